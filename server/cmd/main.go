@@ -56,6 +56,13 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err = cn.GetWebhooks().Dispatch(ctx)
+		if err != nil {
+			logger.Fatal(err)
+		}
+	}()
+
 	srv := server.NewMediaDeliveryServer(
 		logger,
 		platformserver.Config{
@@ -67,7 +74,9 @@ func main() {
 			ShutdownTimeout:         cfg.Server.ShutdownTimeout,
 		},
 		cn.GetTvShowLibrary(),
+		cn.GetMovieLibrary(),
 		cn.GetContentDelivery(),
+		cn.GetQualityProfiles(),
 	)
 	go func() {
 		err = srv.Start(ctx)
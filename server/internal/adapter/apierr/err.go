@@ -53,3 +53,8 @@ func HandleRequestError(log log.Logger, err error) error {
 
 	return err
 }
+
+// IsRetryable ошибка временная, запрос можно повторить позже
+func IsRetryable(err error) bool {
+	return errors.Is(err, ServiceUnavailableErr) || errors.Is(err, NotAuthorizedErr)
+}
@@ -84,6 +84,8 @@ func (api *API) GetCatalogInfo(path string) (*CatalogInfo, error) {
 				return SeriesTypeCatalog
 			case "Season":
 				return SeasonTypeCatalog
+			case "Movie":
+				return MovieTypeCatalog
 			default:
 				return UnknownTypeCatalog
 			}
@@ -6,6 +6,7 @@ const (
 	UnknownTypeCatalog TypeCatalog = "unknown"
 	SeasonTypeCatalog  TypeCatalog = "season"
 	SeriesTypeCatalog  TypeCatalog = "series"
+	MovieTypeCatalog   TypeCatalog = "movie"
 )
 
 type CatalogInfo struct {
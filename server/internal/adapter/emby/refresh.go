@@ -35,3 +35,9 @@ func (api *API) Refresh() error {
 
 	return nil
 }
+
+// ScanPath обновление медиатеки после изменения файлов по пути
+// Emby сам находит изменения при обновлении медиатеки, поэтому путь не используется
+func (api *API) ScanPath(_ string) error {
+	return api.Refresh()
+}
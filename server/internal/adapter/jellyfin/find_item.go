@@ -0,0 +1,99 @@
+package jellyfin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+)
+
+type rawItem struct {
+	Path        string `json:"Path"`
+	Name        string `json:"Name"`
+	ID          string `json:"Id"`
+	IsFolder    bool   `json:"IsFolder"`
+	Type        string `json:"Type"`
+	ProviderIds struct {
+		Tmdb string `json:"Tmdb"`
+	} `json:"ProviderIds"`
+}
+
+// GetCatalogInfo информация о сериале, сезоне или фильме по пути к нему
+// Фильтра по пути в Jellyfin нет, поэтому элементы нужного типа фильтруются на нашей стороне
+func (api *API) GetCatalogInfo(itemPath string) (*emby.CatalogInfo, error) {
+	queryParams := url.Values{}
+	queryParams.Add("Recursive", "true")
+	queryParams.Add("IncludeItemTypes", "Series,Season,Movie")
+	queryParams.Add("Fields", "Path,ProviderIds")
+
+	req, err := api.newRequest(http.MethodGet, "/Items", queryParams, nil)
+	if err != nil {
+		return nil, fmt.Errorf("newRequest: %w", err)
+	}
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to jellyfin items %w", apierr.HandleRequestError(api.logger, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierr.HandleStatusCodeError(api.logger, resp)
+	}
+
+	var result struct {
+		Items []rawItem `json:"Items"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	itemPath = path.Clean(itemPath)
+	var found []rawItem
+	for _, item := range result.Items {
+		if item.Path != "" && path.Clean(item.Path) == itemPath {
+			found = append(found, item)
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, apierr.ContentNotFound
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("multiple items found")
+	}
+
+	item := found[0]
+	var theMovieDbID uint64
+	if item.ProviderIds.Tmdb != "" {
+		theMovieDbID, err = strconv.ParseUint(item.ProviderIds.Tmdb, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse item theMovieDbID: %w", err)
+		}
+	}
+
+	return &emby.CatalogInfo{
+		Path:     item.Path,
+		Name:     item.Name,
+		ID:       api.rememberItemID(item.ID),
+		IsFolder: item.IsFolder,
+		Type: func(t string) emby.TypeCatalog {
+			switch t {
+			case "Series":
+				return emby.SeriesTypeCatalog
+			case "Season":
+				return emby.SeasonTypeCatalog
+			case "Movie":
+				return emby.MovieTypeCatalog
+			default:
+				return emby.UnknownTypeCatalog
+			}
+		}(item.Type),
+		TheMovieDbID: theMovieDbID,
+	}, nil
+}
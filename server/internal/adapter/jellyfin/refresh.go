@@ -0,0 +1,22 @@
+package jellyfin
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Refresh обновим медиатеку
+func (api *API) Refresh() error {
+	req, err := api.newRequest(http.MethodPost, "/Library/Refresh", nil, nil)
+	if err != nil {
+		return fmt.Errorf("newRequest: %w", err)
+	}
+
+	return api.doNoContent(req)
+}
+
+// ScanPath обновление медиатеки после изменения файлов по пути
+// Jellyfin сам находит изменения при обновлении медиатеки, поэтому путь не используется
+func (api *API) ScanPath(_ string) error {
+	return api.Refresh()
+}
@@ -0,0 +1,36 @@
+package jellyfin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// RemoteSearchApply устанавливает метаданные для сериала
+func (api *API) RemoteSearchApply(embyID, theMovieDBID uint64) error {
+	itemID, err := api.lookupItemID(embyID)
+	if err != nil {
+		return err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("replaceAllImages", "true")
+
+	payload, err := json.Marshal(map[string]any{
+		"ProviderIds": map[string]string{
+			"Tmdb": strconv.FormatUint(theMovieDBID, 10),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	req, err := api.newRequest(http.MethodPost, "/Items/RemoteSearch/Apply/"+itemID, queryParams, payload)
+	if err != nil {
+		return fmt.Errorf("newRequest: %w", err)
+	}
+
+	return api.doNoContent(req)
+}
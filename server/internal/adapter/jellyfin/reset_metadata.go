@@ -0,0 +1,29 @@
+package jellyfin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ResetMetadata сбрасываем всю методату
+// Отдельного метода сброса в Jellyfin нет, используем полное обновление с заменой метаданных
+func (api *API) ResetMetadata(embyID uint64) error {
+	itemID, err := api.lookupItemID(embyID)
+	if err != nil {
+		return err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("metadataRefreshMode", "FullRefresh")
+	queryParams.Add("imageRefreshMode", "FullRefresh")
+	queryParams.Add("replaceAllMetadata", "true")
+	queryParams.Add("replaceAllImages", "true")
+
+	req, err := api.newRequest(http.MethodPost, "/Items/"+itemID+"/Refresh", queryParams, nil)
+	if err != nil {
+		return fmt.Errorf("newRequest: %w", err)
+	}
+
+	return api.doNoContent(req)
+}
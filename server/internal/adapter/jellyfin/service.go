@@ -0,0 +1,114 @@
+package jellyfin
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const requestTimeout = 60 * time.Second
+
+// API клиент Jellyfin, реализует те же методы что и emby.API
+/*
+	В Jellyfin идентификаторы элементов это guid, а юзеркейсы работают с числовыми id emby.
+	Поэтому наружу отдается fnv хеш от guid, а соответствие хеша и guid запоминается при GetCatalogInfo
+*/
+type API struct {
+	apiKey     string
+	baseAPIUrl *url.URL
+	httpClient *http.Client
+	logger     log.Logger
+
+	mu      sync.Mutex
+	itemIDs map[uint64]string
+}
+
+func NewApi(apiKey string, baseURL string, logger log.Logger) (*API, error) {
+	urlApi, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %w", err)
+	}
+
+	return &API{
+		apiKey:     apiKey,
+		baseAPIUrl: urlApi,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logger:     logger.Named("jellyfin_api"),
+		itemIDs:    make(map[uint64]string),
+	}, nil
+}
+
+// rememberItemID числовой id для guid элемента
+func (api *API) rememberItemID(guid string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(guid))
+	id := h.Sum64()
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.itemIDs[id] = guid
+
+	return id
+}
+
+// lookupItemID guid элемента по числовому id
+func (api *API) lookupItemID(id uint64) (string, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	guid, ok := api.itemIDs[id]
+	if !ok {
+		return "", fmt.Errorf("unknown item id %d: %w", id, apierr.ContentNotFound)
+	}
+	return guid, nil
+}
+
+// newRequest запрос с авторизацией через заголовок, api_key в query Jellyfin считает устаревшим
+func (api *API) newRequest(method, path string, query url.Values, body []byte) (*http.Request, error) {
+	reqUrl := api.baseAPIUrl.JoinPath(path)
+	if query != nil {
+		reqUrl.RawQuery = query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, reqUrl.String(), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf(`MediaBrowser Token="%s"`, api.apiKey))
+	req.Header.Set("accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// doNoContent выполняет запрос, который в случае успеха возвращает 204
+func (api *API) doNoContent(req *http.Request) error {
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to jellyfin request: %w", apierr.HandleRequestError(api.logger, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return apierr.HandleStatusCodeError(api.logger, resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package jellyfin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+)
+
+const (
+	testApiKey   = "secret"
+	testSeriesID = "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+)
+
+const itemsResponse = `{
+  "Items": [
+    {"Name": "Dark", "Id": "` + testSeriesID + `", "Path": "/tvshows/Dark (2017)", "IsFolder": true, "Type": "Series", "ProviderIds": {"Tmdb": "70523"}},
+    {"Name": "Season 1", "Id": "11112222333344445555666677778888", "Path": "/tvshows/Dark (2017)/Season 01", "IsFolder": true, "Type": "Season", "ProviderIds": {}},
+    {"Name": "Dune", "Id": "99990000aaaabbbbccccddddeeeeffff", "Path": "/movies/Dune (2021)/Dune (2021).mkv", "IsFolder": false, "Type": "Movie", "ProviderIds": {"Tmdb": "438631"}}
+  ],
+  "TotalRecordCount": 3
+}`
+
+type request struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+func newTestServer(t *testing.T, requests *[]request) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != `MediaBrowser Token="`+testApiKey+`"` {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Body: string(body)})
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/jellyfin/Items":
+			_, _ = w.Write([]byte(itemsResponse))
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAPI_GetCatalogInfo(t *testing.T) {
+	var requests []request
+	server := newTestServer(t, &requests)
+	api, err := NewApi(testApiKey, server.URL+"/jellyfin", log.NewLogger(log.DebugLevel))
+	require.NoError(t, err)
+
+	info, err := api.GetCatalogInfo("/tvshows/Dark (2017)/")
+	require.NoError(t, err)
+	require.Equal(t, "Dark", info.Name)
+	require.Equal(t, emby.SeriesTypeCatalog, info.Type)
+	require.True(t, info.IsFolder)
+	require.Equal(t, uint64(70523), info.TheMovieDbID)
+	require.NotZero(t, info.ID)
+
+	movie, err := api.GetCatalogInfo("/movies/Dune (2021)/Dune (2021).mkv")
+	require.NoError(t, err)
+	require.Equal(t, emby.MovieTypeCatalog, movie.Type)
+	require.NotEqual(t, info.ID, movie.ID)
+
+	_, err = api.GetCatalogInfo("/tvshows/Unknown")
+	require.ErrorIs(t, err, apierr.ContentNotFound)
+
+	require.Equal(t, "Fields=Path%2CProviderIds&IncludeItemTypes=Series%2CSeason%2CMovie&Recursive=true", requests[0].Query)
+}
+
+func TestAPI_SetMetadata(t *testing.T) {
+	var requests []request
+	server := newTestServer(t, &requests)
+	api, err := NewApi(testApiKey, server.URL+"/jellyfin", log.NewLogger(log.DebugLevel))
+	require.NoError(t, err)
+
+	require.NoError(t, api.Refresh())
+
+	info, err := api.GetCatalogInfo("/tvshows/Dark (2017)")
+	require.NoError(t, err)
+
+	require.NoError(t, api.ResetMetadata(info.ID))
+	require.NoError(t, api.RemoteSearchApply(info.ID, 70523))
+
+	require.Len(t, requests, 4)
+	require.Equal(t, request{Method: http.MethodPost, Path: "/jellyfin/Library/Refresh"}, requests[0])
+	require.Equal(t, "/jellyfin/Items/"+testSeriesID+"/Refresh", requests[2].Path)
+	require.Contains(t, requests[2].Query, "replaceAllMetadata=true")
+	require.Equal(t, "/jellyfin/Items/RemoteSearch/Apply/"+testSeriesID, requests[3].Path)
+
+	var body struct {
+		ProviderIds map[string]string
+	}
+	require.NoError(t, json.Unmarshal([]byte(requests[3].Body), &body))
+	require.Equal(t, "70523", body.ProviderIds["Tmdb"])
+
+	// id, который не был получен через GetCatalogInfo
+	require.ErrorIs(t, api.ResetMetadata(42), apierr.ContentNotFound)
+
+	wrongKey, err := NewApi("wrong", server.URL+"/jellyfin", log.NewLogger(log.DebugLevel))
+	require.NoError(t, err)
+	require.ErrorIs(t, wrongKey.Refresh(), apierr.NotAuthorizedErr)
+}
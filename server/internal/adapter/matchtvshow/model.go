@@ -9,11 +9,43 @@ const (
 	TrackTypeSubtitle TrackType = "subtitle"
 )
 
+// Паттерны, по которым определяются сезон и серия файла
+const (
+	// PatternSXXEXX S01E05
+	PatternSXXEXX = "sxxexx"
+	// PatternSeasonEpisode Season 1 Episode 5
+	PatternSeasonEpisode = "season_episode"
+	// PatternRuSeasonEpisode Серия 5 из 1 сезона
+	PatternRuSeasonEpisode = "ru_season_episode"
+	// PatternSpecial спешл с номером: SP01, OVA 2
+	PatternSpecial = "special"
+	// PatternSpecialDir номер серии в каталоге спешлов
+	PatternSpecialDir = "special_dir"
+	// PatternEpisodeSeasonInPath номер серии, сезон указан в пути (Season 2/05.mkv)
+	PatternEpisodeSeasonInPath = "episode_season_in_path"
+	// PatternAbsoluteEpisode абсолютный номер серии, сезон определен по количеству серий в сезонах
+	PatternAbsoluteEpisode = "absolute_episode"
+	// PatternEpisodeOnly номер серии без сезона, считается что это 1 сезон
+	PatternEpisodeOnly = "episode_only"
+)
+
+// TrackMatch как файл был сопоставлен с эпизодом
+type TrackMatch struct {
+	SeasonNumber  uint8
+	EpisodeNumber int
+	// Pattern паттерн, по которому определены сезон и серия
+	Pattern string
+	// Confidence уверенность сопоставления от 0 до 1
+	Confidence float64
+}
+
 type Track struct {
 	Name     string
 	Language *string
 	File     string
 	Type     TrackType
+	// Match информация о сопоставлении с эпизодом (nil если файл не сопоставлен)
+	Match *TrackMatch
 }
 
 type ContentMatch struct {
@@ -24,7 +56,21 @@ type ContentMatch struct {
 	Subtitles     []Track
 }
 
+// SeasonInfo сезон сериала и количество эпизодов в нем
+// Используется для перевода абсолютной нумерации серий (аниме) в номер сезона и серии
+type SeasonInfo struct {
+	SeasonNumber uint8
+	EpisodeCount int
+}
+
 type ContentMatches struct {
 	Matches     []ContentMatch
 	Unallocated []Track
 }
+
+// MovieFiles файлы раздачи фильма разложенные по типам дорожек
+type MovieFiles struct {
+	Videos      []Track
+	AudioTracks []Track
+	Subtitles   []Track
+}
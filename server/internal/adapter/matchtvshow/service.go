@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -20,18 +21,43 @@ var (
 	}
 
 	// Паттерны для определения сезона и серии
-	seasonEpisodePatterns = []*regexp.Regexp{
+	seasonEpisodePatterns = []namedPattern{
 		// SXXEXX формат
-		regexp.MustCompile(`[s](\d+)[\s\-_]*[e](\d+)`),
+		{name: PatternSXXEXX, re: regexp.MustCompile(`[s](\d+)[\s\-_]*[e](\d+)`)},
 		// Season X Episode Y
-		regexp.MustCompile(`season[\.\s]*(\d+)[\s\-_]*episode[\.\s]*(\d+)`),
+		{name: PatternSeasonEpisode, re: regexp.MustCompile(`season[\.\s]*(\d+)[\s\-_]*episode[\.\s]*(\d+)`)},
 		// Серия X из Y сезона (русский)
-		regexp.MustCompile(`серия[\.\s]*(\d+)[\s\-_]*из[\.\s]*(\d+)[\s\-_]*сезона`),
+		{name: PatternRuSeasonEpisode, re: regexp.MustCompile(`серия[\.\s]*(\d+)[\s\-_]*из[\.\s]*(\d+)[\s\-_]*сезона`)},
+	}
+
+	// Паттерны для определения номера серии без сезона
+	// В аниме это часто абсолютный номер серии (37 серия = S02E12)
+	episodePatterns = []*regexp.Regexp{
 		// Просто номер серии в конце
 		regexp.MustCompile(`[\s\-_\[\]](\d{2})[\s\.\[\]]`),
 		regexp.MustCompile(`[\s\-_\[\]](\d{2,})[^a-z0-9]`),
 	}
 
+	// Паттерны спешлов с номером: SP01, OVA 2, Special 3 (уходят в 0 сезон)
+	specialEpisodePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?:^|[^a-z])(?:sp|ova|oad|special)[\s\-_\.]?(\d{1,3})(?:[^0-9]|$)`),
+	}
+
+	// Имена каталогов со спешлами, номер серии берется из имени файла
+	specialDirs = []string{"specials", "special", "sp", "sps", "ova", "ovas", "oad", "спешлы", "спецматериалы"}
+
+	// Уверенность сопоставления для каждого паттерна
+	patternConfidence = map[string]float64{
+		PatternSXXEXX:              1,
+		PatternSeasonEpisode:       0.95,
+		PatternRuSeasonEpisode:     0.95,
+		PatternSpecial:             0.8,
+		PatternSpecialDir:          0.7,
+		PatternEpisodeSeasonInPath: 0.7,
+		PatternAbsoluteEpisode:     0.6,
+		PatternEpisodeOnly:         0.5,
+	}
+
 	// Паттерны для определения сезона (вынесены в переменные)
 	seasonPatterns = []*regexp.Regexp{
 		regexp.MustCompile(`season[\.\s]*(\d+)`),
@@ -40,6 +66,20 @@ var (
 	}
 )
 
+type namedPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// episodeMatch найденные в имени файла сезон и серия
+type episodeMatch struct {
+	season  uint8
+	episode int
+	// explicitSeason сезон указан явно, иначе номер серии может быть абсолютным
+	explicitSeason bool
+	pattern        string
+}
+
 type Service struct {
 }
 
@@ -48,36 +88,101 @@ func NewService() *Service {
 }
 
 // extractSeasonAndEpisode извлекает номер сезона и серии из строки
-func extractSeasonAndEpisode(filename string) (season uint8, episode int, found bool) {
+func extractSeasonAndEpisode(filename string) (episodeMatch, bool) {
 	filename = strings.ToLower(filename)
 	for _, pattern := range seasonEpisodePatterns {
-		matches := pattern.FindStringSubmatch(filename)
+		matches := pattern.re.FindStringSubmatch(filename)
 		if len(matches) >= 3 {
-			// Для паттернов, которые находят и сезон и серию
-			season = parseUint8(matches[1])
-			episode = int(parseUint8(matches[2]))
-			return season, episode, true
-		} else if len(matches) >= 2 {
-			// Для паттернов, которые находят только серию
-			episode = int(parseUint8(matches[1]))
-			season = detectSeasonFromString(filename)
-			return season, episode, true
+			return episodeMatch{
+				season:         parseUint8(matches[1]),
+				episode:        int(parseUint8(matches[2])),
+				explicitSeason: true,
+				pattern:        pattern.name,
+			}, true
 		}
 	}
-	return 0, 0, false
+	// Спешлы и OVA
+	for _, pattern := range specialEpisodePatterns {
+		matches := pattern.FindStringSubmatch(filename)
+		if len(matches) >= 2 {
+			return episodeMatch{
+				episode:        parseEpisode(matches[1]),
+				explicitSeason: true,
+				pattern:        PatternSpecial,
+			}, true
+		}
+	}
+	for _, pattern := range episodePatterns {
+		matches := pattern.FindStringSubmatch(filename)
+		if len(matches) >= 2 {
+			result := episodeMatch{
+				episode: parseEpisode(matches[1]),
+			}
+			if isSpecialPath(filename) {
+				result.explicitSeason = true
+				result.pattern = PatternSpecialDir
+				return result, true
+			}
+			result.season, result.explicitSeason = detectSeasonFromString(filename)
+			result.pattern = PatternEpisodeSeasonInPath
+			return result, true
+		}
+	}
+	return episodeMatch{}, false
+}
+
+// isSpecialPath файл лежит в каталоге со спешлами
+func isSpecialPath(filename string) bool {
+	dirs := strings.Split(filepath.ToSlash(filepath.Dir(filename)), "/")
+	for _, dir := range dirs {
+		for _, specialDir := range specialDirs {
+			if strings.TrimSpace(dir) == specialDir {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // detectSeasonFromString пытается определить сезон из названия
-func detectSeasonFromString(filename string) uint8 {
+func detectSeasonFromString(filename string) (uint8, bool) {
 	for _, pattern := range seasonPatterns {
 		matches := pattern.FindStringSubmatch(filename)
 		if len(matches) >= 2 {
-			return parseUint8(matches[1])
+			return parseUint8(matches[1]), true
 		}
 	}
 
 	// Если сезон явно не указан, предполагаем 1-й
-	return 1
+	return 1, false
+}
+
+// absoluteToSeason переводит абсолютный номер серии в номер сезона и серии
+/*
+	Сезоны по порядку: 1 сезон - 25 серий, 2 сезон - 12 серий
+	37 серия = S02E12
+	Спешлы (0 сезон) в абсолютной нумерации не участвуют.
+	Если сезоны неизвестны или номер больше общего количества серий, считаем что это 1 сезон
+*/
+func absoluteToSeason(absolute int, seasons []SeasonInfo) (uint8, int, bool) {
+	regular := make([]SeasonInfo, 0, len(seasons))
+	for _, season := range seasons {
+		if season.SeasonNumber > 0 {
+			regular = append(regular, season)
+		}
+	}
+	sort.Slice(regular, func(i, j int) bool {
+		return regular[i].SeasonNumber < regular[j].SeasonNumber
+	})
+
+	episode := absolute
+	for _, season := range regular {
+		if episode <= season.EpisodeCount {
+			return season.SeasonNumber, episode, true
+		}
+		episode -= season.EpisodeCount
+	}
+	return 1, absolute, false
 }
 
 // extractTrackName извлекает название трека из пути
@@ -97,6 +202,17 @@ func extractTrackName(filePath string) string {
 	return strings.TrimSuffix(filename, filepath.Ext(filename))
 }
 
+// parseEpisode преобразует строку в номер серии (абсолютные номера бывают больше 255)
+func parseEpisode(s string) int {
+	var result int
+	for _, char := range s {
+		if char >= '0' && char <= '9' {
+			result = result*10 + int(char-'0')
+		}
+	}
+	return result
+}
+
 // parseUint8 преобразует строку в uint8
 func parseUint8(s string) uint8 {
 	var result uint8
@@ -183,7 +299,8 @@ func (s *Service) toTrack(file string) Track {
 }
 
 // MatchEpisodeFiles сопоставляет файлы с эпизодами
-func (s *Service) MatchEpisodeFiles(torrentFiles []string) (*ContentMatches, error) {
+// seasons сезоны сериала, по ним номера серий без сезона переводятся из абсолютной нумерации
+func (s *Service) MatchEpisodeFiles(torrentFiles []string, seasons []SeasonInfo) (*ContentMatches, error) {
 	episodesMap := make(map[string]*ContentMatch)
 	unallocated := make([]Track, 0)
 	for _, filename := range torrentFiles {
@@ -191,20 +308,34 @@ func (s *Service) MatchEpisodeFiles(torrentFiles []string) (*ContentMatches, err
 		track := s.toTrack(filename)
 
 		// Пытаемся определить сезон и серию
-		season, episode, found := extractSeasonAndEpisode(filename) // Используем весь путь
+		match, found := extractSeasonAndEpisode(filename) // Используем весь путь
 		if !found {
 			if track.Type != TrackTypeUnknown {
 				unallocated = append(unallocated, track)
 			}
 			continue
 		}
+		if !match.explicitSeason {
+			var ok bool
+			match.season, match.episode, ok = absoluteToSeason(match.episode, seasons)
+			match.pattern = PatternEpisodeOnly
+			if ok {
+				match.pattern = PatternAbsoluteEpisode
+			}
+		}
+		track.Match = &TrackMatch{
+			SeasonNumber:  match.season,
+			EpisodeNumber: match.episode,
+			Pattern:       match.pattern,
+			Confidence:    patternConfidence[match.pattern],
+		}
 
 		// Создаем ключ для мапы эпизодов
-		key := fmt.Sprintf("S%02dE%02d", season, episode)
+		key := fmt.Sprintf("S%02dE%02d", match.season, match.episode)
 		if _, exists := episodesMap[key]; !exists {
 			episodesMap[key] = &ContentMatch{
-				EpisodeNumber: episode,
-				SeasonNumber:  season,
+				EpisodeNumber: match.episode,
+				SeasonNumber:  match.season,
 				AudioTracks:   []Track{},
 				Subtitles:     []Track{},
 			}
@@ -213,6 +344,15 @@ func (s *Service) MatchEpisodeFiles(torrentFiles []string) (*ContentMatches, err
 
 		switch track.Type {
 		case TrackTypeVideo:
+			// Несколько видеофайлов с одним номером серии: остается более уверенное сопоставление,
+			// остальные попадают в нераспределенные
+			if ep.Video != nil {
+				if ep.Video.Match.Confidence >= track.Match.Confidence {
+					unallocated = append(unallocated, track)
+					continue
+				}
+				unallocated = append(unallocated, *ep.Video)
+			}
 			ep.Video = &track
 		case TrackTypeAudio:
 			ep.AudioTracks = append(ep.AudioTracks, track)
@@ -234,3 +374,25 @@ func (s *Service) MatchEpisodeFiles(torrentFiles []string) (*ContentMatches, err
 		Unallocated: unallocated,
 	}, nil
 }
+
+// MatchMovieFiles раскладывает файлы раздачи фильма по типам дорожек
+func (s *Service) MatchMovieFiles(torrentFiles []string) (*MovieFiles, error) {
+	result := MovieFiles{
+		Videos:      []Track{},
+		AudioTracks: []Track{},
+		Subtitles:   []Track{},
+	}
+	for _, filename := range torrentFiles {
+		track := s.toTrack(filename)
+		switch track.Type {
+		case TrackTypeVideo:
+			result.Videos = append(result.Videos, track)
+		case TrackTypeAudio:
+			result.AudioTracks = append(result.AudioTracks, track)
+		case TrackTypeSubtitle:
+			result.Subtitles = append(result.Subtitles, track)
+		}
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,105 @@
+package matchtvshow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbsoluteToSeason(t *testing.T) {
+	seasons := []SeasonInfo{
+		{SeasonNumber: 2, EpisodeCount: 12},
+		{SeasonNumber: 0, EpisodeCount: 3},
+		{SeasonNumber: 1, EpisodeCount: 25},
+	}
+
+	tests := []struct {
+		absolute    int
+		wantSeason  uint8
+		wantEpisode int
+		wantOK      bool
+	}{
+		{absolute: 1, wantSeason: 1, wantEpisode: 1, wantOK: true},
+		{absolute: 25, wantSeason: 1, wantEpisode: 25, wantOK: true},
+		{absolute: 26, wantSeason: 2, wantEpisode: 1, wantOK: true},
+		{absolute: 37, wantSeason: 2, wantEpisode: 12, wantOK: true},
+		// Больше чем серий в сезонах
+		{absolute: 38, wantSeason: 1, wantEpisode: 38},
+	}
+	for _, tt := range tests {
+		season, episode, ok := absoluteToSeason(tt.absolute, seasons)
+		require.Equal(t, tt.wantSeason, season, tt.absolute)
+		require.Equal(t, tt.wantEpisode, episode, tt.absolute)
+		require.Equal(t, tt.wantOK, ok, tt.absolute)
+	}
+
+	// Сезоны неизвестны
+	season, episode, ok := absoluteToSeason(37, nil)
+	require.Equal(t, uint8(1), season)
+	require.Equal(t, 37, episode)
+	require.False(t, ok)
+}
+
+func TestMatchEpisodeFiles(t *testing.T) {
+	s := NewService()
+	seasons := []SeasonInfo{
+		{SeasonNumber: 0, EpisodeCount: 3},
+		{SeasonNumber: 1, EpisodeCount: 25},
+		{SeasonNumber: 2, EpisodeCount: 12},
+	}
+
+	tests := []struct {
+		file        string
+		wantSeason  uint8
+		wantEpisode int
+		wantPattern string
+	}{
+		{file: "Dark.S01E02.1080p.mkv", wantSeason: 1, wantEpisode: 2, wantPattern: PatternSXXEXX},
+		{file: "[SubsPlease] Shingeki no Kyojin - 05 [1080p].mkv", wantSeason: 1, wantEpisode: 5, wantPattern: PatternAbsoluteEpisode},
+		// Абсолютная нумерация
+		{file: "[SubsPlease] Shingeki no Kyojin - 37 [1080p].mkv", wantSeason: 2, wantEpisode: 12, wantPattern: PatternAbsoluteEpisode},
+		// Сезон указан в каталоге, номер серии не абсолютный
+		{file: "Season 2/Shingeki no Kyojin - 05.mkv", wantSeason: 2, wantEpisode: 5, wantPattern: PatternEpisodeSeasonInPath},
+		// Спешлы
+		{file: "[SubsPlease] Shingeki no Kyojin - SP01 [1080p].mkv", wantSeason: 0, wantEpisode: 1, wantPattern: PatternSpecial},
+		{file: "Shingeki no Kyojin OVA 2.mkv", wantSeason: 0, wantEpisode: 2, wantPattern: PatternSpecial},
+		{file: "Specials/[SubsPlease] Shingeki no Kyojin - 03 [1080p].mkv", wantSeason: 0, wantEpisode: 3, wantPattern: PatternSpecialDir},
+		{file: "Shingeki.no.Kyojin.S00E02.mkv", wantSeason: 0, wantEpisode: 2, wantPattern: PatternSXXEXX},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			result, err := s.MatchEpisodeFiles([]string{tt.file}, seasons)
+			require.NoError(t, err)
+			require.Len(t, result.Matches, 1)
+			require.Equal(t, tt.wantSeason, result.Matches[0].SeasonNumber)
+			require.Equal(t, tt.wantEpisode, result.Matches[0].EpisodeNumber)
+			require.Equal(t, tt.file, result.Matches[0].Video.File)
+			require.Equal(t, tt.wantPattern, result.Matches[0].Video.Match.Pattern)
+			require.Equal(t, patternConfidence[tt.wantPattern], result.Matches[0].Video.Match.Confidence)
+		})
+	}
+
+	t.Run("audio track of absolute episode", func(t *testing.T) {
+		result, err := s.MatchEpisodeFiles([]string{
+			"[SubsPlease] Shingeki no Kyojin - 37 [1080p].mkv",
+			"Rus Sound/[SubsPlease] Shingeki no Kyojin - 37 [1080p].mka",
+		}, seasons)
+		require.NoError(t, err)
+		require.Len(t, result.Matches, 1)
+		require.Equal(t, uint8(2), result.Matches[0].SeasonNumber)
+		require.Len(t, result.Matches[0].AudioTracks, 1)
+	})
+	t.Run("duplicate episode video", func(t *testing.T) {
+		result, err := s.MatchEpisodeFiles([]string{
+			"[SubsPlease] Shingeki no Kyojin - 26 [1080p].mkv",
+			"Shingeki.no.Kyojin.S02E01.mkv",
+		}, seasons)
+		require.NoError(t, err)
+		require.Len(t, result.Matches, 1)
+		// Остается более уверенное сопоставление
+		require.Equal(t, "Shingeki.no.Kyojin.S02E01.mkv", result.Matches[0].Video.File)
+		require.Len(t, result.Unallocated, 1)
+		require.Equal(t, "[SubsPlease] Shingeki no Kyojin - 26 [1080p].mkv", result.Unallocated[0].File)
+		require.NotNil(t, result.Unallocated[0].Match)
+	})
+}
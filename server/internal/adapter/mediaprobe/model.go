@@ -0,0 +1,48 @@
+package mediaprobe
+
+import "time"
+
+type TrackType string
+
+const (
+	TrackTypeVideo    TrackType = "video"
+	TrackTypeAudio    TrackType = "audio"
+	TrackTypeSubtitle TrackType = "subtitles"
+)
+
+// Track дорожка внутри контейнера
+type Track struct {
+	ID    int
+	Type  TrackType
+	Codec string
+	// Language код языка ISO 639-2 (rus, eng, jpn), nil если язык не указан
+	Language *string
+	// Name название дорожки из контейнера
+	Name *string
+	// Channels количество каналов (только для аудио)
+	Channels int
+	// PixelDimensions разрешение (только для видео), например 1920x1080
+	PixelDimensions string
+	Default         bool
+	Forced          bool
+}
+
+// MediaInfo информация о медиафайле
+type MediaInfo struct {
+	// ContainerType тип контейнера (Matroska, QuickTime/MP4, SRT subtitles)
+	ContainerType string
+	// Duration длительность (0 если контейнер ее не содержит)
+	Duration time.Duration
+	Tracks   []Track
+}
+
+// TracksByType дорожки заданного типа
+func (m *MediaInfo) TracksByType(trackType TrackType) []Track {
+	result := make([]Track, 0, len(m.Tracks))
+	for _, track := range m.Tracks {
+		if track.Type == trackType {
+			result = append(result, track)
+		}
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package mediaprobe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ErrUnrecognized mkvmerge не смог распознать формат файла
+var ErrUnrecognized = errors.New("media file is not recognized")
+
+// undefinedLanguage язык дорожки не указан
+const undefinedLanguage = "und"
+
+// identifyResult вывод mkvmerge -J (https://mkvtoolnix.download/doc/mkvmerge-identification-output-schema-v18.json)
+type identifyResult struct {
+	FileName  string `json:"file_name"`
+	Container struct {
+		Recognized bool   `json:"recognized"`
+		Supported  bool   `json:"supported"`
+		Type       string `json:"type"`
+		Properties struct {
+			// Duration в наносекундах
+			Duration int64 `json:"duration"`
+		} `json:"properties"`
+	} `json:"container"`
+	Errors []string `json:"errors"`
+	Tracks []struct {
+		ID         int    `json:"id"`
+		Type       string `json:"type"`
+		Codec      string `json:"codec"`
+		Properties struct {
+			Language        string `json:"language"`
+			TrackName       string `json:"track_name"`
+			AudioChannels   int    `json:"audio_channels"`
+			PixelDimensions string `json:"pixel_dimensions"`
+			DefaultTrack    bool   `json:"default_track"`
+			ForcedTrack     bool   `json:"forced_track"`
+		} `json:"properties"`
+	} `json:"tracks"`
+}
+
+// Service получение информации о дорожках медиафайлов через mkvmerge --identify
+type Service struct {
+	binary string
+}
+
+func NewService() *Service {
+	return &Service{
+		binary: "mkvmerge",
+	}
+}
+
+// Probe информация о дорожках файла
+// Возвращает ErrUnrecognized если формат файла не распознан
+func (s *Service) Probe(ctx context.Context, filePath string) (*MediaInfo, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.binary, "-J", filePath)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// mkvmerge завершается с кодом 1 при предупреждениях, вывод при этом корректный
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || stdout.Len() == 0 {
+			return nil, fmt.Errorf("mkvmerge -J: %w (%s)", err, strings.TrimSpace(stderr.String()))
+		}
+	}
+
+	return parseIdentify(stdout.Bytes())
+}
+
+// parseIdentify разбор вывода mkvmerge -J
+func parseIdentify(data []byte) (*MediaInfo, error) {
+	var result identifyResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	if !result.Container.Recognized || !result.Container.Supported {
+		return nil, fmt.Errorf("%s: %w", result.FileName, ErrUnrecognized)
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("mkvmerge: %s", strings.Join(result.Errors, "; "))
+	}
+
+	info := &MediaInfo{
+		ContainerType: result.Container.Type,
+		Duration:      time.Duration(result.Container.Properties.Duration),
+		Tracks:        make([]Track, 0, len(result.Tracks)),
+	}
+	for _, item := range result.Tracks {
+		track := Track{
+			ID:              item.ID,
+			Type:            TrackType(item.Type),
+			Codec:           item.Codec,
+			Channels:        item.Properties.AudioChannels,
+			PixelDimensions: item.Properties.PixelDimensions,
+			Default:         item.Properties.DefaultTrack,
+			Forced:          item.Properties.ForcedTrack,
+		}
+		if language := item.Properties.Language; language != "" && language != undefinedLanguage {
+			track.Language = &language
+		}
+		if name := strings.TrimSpace(item.Properties.TrackName); name != "" {
+			track.Name = &name
+		}
+		info.Tracks = append(info.Tracks, track)
+	}
+
+	return info, nil
+}
@@ -0,0 +1,83 @@
+package mediaprobe
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseIdentify(t *testing.T) {
+	t.Run("episode mkv", func(t *testing.T) {
+		info, err := parseIdentify(readFixture(t, "episode_mkv.json"))
+		require.NoError(t, err)
+		require.Equal(t, "Matroska", info.ContainerType)
+		require.Equal(t, 52*time.Minute+59520*time.Millisecond, info.Duration)
+		require.Len(t, info.Tracks, 4)
+
+		video := info.TracksByType(TrackTypeVideo)
+		require.Len(t, video, 1)
+		require.Equal(t, "AVC/H.264/MPEG-4p10", video[0].Codec)
+		require.Equal(t, "1920x1080", video[0].PixelDimensions)
+		// und - язык не указан
+		require.Nil(t, video[0].Language)
+		require.Nil(t, video[0].Name)
+
+		audio := info.TracksByType(TrackTypeAudio)
+		require.Len(t, audio, 2)
+		require.Equal(t, Track{
+			ID:       1,
+			Type:     TrackTypeAudio,
+			Codec:    "E-AC-3",
+			Language: lo.ToPtr("rus"),
+			Name:     lo.ToPtr("Многоголосый закадровый | LostFilm"),
+			Channels: 6,
+			Default:  true,
+		}, audio[0])
+		require.Equal(t, "ger", *audio[1].Language)
+
+		subtitles := info.TracksByType(TrackTypeSubtitle)
+		require.Len(t, subtitles, 1)
+		require.True(t, subtitles[0].Forced)
+	})
+
+	t.Run("external audio", func(t *testing.T) {
+		info, err := parseIdentify(readFixture(t, "audio_mka.json"))
+		require.NoError(t, err)
+		require.Len(t, info.Tracks, 1)
+		require.Equal(t, "AC-3", info.Tracks[0].Codec)
+		require.Equal(t, 2, info.Tracks[0].Channels)
+		require.Nil(t, info.Tracks[0].Language)
+		// Название из пробелов считается пустым
+		require.Nil(t, info.Tracks[0].Name)
+	})
+
+	t.Run("external subtitles without duration", func(t *testing.T) {
+		info, err := parseIdentify(readFixture(t, "subtitles_srt.json"))
+		require.NoError(t, err)
+		require.Equal(t, "SRT subtitles", info.ContainerType)
+		require.Zero(t, info.Duration)
+		require.Equal(t, TrackTypeSubtitle, info.Tracks[0].Type)
+		require.Equal(t, "SubRip/SRT", info.Tracks[0].Codec)
+	})
+
+	t.Run("unrecognized", func(t *testing.T) {
+		_, err := parseIdentify(readFixture(t, "unrecognized.json"))
+		require.ErrorIs(t, err, ErrUnrecognized)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parseIdentify([]byte("mkvmerge: command not found"))
+		require.Error(t, err)
+	})
+}
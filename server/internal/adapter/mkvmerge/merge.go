@@ -91,13 +91,12 @@ func (s *Merge) Merge(ctx context.Context, params MergeParams, outputChan chan<-
 
 	// Читаем вывод в реальном времени и отправляем в канал
 	var wg sync.WaitGroup
+	wg.Add(2)
 	go func() {
-		wg.Add(1)
 		defer wg.Done()
 		s.scanOutput(ctx, stdoutPipe, outputChan, InfoMessageType)
 	}()
 	go func() {
-		wg.Add(1)
 		defer wg.Done()
 		s.scanOutput(ctx, stderrPipe, outputChan, ErrorMessageType)
 	}()
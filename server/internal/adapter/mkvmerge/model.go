@@ -13,6 +13,8 @@ const (
 	RunningStatus  Status = iota
 	CompleteStatus Status = iota
 	ErrorStatus    Status = iota
+	// CanceledStatus обработка отменена пользователем
+	CanceledStatus Status = iota
 )
 
 type MergeLogs struct {
@@ -23,6 +23,12 @@ type Pipeline struct {
 	logger  log.Logger
 	merger  MkvMerge
 	storage Storage
+
+	// mu защищает информацию о выполняемой сейчас обработке
+	mu            sync.Mutex
+	runningID     uuid.UUID
+	cancelRunning context.CancelFunc
+	canceled      bool
 }
 
 func NewPipeline(merger MkvMerge, storage Storage, logger log.Logger) *Pipeline {
@@ -77,6 +83,61 @@ func (s *Pipeline) GetMergeResult(ctx context.Context, id uuid.UUID) (*MergeResu
 	return result, nil
 }
 
+// CancelMerge отмена обработки, если она еще не завершена
+// Ожидающая обработка больше не будет запущена, выполняемая сейчас - прерывается
+func (s *Pipeline) CancelMerge(ctx context.Context, id uuid.UUID) error {
+	result, err := s.GetMergeResult(ctx, id)
+	if err != nil {
+		return fmt.Errorf("GetMergeResult: %w", err)
+	}
+	if result.Status == CompleteStatus || result.Status == ErrorStatus || result.Status == CanceledStatus {
+		return nil
+	}
+
+	if err = s.storage.Update(ctx, id, &UpdateMergeResult{
+		Status:    CanceledStatus,
+		Completed: lo.ToPtr(time.Now()),
+	}); err != nil {
+		return fmt.Errorf("storage.Update: %w", err)
+	}
+
+	// Статус обновлен до проверки выполняемой обработки: пайплайн сначала запоминает обработку,
+	// а потом перечитывает ее статус, поэтому отмена не теряется и без блокировки на время запросов к базе
+	s.cancelIfRunning(id)
+
+	return nil
+}
+
+// cancelIfRunning прерывает обработку, если она выполняется сейчас
+func (s *Pipeline) cancelIfRunning(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancelRunning != nil && s.runningID == id {
+		s.canceled = true
+		s.cancelRunning()
+	}
+}
+
+// setRunning запоминает выполняемую обработку, что бы ее можно было отменить
+func (s *Pipeline) setRunning(id uuid.UUID, cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runningID = id
+	s.cancelRunning = cancel
+	s.canceled = false
+}
+
+// resetRunning сбрасывает выполняемую обработку, возвращает была ли она отменена
+func (s *Pipeline) resetRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	canceled := s.canceled
+	s.runningID = uuid.Nil
+	s.cancelRunning = nil
+	s.canceled = false
+	return canceled
+}
+
 func (s *Pipeline) startTimer(ctx context.Context) error {
 	// Используем таймер с select для корректной обработки отмены контекста
 	timer := time.NewTimer(retryDelay)
@@ -125,9 +186,9 @@ func (s *Pipeline) runMerge(ctx context.Context, id uuid.UUID, params MergeParam
 
 	var wg sync.WaitGroup
 
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		wg.Add(1)
 		for msg := range outputChan {
 			if strings.Contains(msg.Content, "Error:") {
 				msg.Type = ErrorMessageType
@@ -205,19 +266,47 @@ func (s *Pipeline) StartMergePipeline(ctx context.Context) error {
 
 		s.logger.Debugf("start mvk merge: %s", result.Params.VideoInputFile)
 
+		mergeCtx, cancel := context.WithCancel(ctx)
+		s.setRunning(result.ID, cancel)
+
+		// Обработку могли отменить пока она выбиралась из очереди
+		if current, gerr := s.storage.GetByID(ctx, result.ID); gerr == nil && current.Status == CanceledStatus {
+			s.resetRunning()
+			cancel()
+			continue
+		}
+
 		// TODO: транзакция
 		err = s.storage.DeleteLogs(ctx, result.ID)
 		if err != nil {
+			cancel()
 			return fmt.Errorf("storage.DeleteLogs: %w", err)
 		}
 		err = s.storage.Update(ctx, result.ID, &UpdateMergeResult{
 			Status: RunningStatus,
 		})
 		if err != nil {
+			cancel()
 			return fmt.Errorf("storage.Update: %w", err)
 		}
 
-		err = s.runMerge(ctx, result.ID, result.Params)
+		err = s.runMerge(mergeCtx, result.ID, result.Params)
+		canceled := s.resetRunning()
+		cancel()
+
+		if canceled {
+			// Статус мог быть перезаписан запуском обработки, фиксируем отмену еще раз
+			s.logger.Debugf("canceled mvk merge: %s", result.Params.VideoInputFile)
+			uerr := s.storage.Update(ctx, result.ID, &UpdateMergeResult{
+				Status:    CanceledStatus,
+				Completed: lo.ToPtr(time.Now()),
+			})
+			if uerr != nil {
+				return fmt.Errorf("storage.Update: %w", uerr)
+			}
+			continue
+		}
+
 		if err != nil {
 			s.logger.Errorf("error mvk merge: %s", result.Params.VideoInputFile)
 			uerr := s.storage.Update(ctx, result.ID, &UpdateMergeResult{
@@ -0,0 +1,24 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/kkiling/goplatform/log"
+)
+
+// Noop используется когда уведомления не настроены, сообщение только пишется в лог
+type Noop struct {
+	logger log.Logger
+}
+
+func NewNoop(logger log.Logger) *Noop {
+	return &Noop{
+		logger: logger.Named("notifier"),
+	}
+}
+
+// Send пишет уведомление в лог
+func (n *Noop) Send(_ context.Context, text string) error {
+	n.logger.Debugf("notification: %s", text)
+	return nil
+}
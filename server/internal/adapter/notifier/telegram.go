@@ -0,0 +1,142 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	// defaultTelegramURL адрес Bot API
+	defaultTelegramURL = "https://api.telegram.org"
+)
+
+// TelegramConfig настройки бота
+type TelegramConfig struct {
+	// Token токен бота от @BotFather
+	Token string
+	// ChatID id чата или @username канала куда отправляются уведомления
+	ChatID string
+	// ApiURL адрес Bot API, по умолчанию https://api.telegram.org
+	ApiURL string
+	// ProxyURL прокси для доступа к api
+	ProxyURL *string
+}
+
+// Telegram отправка уведомлений через Telegram Bot API
+type Telegram struct {
+	token      string
+	chatID     string
+	baseAPIUrl *url.URL
+	httpClient *http.Client
+	logger     log.Logger
+}
+
+func NewTelegram(logger log.Logger, cfg TelegramConfig) (*Telegram, error) {
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("telegram token is required")
+	}
+	if cfg.ChatID == "" {
+		return nil, fmt.Errorf("telegram chat id is required")
+	}
+
+	apiURL := cfg.ApiURL
+	if apiURL == "" {
+		apiURL = defaultTelegramURL
+	}
+	urlApi, err := url.Parse(strings.TrimRight(apiURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.ProxyURL != nil {
+		proxyURL, err := url.Parse(*cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("url.Parse: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &Telegram{
+		token:      cfg.Token,
+		chatID:     cfg.ChatID,
+		baseAPIUrl: urlApi,
+		httpClient: &http.Client{Timeout: requestTimeout, Transport: transport},
+		logger:     logger.Named("telegram"),
+	}, nil
+}
+
+type sendMessageRequest struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+type apiResponse struct {
+	Ok          bool   `json:"ok"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+}
+
+// Send отправляет текстовое сообщение в чат
+func (t *Telegram) Send(ctx context.Context, text string) error {
+	body, err := json.Marshal(sendMessageRequest{
+		ChatID:                t.chatID,
+		Text:                  text,
+		DisableWebPagePreview: true,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	reqURL := fmt.Sprintf("%s/bot%s/sendMessage", t.baseAPIUrl.String(), t.token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		// В url содержится токен бота, в лог и ошибку он попасть не должен
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to send message: %w", apierr.HandleRequestError(t.logger, err))
+	}
+	defer resp.Body.Close()
+
+	var result apiResponse
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return apierr.HandleStatusCodeError(t.logger, resp)
+		}
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if !result.Ok {
+		t.logger.Warnf("sendMessage failed: %d %s", result.ErrorCode, result.Description)
+		switch result.ErrorCode {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: %w", result.Description, apierr.AuthenticationFailedErr)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: %w", result.Description, apierr.ServiceUnavailableErr)
+		}
+		return fmt.Errorf("telegram error %d: %s", result.ErrorCode, result.Description)
+	}
+
+	return nil
+}
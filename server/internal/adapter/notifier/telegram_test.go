@@ -0,0 +1,93 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const testToken = "123:abc"
+
+func newTestTelegram(t *testing.T, handler func(w http.ResponseWriter, req sendMessageRequest)) *Telegram {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bot"+testToken+"/sendMessage" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":404,"description":"Not Found"}`))
+			return
+		}
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var req sendMessageRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		handler(w, req)
+	}))
+	t.Cleanup(server.Close)
+
+	tg, err := NewTelegram(log.NewLogger(log.DebugLevel), TelegramConfig{
+		Token:  testToken,
+		ChatID: "-100500",
+		ApiURL: server.URL + "/",
+	})
+	require.NoError(t, err)
+	return tg
+}
+
+func TestTelegram_Send(t *testing.T) {
+	var got sendMessageRequest
+	tg := newTestTelegram(t, func(w http.ResponseWriter, req sendMessageRequest) {
+		got = req
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1}}`))
+	})
+
+	require.NoError(t, tg.Send(context.Background(), "Сезон доставлен"))
+	require.Equal(t, sendMessageRequest{
+		ChatID:                "-100500",
+		Text:                  "Сезон доставлен",
+		DisableWebPagePreview: true,
+	}, got)
+}
+
+func TestTelegram_SendError(t *testing.T) {
+	t.Run("unauthorized", func(t *testing.T) {
+		tg := newTestTelegram(t, func(w http.ResponseWriter, _ sendMessageRequest) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":401,"description":"Unauthorized"}`))
+		})
+		require.ErrorIs(t, tg.Send(context.Background(), "text"), apierr.AuthenticationFailedErr)
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		tg := newTestTelegram(t, func(w http.ResponseWriter, _ sendMessageRequest) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5"}`))
+		})
+		require.ErrorIs(t, tg.Send(context.Background(), "text"), apierr.ServiceUnavailableErr)
+	})
+
+	t.Run("chat not found", func(t *testing.T) {
+		tg := newTestTelegram(t, func(w http.ResponseWriter, _ sendMessageRequest) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+		})
+		err := tg.Send(context.Background(), "text")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "chat not found")
+		require.NotContains(t, err.Error(), testToken)
+	})
+}
+
+func TestNewTelegram(t *testing.T) {
+	_, err := NewTelegram(log.NewLogger(log.DebugLevel), TelegramConfig{ChatID: "1"})
+	require.Error(t, err)
+	_, err = NewTelegram(log.NewLogger(log.DebugLevel), TelegramConfig{Token: testToken})
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package plex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+)
+
+// matchPath элемент лежит по указанному пути: каталог сериала или файл фильма
+func (m metadata) matchPath(itemPath string) bool {
+	for _, loc := range m.Location {
+		if path.Clean(loc.Path) == itemPath {
+			return true
+		}
+	}
+	for _, md := range m.Media {
+		for _, p := range md.Part {
+			if path.Clean(p.File) == itemPath {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// theMovieDbID id из guid вида tmdb://123 или com.plexapp.agents.themoviedb://123?lang=ru
+func (m metadata) theMovieDbID() uint64 {
+	guids := append([]guid{{ID: m.AgentGuid}}, m.Guid...)
+	for _, g := range guids {
+		id, ok := strings.CutPrefix(g.ID, "tmdb://")
+		if !ok {
+			id, ok = strings.CutPrefix(g.ID, "com.plexapp.agents.themoviedb://")
+		}
+		if !ok {
+			continue
+		}
+		id, _, _ = strings.Cut(id, "?")
+		if value, err := strconv.ParseUint(id, 10, 64); err == nil {
+			return value
+		}
+	}
+	return 0
+}
+
+// GetCatalogInfo информация о сериале или фильме по пути к нему
+// Если элемента еще нет, запускается частичное сканирование каталога и возвращается ContentNotFound
+func (api *API) GetCatalogInfo(itemPath string) (*emby.CatalogInfo, error) {
+	itemPath = path.Clean(itemPath)
+
+	section, err := api.sectionForPath(itemPath)
+	if err != nil {
+		return nil, fmt.Errorf("sectionForPath: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("includeGuids", "1")
+	query.Set("type", searchTypeShow)
+	if section.Type == sectionTypeMovie {
+		query.Set("type", searchTypeMovie)
+	}
+
+	var res metadataResponse
+	if err = api.do(http.MethodGet, "/library/sections/"+section.Key+"/all", query, &res); err != nil {
+		return nil, fmt.Errorf("get section items: %w", err)
+	}
+
+	var found []metadata
+	for _, item := range res.MediaContainer.Metadata {
+		if item.matchPath(itemPath) {
+			found = append(found, item)
+		}
+	}
+
+	if len(found) == 0 {
+		if errScan := api.ScanPath(path.Dir(itemPath)); errScan != nil && !errors.Is(errScan, apierr.ContentNotFound) {
+			api.logger.Warnf("ScanPath: %v", errScan)
+		}
+		return nil, apierr.ContentNotFound
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("multiple items found")
+	}
+
+	item := found[0]
+	id, err := strconv.ParseUint(item.RatingKey, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse item ratingKey: %w", err)
+	}
+
+	catalogType := emby.UnknownTypeCatalog
+	switch item.Type {
+	case "show":
+		catalogType = emby.SeriesTypeCatalog
+	case "season":
+		catalogType = emby.SeasonTypeCatalog
+	case "movie":
+		catalogType = emby.MovieTypeCatalog
+	}
+
+	return &emby.CatalogInfo{
+		Path:         itemPath,
+		Name:         item.Title,
+		ID:           id,
+		IsFolder:     len(item.Location) > 0,
+		Type:         catalogType,
+		TheMovieDbID: item.theMovieDbID(),
+	}, nil
+}
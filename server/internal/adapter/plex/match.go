@@ -0,0 +1,29 @@
+package plex
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ResetMetadata сбрасываем метч элемента
+func (api *API) ResetMetadata(embyID uint64) error {
+	reqPath := "/library/metadata/" + strconv.FormatUint(embyID, 10) + "/unmatch"
+	if err := api.do(http.MethodPut, reqPath, nil, nil); err != nil {
+		return fmt.Errorf("unmatch: %w", err)
+	}
+	return nil
+}
+
+// RemoteSearchApply "fix match" элемента на фильм/сериал themoviedb через guid агента
+func (api *API) RemoteSearchApply(embyID, theMovieDBID uint64) error {
+	query := url.Values{}
+	query.Set("guid", fmt.Sprintf(api.matchGuid, theMovieDBID))
+
+	reqPath := "/library/metadata/" + strconv.FormatUint(embyID, 10) + "/match"
+	if err := api.do(http.MethodPut, reqPath, query, nil); err != nil {
+		return fmt.Errorf("match: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package plex
+
+type location struct {
+	Path string `json:"path"`
+}
+
+type directory struct {
+	Key      string     `json:"key"`
+	Type     string     `json:"type"`
+	Title    string     `json:"title"`
+	Location []location `json:"Location"`
+}
+
+type sectionsResponse struct {
+	MediaContainer struct {
+		Directory []directory `json:"Directory"`
+	} `json:"MediaContainer"`
+}
+
+type guid struct {
+	ID string `json:"id"`
+}
+
+type part struct {
+	File string `json:"file"`
+}
+
+type media struct {
+	Part []part `json:"Part"`
+}
+
+type metadata struct {
+	RatingKey string `json:"ratingKey"`
+	Title     string `json:"title"`
+	Type      string `json:"type"`
+	// AgentGuid guid основного агента, для устаревших агентов содержит id themoviedb
+	AgentGuid string     `json:"guid"`
+	Guid      []guid     `json:"Guid"`
+	Location  []location `json:"Location"`
+	Media     []media    `json:"Media"`
+}
+
+type metadataResponse struct {
+	MediaContainer struct {
+		Metadata []metadata `json:"Metadata"`
+	} `json:"MediaContainer"`
+}
+
+// Типы элементов библиотеки plex
+const (
+	sectionTypeShow  = "show"
+	sectionTypeMovie = "movie"
+	// searchTypeMovie, searchTypeShow значение параметра type в /library/sections/{key}/all
+	searchTypeMovie = "1"
+	searchTypeShow  = "2"
+)
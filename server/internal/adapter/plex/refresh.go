@@ -0,0 +1,32 @@
+package plex
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// Refresh обновим медиатеку (сканирование всех библиотек)
+func (api *API) Refresh() error {
+	if err := api.do(http.MethodGet, "/library/sections/all/refresh", nil, nil); err != nil {
+		return fmt.Errorf("refresh sections: %w", err)
+	}
+	return nil
+}
+
+// ScanPath частичное сканирование библиотеки только по указанному каталогу
+func (api *API) ScanPath(itemPath string) error {
+	section, err := api.sectionForPath(itemPath)
+	if err != nil {
+		return fmt.Errorf("sectionForPath: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("path", path.Clean(itemPath))
+
+	if err = api.do(http.MethodGet, "/library/sections/"+section.Key+"/refresh", query, nil); err != nil {
+		return fmt.Errorf("partial scan: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package plex
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+func (api *API) getSections() ([]directory, error) {
+	var res sectionsResponse
+	if err := api.do(http.MethodGet, "/library/sections", nil, &res); err != nil {
+		return nil, fmt.Errorf("get sections: %w", err)
+	}
+	return res.MediaContainer.Directory, nil
+}
+
+// isSubPath путь itemPath находится внутри root (или совпадает с ним)
+func isSubPath(root, itemPath string) bool {
+	root = path.Clean(root)
+	itemPath = path.Clean(itemPath)
+	return itemPath == root || strings.HasPrefix(itemPath, strings.TrimSuffix(root, "/")+"/")
+}
+
+// sectionForPath библиотека, в каталогах которой лежит путь
+func (api *API) sectionForPath(itemPath string) (*directory, error) {
+	sections, err := api.getSections()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, section := range sections {
+		if section.Type != sectionTypeShow && section.Type != sectionTypeMovie {
+			continue
+		}
+		for _, loc := range section.Location {
+			if isSubPath(loc.Path, itemPath) {
+				return &section, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("section for path %s: %w", itemPath, apierr.ContentNotFound)
+}
@@ -0,0 +1,93 @@
+package plex
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const (
+	requestTimeout = 60 * time.Second
+	// defaultMatchGuid агент через который применяется метч, %d - id в themoviedb
+	defaultMatchGuid = "com.plexapp.agents.themoviedb://%d?lang=ru"
+)
+
+// Config настройки подключения к Plex Media Server
+type Config struct {
+	// BaseURL адрес сервера, например http://localhost:32400
+	BaseURL string
+	// Token X-Plex-Token
+	Token string
+	// MatchGuid формат guid агента для "fix match", по умолчанию themoviedb агент
+	MatchGuid string
+}
+
+// API клиент Plex, реализует те же методы что и emby.API
+// Идентификатор элемента это ratingKey, он числовой и отдается как есть
+type API struct {
+	token      string
+	matchGuid  string
+	baseAPIUrl *url.URL
+	httpClient *http.Client
+	logger     log.Logger
+}
+
+func NewApi(cfg Config, logger log.Logger) (*API, error) {
+	urlApi, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %w", err)
+	}
+
+	matchGuid := cfg.MatchGuid
+	if matchGuid == "" {
+		matchGuid = defaultMatchGuid
+	}
+
+	return &API{
+		token:      cfg.Token,
+		matchGuid:  matchGuid,
+		baseAPIUrl: urlApi,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logger:     logger.Named("plex_api"),
+	}, nil
+}
+
+// do выполняет запрос, result - куда декодировать json ответа (может быть nil)
+func (api *API) do(method, path string, query url.Values, result any) error {
+	reqUrl := api.baseAPIUrl.JoinPath(path)
+	if query != nil {
+		reqUrl.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequest(method, reqUrl.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Plex-Token", api.token)
+	// Без заголовка plex отвечает в xml
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to plex request: %w", apierr.HandleRequestError(api.logger, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apierr.HandleStatusCodeError(api.logger, resp)
+	}
+
+	if result != nil {
+		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return nil
+}
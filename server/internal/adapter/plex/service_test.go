@@ -0,0 +1,132 @@
+package plex
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+)
+
+const testToken = "secret"
+
+const sectionsJSON = `{"MediaContainer": {"Directory": [
+  {"key": "1", "type": "movie", "title": "Фильмы", "Location": [{"id": 1, "path": "/movies"}]},
+  {"key": "2", "type": "show", "title": "Сериалы", "Location": [{"id": 2, "path": "/tvshows"}]},
+  {"key": "3", "type": "artist", "title": "Музыка", "Location": [{"id": 3, "path": "/music"}]}
+]}}`
+
+const showsJSON = `{"MediaContainer": {"Metadata": [
+  {"ratingKey": "101", "title": "Dark", "type": "show", "guid": "plex://show/5d9c08", "Guid": [{"id": "imdb://tt5753856"}, {"id": "tmdb://70523"}], "Location": [{"path": "/tvshows/Dark (2017)"}]},
+  {"ratingKey": "102", "title": "Unmatched", "type": "show", "guid": "local://102", "Location": [{"path": "/tvshows/Unmatched"}]}
+]}}`
+
+const moviesJSON = `{"MediaContainer": {"Metadata": [
+  {"ratingKey": "201", "title": "Dune", "type": "movie", "guid": "com.plexapp.agents.themoviedb://438631?lang=ru", "Media": [{"Part": [{"file": "/movies/Dune (2021)/Dune (2021).mkv"}]}]}
+]}}`
+
+type request struct {
+	Method string
+	Path   string
+	Query  string
+}
+
+func newTestApi(t *testing.T) (*API, *[]request) {
+	t.Helper()
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Plex-Token") != testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests = append(requests, request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery})
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/library/sections":
+			_, _ = w.Write([]byte(sectionsJSON))
+		case r.URL.Path == "/library/sections/1/all":
+			_, _ = w.Write([]byte(moviesJSON))
+		case r.URL.Path == "/library/sections/2/all":
+			_, _ = w.Write([]byte(showsJSON))
+		case r.Method == http.MethodGet && (r.URL.Path == "/library/sections/all/refresh" || r.URL.Path == "/library/sections/2/refresh"):
+		case r.Method == http.MethodPut && (r.URL.Path == "/library/metadata/102/unmatch" || r.URL.Path == "/library/metadata/102/match"):
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	api, err := NewApi(Config{BaseURL: server.URL, Token: testToken}, log.NewLogger(log.DebugLevel))
+	require.NoError(t, err)
+	return api, &requests
+}
+
+func TestAPI_GetCatalogInfo(t *testing.T) {
+	api, requests := newTestApi(t)
+
+	show, err := api.GetCatalogInfo("/tvshows/Dark (2017)/")
+	require.NoError(t, err)
+	require.Equal(t, &emby.CatalogInfo{
+		Path:         "/tvshows/Dark (2017)",
+		Name:         "Dark",
+		ID:           101,
+		IsFolder:     true,
+		Type:         emby.SeriesTypeCatalog,
+		TheMovieDbID: 70523,
+	}, show)
+	require.Equal(t, "includeGuids=1&type=2", (*requests)[1].Query)
+
+	movie, err := api.GetCatalogInfo("/movies/Dune (2021)/Dune (2021).mkv")
+	require.NoError(t, err)
+	require.Equal(t, emby.MovieTypeCatalog, movie.Type)
+	require.Equal(t, uint64(438631), movie.TheMovieDbID)
+	require.False(t, movie.IsFolder)
+
+	unmatched, err := api.GetCatalogInfo("/tvshows/Unmatched")
+	require.NoError(t, err)
+	require.Zero(t, unmatched.TheMovieDbID)
+
+	_, err = api.GetCatalogInfo("/music/Album")
+	require.ErrorIs(t, err, apierr.ContentNotFound)
+}
+
+func TestAPI_GetCatalogInfo_ScanMissing(t *testing.T) {
+	api, requests := newTestApi(t)
+
+	// Сериал удален или еще не просканирован - запускаем частичное сканирование каталога
+	_, err := api.GetCatalogInfo("/tvshows/New Show")
+	require.ErrorIs(t, err, apierr.ContentNotFound)
+
+	last := (*requests)[len(*requests)-1]
+	require.Equal(t, request{Method: http.MethodGet, Path: "/library/sections/2/refresh", Query: "path=%2Ftvshows"}, last)
+}
+
+func TestAPI_Match(t *testing.T) {
+	api, requests := newTestApi(t)
+
+	require.NoError(t, api.Refresh())
+	require.NoError(t, api.ResetMetadata(102))
+	require.NoError(t, api.RemoteSearchApply(102, 70523))
+
+	require.Equal(t, []request{
+		{Method: http.MethodGet, Path: "/library/sections/all/refresh"},
+		{Method: http.MethodPut, Path: "/library/metadata/102/unmatch"},
+		{Method: http.MethodPut, Path: "/library/metadata/102/match", Query: "guid=com.plexapp.agents.themoviedb%3A%2F%2F70523%3Flang%3Dru"},
+	}, *requests)
+
+	require.ErrorIs(t, api.ResetMetadata(999), apierr.ContentNotFound)
+}
+
+func TestAPI_ScanPath(t *testing.T) {
+	api, requests := newTestApi(t)
+
+	require.NoError(t, api.ScanPath("/tvshows/Dark (2017)/Season 01"))
+	require.Equal(t, "path=%2Ftvshows%2FDark+%282017%29%2FSeason+01", (*requests)[1].Query)
+
+	require.ErrorIs(t, api.ScanPath("/downloads/Dark"), apierr.ContentNotFound)
+}
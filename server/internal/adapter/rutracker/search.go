@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
@@ -12,14 +14,49 @@ import (
 	"github.com/kkiling/media-delivery/internal/adapter/apierr"
 )
 
-func (api *Api) SearchTorrents(query string) (*TorrentResponse, error) {
-	if err := api.login(); err != nil {
-		return nil, fmt.Errorf("failed to login: %w", err)
+var totalResultsRe = regexp.MustCompile(`Результатов поиска:\s*(\d+)`)
+
+// searchPage разобранная страница выдачи поиска
+type searchPage struct {
+	Results []Torrent
+	// TotalResults сколько всего раздач нашел трекер
+	TotalResults int
+	// SearchID идентификатор поиска, по нему запрашиваются следующие страницы
+	SearchID string
+}
+
+// setForumFilter ограничивает поиск форумами из конфига
+func (api *Api) setForumFilter(params url.Values) {
+	if len(api.forumIDs) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(api.forumIDs))
+	for _, id := range api.forumIDs {
+		ids = append(ids, strconv.Itoa(id))
 	}
+	params.Set("f", strings.Join(ids, ","))
+}
 
-	api.logger.Debugf("Search torrents: %s", query)
+func (api *Api) firstPageURL(query string) string {
+	params := url.Values{}
+	params.Set("nm", query)
+	api.setForumFilter(params)
+	return api.baseAPIUrl.String() + "tracker.php?" + params.Encode()
+}
 
-	searchURL := api.baseAPIUrl.String() + "tracker.php?nm=" + url.QueryEscape(query)
+func (api *Api) nextPageURL(query, searchID string, start int) string {
+	params := url.Values{}
+	if searchID != "" {
+		params.Set("search_id", searchID)
+	} else {
+		params.Set("nm", query)
+		api.setForumFilter(params)
+	}
+	params.Set("start", strconv.Itoa(start))
+	return api.baseAPIUrl.String() + "tracker.php?" + params.Encode()
+}
+
+func (api *Api) fetchSearchPage(searchURL string) (*searchPage, error) {
 	resp, err := api.httpClient.Get(searchURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to tvshowlibrary torrents: %w", apierr.HandleRequestError(api.logger, err))
@@ -36,18 +73,71 @@ func (api *Api) SearchTorrents(query string) (*TorrentResponse, error) {
 		return nil, fmt.Errorf("failed to create document: %v", err)
 	}
 
+	return api.parseSearchPage(doc), nil
+}
+
+func (api *Api) SearchTorrents(query string) (*TorrentResponse, error) {
+	if err := api.login(); err != nil {
+		return nil, fmt.Errorf("failed to login: %w", err)
+	}
+
+	api.logger.Debugf("Search torrents: %s", query)
+
+	first, err := api.fetchSearchPage(api.firstPageURL(query))
+	if err != nil {
+		return nil, err
+	}
+	if len(first.Results) == 0 {
+		return emptyTorrentResponse(), nil
+	}
+
+	results := first.Results
+	totalResults := first.TotalResults
+	pageSize := len(first.Results)
+	page := 1
+	// Идем по страницам выдачи (start=), пока не наберем все результаты или не упремся в лимит
+	for page < api.maxPages && len(results) < totalResults {
+		next, err := api.fetchSearchPage(api.nextPageURL(query, first.SearchID, page*pageSize))
+		if err != nil {
+			// Уже прочитанные страницы не теряем, остальные раздачи считаем недоступными
+			api.logger.Warnf("Search torrents %s, page %d: %v", query, page+1, err)
+			totalResults = len(results)
+			break
+		}
+		if len(next.Results) == 0 {
+			break
+		}
+		results = append(results, next.Results...)
+		page++
+	}
+
+	// Сортировка по downloads (в порядке убывания)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Downloads > results[j].Downloads
+	})
+
+	return &TorrentResponse{
+		Results:      results,
+		Page:         page,
+		TotalResults: max(totalResults, len(results)),
+	}, nil
+}
+
+func (api *Api) parseSearchPage(doc *goquery.Document) *searchPage {
+	page := &searchPage{
+		Results: []Torrent{},
+	}
+
 	table := doc.Find("table#tor-tbl")
 	if table.Length() == 0 {
-		return emptyTorrentResponse(), nil
+		return page
 	}
 
 	firstRow := table.Find("tbody tr").First()
 	if firstRow.Find("td").First().Text() == "Не найдено" {
-		return emptyTorrentResponse(), nil
+		return page
 	}
 
-	var results []Torrent
-
 	table.Find("tbody tr").Each(func(i int, row *goquery.Selection) {
 		cols := row.Find("td")
 		if cols.Length() < 10 {
@@ -79,7 +169,7 @@ func (api *Api) SearchTorrents(query string) (*TorrentResponse, error) {
 			size = 0
 		}
 
-		results = append(results, Torrent{
+		page.Results = append(page.Results, Torrent{
 			Title:      strings.TrimSpace(title),
 			Href:       href,
 			Category:   strings.TrimSpace(forum),
@@ -93,14 +183,26 @@ func (api *Api) SearchTorrents(query string) (*TorrentResponse, error) {
 		})
 	})
 
-	// Сортировка по downloads (в порядке убывания)
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Downloads > results[j].Downloads
+	// Общее количество результатов: "Результатов поиска: 500 (max: 500)"
+	page.TotalResults = len(page.Results)
+	if match := totalResultsRe.FindStringSubmatch(doc.Text()); len(match) > 1 {
+		if total, err := strconv.Atoi(match[1]); err == nil && total > page.TotalResults {
+			page.TotalResults = total
+		}
+	}
+
+	// Ссылки пагинации вида tracker.php?search_id=XXX&start=50
+	doc.Find("a.pg").EachWithBreak(func(_ int, link *goquery.Selection) bool {
+		u, err := url.Parse(link.AttrOr("href", ""))
+		if err != nil {
+			return true
+		}
+		if searchID := u.Query().Get("search_id"); searchID != "" {
+			page.SearchID = searchID
+			return false
+		}
+		return true
 	})
 
-	return &TorrentResponse{
-		Results:      results,
-		Page:         1,
-		TotalResults: len(results),
-	}, nil
+	return page
 }
@@ -0,0 +1,161 @@
+package rutracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func newTestApi(t *testing.T, baseURL string, maxPages int, forumIDs []int) *Api {
+	t.Helper()
+	baseAPIUrl, err := url.Parse(baseURL)
+	require.NoError(t, err)
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+
+	// Сохраненные куки, что бы не ходить на login.php
+	cookiesDir := t.TempDir()
+	cookies, err := json.Marshal([]*http.Cookie{{Name: "bb_session", Value: "test"}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(cookiesDir, cookeFile), cookies, 0o600))
+
+	return &Api{
+		cookiesDir: cookiesDir,
+		maxPages:   maxPages,
+		forumIDs:   forumIDs,
+		baseAPIUrl: baseAPIUrl,
+		httpClient: &http.Client{Jar: jar},
+		logger:     log.NewLogger(log.WarnLevel),
+	}
+}
+
+func TestApi_parseSearchPage(t *testing.T) {
+	api := newTestApi(t, apiUrl, defaultMaxPages, nil)
+
+	t.Run("first page", func(t *testing.T) {
+		doc, err := readerDocument(bytes.NewReader(readFixture(t, "search_page1.html")))
+		require.NoError(t, err)
+
+		page := api.parseSearchPage(doc)
+		require.Equal(t, 5, page.TotalResults)
+		require.Equal(t, "AbCdEf12", page.SearchID)
+		require.Len(t, page.Results, 3)
+		require.Equal(t, Torrent{
+			Title:      "Тьма / Dark / Сезон: 1-3 / Серии: 1-26 из 26 [2017-2020, WEB-DLRip]",
+			Href:       apiUrl + "viewtopic.php?t=6002",
+			Author:     "seeder2",
+			SizeBytes:  12133282611,
+			SizePretty: "11.30GB",
+			Seeds:      80,
+			Leeches:    2,
+			Downloads:  12345,
+			AddedDate:  "28-Июн-20",
+			Category:   "Зарубежные сериалы",
+		}, page.Results[1])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		doc, err := readerDocument(bytes.NewReader(readFixture(t, "search_not_found.html")))
+		require.NoError(t, err)
+
+		page := api.parseSearchPage(doc)
+		require.Empty(t, page.Results)
+		require.Equal(t, 0, page.TotalResults)
+	})
+}
+
+func TestApi_SearchTorrents(t *testing.T) {
+	var requests []url.Values
+	var nextPageErr bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/forum/tracker.php", r.URL.Path)
+		query := r.URL.Query()
+		requests = append(requests, query)
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		switch {
+		case query.Get("nm") == "Тьма":
+			_, _ = w.Write(readFixture(t, "search_page1.html"))
+		case query.Get("nm") == "Нет такого":
+			_, _ = w.Write(readFixture(t, "search_not_found.html"))
+		case query.Get("search_id") == "AbCdEf12" && nextPageErr:
+			w.WriteHeader(http.StatusInternalServerError)
+		case query.Get("search_id") == "AbCdEf12" && query.Get("start") == "3":
+			_, _ = w.Write(readFixture(t, "search_page2.html"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("all pages", func(t *testing.T) {
+		requests = nil
+		api := newTestApi(t, server.URL+"/forum/", defaultMaxPages, []int{189, 2366})
+
+		res, err := api.SearchTorrents("Тьма")
+		require.NoError(t, err)
+		require.Equal(t, 5, res.TotalResults)
+		require.Equal(t, 2, res.Page)
+		require.Len(t, res.Results, 5)
+		// Сортировка по количеству скачиваний
+		require.Equal(t, uint32(12345), res.Results[0].Downloads)
+		require.Equal(t, uint32(300), res.Results[4].Downloads)
+
+		require.Len(t, requests, 2)
+		require.Equal(t, "189,2366", requests[0].Get("f"))
+	})
+
+	t.Run("pages limit", func(t *testing.T) {
+		requests = nil
+		api := newTestApi(t, server.URL+"/forum/", 1, nil)
+
+		res, err := api.SearchTorrents("Тьма")
+		require.NoError(t, err)
+		require.Equal(t, 5, res.TotalResults)
+		require.Equal(t, 1, res.Page)
+		require.Len(t, res.Results, 3)
+
+		require.Len(t, requests, 1)
+		require.Empty(t, requests[0].Get("f"))
+	})
+
+	t.Run("next page error", func(t *testing.T) {
+		requests = nil
+		nextPageErr = true
+		defer func() { nextPageErr = false }()
+		api := newTestApi(t, server.URL+"/forum/", defaultMaxPages, nil)
+
+		res, err := api.SearchTorrents("Тьма")
+		require.NoError(t, err)
+		// Остаются раздачи с первой страницы
+		require.Equal(t, 3, res.TotalResults)
+		require.Equal(t, 1, res.Page)
+		require.Len(t, res.Results, 3)
+
+		require.Len(t, requests, 2)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		api := newTestApi(t, server.URL+"/forum/", defaultMaxPages, nil)
+
+		res, err := api.SearchTorrents("Нет такого")
+		require.NoError(t, err)
+		require.Empty(t, res.Results)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
+	"strings"
 
 	"github.com/kkiling/goplatform/log"
 )
@@ -12,12 +13,16 @@ import (
 const (
 	cookeFile = "rutracker_cookies.gob"
 	apiUrl    = "https://rutracker.org/forum/"
+	// defaultMaxPages сколько страниц выдачи читаем, если не задано в конфиге
+	defaultMaxPages = 3
 )
 
 type Api struct {
 	username   string
 	password   string
 	cookiesDir string
+	maxPages   int
+	forumIDs   []int
 	baseAPIUrl *url.URL
 	httpClient *http.Client
 	logger     log.Logger
@@ -28,6 +33,10 @@ type Config struct {
 	Password   string
 	CookiesDir string
 	ProxyURL   *string
+	// MaxPages максимальное количество страниц выдачи, которые читаем при поиске
+	MaxPages int
+	// ForumIDs ищем только в указанных форумах (пусто - по всему трекеру)
+	ForumIDs []int
 }
 
 func NewApi(logger log.Logger, cfg Config) (*Api, error) {
@@ -57,12 +66,24 @@ func NewApi(logger log.Logger, cfg Config) (*Api, error) {
 		logThis.Infof("proxy configured")
 	}
 
+	maxPages := cfg.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultMaxPages
+	}
+
 	return &Api{
 		username:   cfg.Username,
 		password:   cfg.Password,
 		cookiesDir: cfg.CookiesDir,
+		maxPages:   maxPages,
+		forumIDs:   cfg.ForumIDs,
 		baseAPIUrl: baseAPIUrl,
 		httpClient: &http.Client{Jar: jar},
 		logger:     logThis,
 	}, nil
 }
+
+// IsOwnLink ссылка на раздачу принадлежит rutracker
+func (api *Api) IsOwnLink(torrentUrl string) bool {
+	return strings.HasPrefix(torrentUrl, api.baseAPIUrl.String())
+}
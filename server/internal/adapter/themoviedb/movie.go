@@ -1,6 +1,7 @@
 package themoviedb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,7 +11,7 @@ import (
 	"github.com/kkiling/media-delivery/internal/adapter/apierr"
 )
 
-func (api *API) GetMovie(movieID uint64, language Language) (*Movie, error) {
+func (api *API) GetMovie(_ context.Context, movieID uint64, language Language) (*Movie, error) {
 	queryParams := url.Values{}
 	queryParams.Add("api_key", api.apiKey)
 	queryParams.Add("language", string(language))
@@ -79,6 +80,7 @@ func (api *API) GetMovie(movieID uint64, language Language) (*Movie, error) {
 		Backdrop:         api.getImage(result.Backdrop),
 		Budget:           result.Budget,
 		Genres:           genres,
+		ImdbID:           result.ImdbID,
 		OriginCountry:    result.OriginCountry,
 		OriginalLanguage: result.OriginalLanguage,
 		Revenue:          result.Revenue,
@@ -1,6 +1,7 @@
 package themoviedb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,10 +19,10 @@ func (api *API) searchMovie(params SearchQuery) (*MovieSearchResponse, error) {
 	queryParams.Add("language", string(params.Language))
 	queryParams.Add("page", fmt.Sprintf("%d", params.Page))
 
-	getUrl := fmt.Sprintf("%s/tvshowlibrary/movie?%s", api.baseAPIUrl.String(), queryParams.Encode())
+	getUrl := fmt.Sprintf("%s/search/movie?%s", api.baseAPIUrl.String(), queryParams.Encode())
 	resp, err := api.httpClient.Get(getUrl)
 	if err != nil {
-		return nil, fmt.Errorf("failed to tvshowlibrary movies: %w", apierr.HandleRequestError(api.logger, err))
+		return nil, fmt.Errorf("failed to search movies: %w", apierr.HandleRequestError(api.logger, err))
 	}
 	defer resp.Body.Close()
 
@@ -77,7 +78,7 @@ func (api *API) searchMovie(params SearchQuery) (*MovieSearchResponse, error) {
 }
 
 // SearchMovie searches for movies with sorting by popularity
-func (api *API) SearchMovie(params SearchQuery) (*MovieSearchResponse, error) {
+func (api *API) SearchMovie(_ context.Context, params SearchQuery) (*MovieSearchResponse, error) {
 	if err := api.validate.Struct(params); err != nil {
 		return nil, fmt.Errorf("invalid tvshowlibrary query: %w", err)
 	}
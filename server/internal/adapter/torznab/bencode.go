@@ -0,0 +1,147 @@
+package torznab
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var errInvalidBencode = errors.New("invalid bencode")
+
+// torrentMeta данные .torrent файла, необходимые для построения magnet ссылки
+type torrentMeta struct {
+	Hash     string
+	Name     string
+	Announce []string
+}
+
+// bdecoder минимальный разбор bencode: достаточно чтобы найти словарь info и список трекеров
+type bdecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *bdecoder) next() (any, error) {
+	if d.pos >= len(d.data) {
+		return nil, errInvalidBencode
+	}
+	switch c := d.data[d.pos]; {
+	case c == 'i':
+		end := bytes.IndexByte(d.data[d.pos:], 'e')
+		if end < 0 {
+			return nil, errInvalidBencode
+		}
+		v, err := strconv.ParseInt(string(d.data[d.pos+1:d.pos+end]), 10, 64)
+		if err != nil {
+			return nil, errInvalidBencode
+		}
+		d.pos += end + 1
+		return v, nil
+	case c == 'l':
+		d.pos++
+		var list []any
+		for d.pos < len(d.data) && d.data[d.pos] != 'e' {
+			v, err := d.next()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		d.pos++
+		return list, nil
+	case c == 'd':
+		d.pos++
+		dict := map[string]any{}
+		for d.pos < len(d.data) && d.data[d.pos] != 'e' {
+			key, err := d.next()
+			if err != nil {
+				return nil, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, errInvalidBencode
+			}
+			start := d.pos
+			v, err := d.next()
+			if err != nil {
+				return nil, err
+			}
+			// info hash считается от исходных байт словаря info
+			if k == "info" {
+				dict["info_raw"] = d.data[start:d.pos]
+			}
+			dict[k] = v
+		}
+		d.pos++
+		return dict, nil
+	case c >= '0' && c <= '9':
+		colon := bytes.IndexByte(d.data[d.pos:], ':')
+		if colon < 0 {
+			return nil, errInvalidBencode
+		}
+		n, err := strconv.Atoi(string(d.data[d.pos : d.pos+colon]))
+		if err != nil || n < 0 {
+			return nil, errInvalidBencode
+		}
+		start := d.pos + colon + 1
+		if start+n > len(d.data) {
+			return nil, errInvalidBencode
+		}
+		d.pos = start + n
+		return string(d.data[start:d.pos]), nil
+	default:
+		return nil, errInvalidBencode
+	}
+}
+
+func parseTorrentFile(data []byte) (*torrentMeta, error) {
+	d := &bdecoder{data: data}
+	v, err := d.next()
+	if err != nil {
+		return nil, err
+	}
+	root, ok := v.(map[string]any)
+	if !ok {
+		return nil, errInvalidBencode
+	}
+	infoRaw, ok := root["info_raw"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("info dict not found: %w", errInvalidBencode)
+	}
+
+	sum := sha1.Sum(infoRaw)
+	meta := &torrentMeta{
+		Hash: strings.ToUpper(hex.EncodeToString(sum[:])),
+	}
+	if info, ok := root["info"].(map[string]any); ok {
+		meta.Name, _ = info["name"].(string)
+	}
+	if announce, ok := root["announce"].(string); ok {
+		meta.Announce = append(meta.Announce, announce)
+	}
+	if tiers, ok := root["announce-list"].([]any); ok {
+		for _, tier := range tiers {
+			list, _ := tier.([]any)
+			for _, item := range list {
+				if s, ok := item.(string); ok && s != "" && !contains(meta.Announce, s) {
+					meta.Announce = append(meta.Announce, s)
+				}
+			}
+		}
+	}
+
+	return meta, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package torznab
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+// maxTorrentFileSize ограничение на размер скачиваемого .torrent файла
+const maxTorrentFileSize = 10 << 20
+
+func isMagnet(link string) bool {
+	return strings.HasPrefix(strings.ToLower(link), "magnet:")
+}
+
+func magnetInfo(magnet string) (*MagnetInfo, error) {
+	hash, err := hashFromMagnet(magnet)
+	if err != nil {
+		return nil, fmt.Errorf("hashFromMagnet: %w", err)
+	}
+	return &MagnetInfo{
+		Magnet: magnet,
+		Hash:   hash,
+	}, nil
+}
+
+func (api *Api) GetMagnetLink(torrentUrl string) (*MagnetInfo, error) {
+	api.logger.Debugf("Get magnet link: %s", torrentUrl)
+
+	if isMagnet(torrentUrl) {
+		return magnetInfo(torrentUrl)
+	}
+
+	if !api.IsOwnLink(torrentUrl) {
+		return nil, errors.New("invalid torrent URL")
+	}
+
+	resp, err := api.httpClient.Get(torrentUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get magnet link: %w", apierr.HandleRequestError(api.logger, err))
+	}
+	defer resp.Body.Close()
+
+	// Jackett/Prowlarr отдают redirect на magnet, если у раздачи нет .torrent файла
+	if resp.StatusCode >= http.StatusMultipleChoices && resp.StatusCode < http.StatusBadRequest {
+		location := resp.Header.Get("Location")
+		if !isMagnet(location) {
+			return nil, fmt.Errorf("unexpected redirect: %s", location)
+		}
+		return magnetInfo(location)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierr.HandleStatusCodeError(api.logger, resp)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTorrentFileSize))
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	meta, err := parseTorrentFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("parseTorrentFile: %w", err)
+	}
+
+	return &MagnetInfo{
+		Magnet: buildMagnet(meta),
+		Hash:   meta.Hash,
+	}, nil
+}
+
+func buildMagnet(meta *torrentMeta) string {
+	params := url.Values{}
+	if meta.Name != "" {
+		params.Set("dn", meta.Name)
+	}
+	for _, tr := range meta.Announce {
+		params.Add("tr", tr)
+	}
+	magnet := "magnet:?xt=urn:btih:" + meta.Hash
+	if len(params) > 0 {
+		magnet += "&" + params.Encode()
+	}
+	return magnet
+}
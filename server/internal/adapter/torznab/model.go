@@ -0,0 +1,82 @@
+package torznab
+
+import "encoding/xml"
+
+type Torrent struct {
+	// Title наименование
+	Title string
+	// Href ссылка на раздачу (magnet или ссылка на .torrent файл)
+	Href string
+	// Indexer трекер, на котором найдена раздача (для агрегаторов)
+	Indexer string
+	// Size Размер раздачи (байты)
+	SizeBytes uint64
+	// Размер виде строки (32Gb)
+	SizePretty string
+	// Seeds Информация о сидах
+	Seeds uint32
+	// Leeches Информация о личах
+	Leeches uint32
+	// Downloads количество скачиваний
+	Downloads uint32
+	// AddedDate Дата добавления
+	AddedDate string
+	// Категория
+	Category string
+}
+
+type TorrentResponse struct {
+	Page         int
+	TotalResults int
+	Results      []Torrent
+}
+
+type MagnetInfo struct {
+	Magnet string
+	Hash   string
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Response *rssResponse `xml:"response"`
+	Items    []rssItem    `xml:"item"`
+}
+
+type rssResponse struct {
+	Offset int `xml:"offset,attr"`
+	Total  int `xml:"total,attr"`
+}
+
+type rssItem struct {
+	Title      string        `xml:"title"`
+	Guid       string        `xml:"guid"`
+	Link       string        `xml:"link"`
+	Comments   string        `xml:"comments"`
+	PubDate    string        `xml:"pubDate"`
+	Size       uint64        `xml:"size"`
+	Categories []string      `xml:"category"`
+	Indexer    string        `xml:"jackettindexer"`
+	Enclosure  *rssEnclosure `xml:"enclosure"`
+	Attrs      []torznabAttr `xml:"attr"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length uint64 `xml:"length,attr"`
+}
+
+type torznabAttr struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// apiError ошибка, которую torznab отдает вместо rss
+type apiError struct {
+	XMLName     xml.Name `xml:"error"`
+	Code        int      `xml:"code,attr"`
+	Description string   `xml:"description,attr"`
+}
@@ -0,0 +1,157 @@
+package torznab
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+func (api *Api) searchURL(query string) string {
+	params := url.Values{}
+	params.Set("t", "search")
+	params.Set("apikey", api.apiKey)
+	params.Set("q", query)
+	if len(api.categories) > 0 {
+		cats := make([]string, 0, len(api.categories))
+		for _, c := range api.categories {
+			cats = append(cats, strconv.Itoa(c))
+		}
+		params.Set("cat", strings.Join(cats, ","))
+	}
+
+	u := *api.baseAPIUrl
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/api"
+	u.RawQuery = params.Encode()
+	return u.String()
+}
+
+func (api *Api) SearchTorrents(query string) (*TorrentResponse, error) {
+	api.logger.Debugf("Search torrents: %s", query)
+
+	resp, err := api.httpClient.Get(api.searchURL(query))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search torrents: %w", apierr.HandleRequestError(api.logger, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apierr.HandleStatusCodeError(api.logger, resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if err := parseApiError(body); err != nil {
+		return nil, err
+	}
+
+	var feed rssFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		return nil, fmt.Errorf("xml.Unmarshal: %w", err)
+	}
+
+	results := make([]Torrent, 0, len(feed.Channel.Items))
+	for _, item := range feed.Channel.Items {
+		results = append(results, itemToTorrent(item))
+	}
+
+	// Сортировка по downloads (в порядке убывания)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Downloads > results[j].Downloads
+	})
+
+	total := len(results)
+	if feed.Channel.Response != nil && feed.Channel.Response.Total > total {
+		total = feed.Channel.Response.Total
+	}
+
+	return &TorrentResponse{
+		Results:      results,
+		Page:         1,
+		TotalResults: total,
+	}, nil
+}
+
+func parseApiError(body []byte) error {
+	var apiErr apiError
+	if xml.Unmarshal(body, &apiErr) != nil {
+		return nil
+	}
+	// https://torznab.github.io/spec-1.3-draft/torznab/Specification-v1.3.html#error-codes
+	if apiErr.Code >= 100 && apiErr.Code < 200 {
+		return fmt.Errorf("%s: %w", apiErr.Description, apierr.NotAuthorizedErr)
+	}
+	return fmt.Errorf("torznab error %d: %s", apiErr.Code, apiErr.Description)
+}
+
+func itemToTorrent(item rssItem) Torrent {
+	attrs := make(map[string]string, len(item.Attrs))
+	for _, attr := range item.Attrs {
+		attrs[attr.Name] = attr.Value
+	}
+
+	size := item.Size
+	if size == 0 {
+		if v, err := strconv.ParseUint(attrs["size"], 10, 64); err == nil {
+			size = v
+		} else if item.Enclosure != nil {
+			size = item.Enclosure.Length
+		}
+	}
+
+	seeds := attrUint32(attrs, "seeders")
+	// peers у torznab это сиды + личи
+	var leeches uint32
+	if peers := attrUint32(attrs, "peers"); peers > seeds {
+		leeches = peers - seeds
+	}
+
+	// Приоритет у magnet ссылки, затем ссылка на .torrent файл
+	href := attrs["magneturl"]
+	if href == "" {
+		href = item.Link
+	}
+	if href == "" && item.Enclosure != nil {
+		href = item.Enclosure.URL
+	}
+
+	return Torrent{
+		Title:      strings.TrimSpace(item.Title),
+		Href:       href,
+		Indexer:    strings.TrimSpace(item.Indexer),
+		SizeBytes:  size,
+		SizePretty: formatBytesWithPrecision(size, 2),
+		Seeds:      seeds,
+		Leeches:    leeches,
+		Downloads:  attrUint32(attrs, "grabs"),
+		AddedDate:  formatPubDate(item.PubDate),
+		Category:   categoryName(item.Categories),
+	}
+}
+
+func attrUint32(attrs map[string]string, name string) uint32 {
+	v, err := strconv.ParseUint(attrs[name], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(v)
+}
+
+func formatPubDate(pubDate string) string {
+	pubDate = strings.TrimSpace(pubDate)
+	t, err := time.Parse(time.RFC1123Z, pubDate)
+	if err != nil {
+		return pubDate
+	}
+	return t.Format("2006-01-02")
+}
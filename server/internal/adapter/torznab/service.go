@@ -0,0 +1,88 @@
+package torznab
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+)
+
+const requestTimeout = 60 * time.Second
+
+// Config настройки подключения к Torznab API (Jackett, Prowlarr)
+type Config struct {
+	// Name наименование источника, используется в логах
+	Name string
+	// BaseURL адрес torznab эндпоинта, например
+	// http://jackett:9117/api/v2.0/indexers/all/results/torznab/
+	BaseURL string
+	// ApiKey ключ api
+	ApiKey string
+	// Categories категории в которых ищем раздачи (newznab ids)
+	Categories []int
+	ProxyURL   *string
+}
+
+type Api struct {
+	apiKey     string
+	categories []int
+	baseAPIUrl *url.URL
+	httpClient *http.Client
+	logger     log.Logger
+}
+
+func NewApi(logger log.Logger, cfg Config) (*Api, error) {
+	baseAPIUrl, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %w", err)
+	}
+	if baseAPIUrl.Scheme == "" || baseAPIUrl.Host == "" {
+		return nil, fmt.Errorf("invalid base url: %s", cfg.BaseURL)
+	}
+
+	// Редиректы не выполняем: ссылка на скачивание может отдавать redirect на magnet
+	httpClient := &http.Client{
+		Timeout: requestTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	logThis := logger.Named("torznab_" + cfg.Name)
+	// Если передан прокси, настраиваем его
+	if cfg.ProxyURL != nil {
+		proxy, err := url.Parse(*cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy URL: %w", err)
+		}
+
+		transport := &http.Transport{
+			Proxy: http.ProxyURL(proxy),
+		}
+		httpClient.Transport = transport
+		logThis.Infof("proxy configured")
+	}
+
+	return &Api{
+		apiKey:     cfg.ApiKey,
+		categories: cfg.Categories,
+		baseAPIUrl: baseAPIUrl,
+		httpClient: httpClient,
+		logger:     logThis,
+	}, nil
+}
+
+// IsOwnLink ссылка на раздачу принадлежит этому источнику (magnet ссылки обрабатываются любым источником)
+func (api *Api) IsOwnLink(torrentUrl string) bool {
+	if isMagnet(torrentUrl) {
+		return true
+	}
+	u, err := url.Parse(torrentUrl)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, api.baseAPIUrl.Scheme) &&
+		strings.EqualFold(u.Host, api.baseAPIUrl.Host)
+}
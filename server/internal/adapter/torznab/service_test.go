@@ -0,0 +1,167 @@
+package torznab
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const (
+	testApiKey     = "secret"
+	testMagnetHash = "0123456789ABCDEF0123456789ABCDEF01234567"
+	testInfoDict   = "d6:lengthi1024e4:name9:Dune.2021e"
+)
+
+const searchFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:torznab="http://torznab.com/schemas/2015/feed">
+  <channel>
+    <title>Jackett</title>
+    <torznab:response offset="0" total="2"/>
+    <item>
+      <title>Dune (2021) 1080p</title>
+      <guid>https://tracker.example/t/1</guid>
+      <jackettindexer id="example">Example</jackettindexer>
+      <link>{{BASE}}/dl/example/?file=Dune</link>
+      <pubDate>Sat, 16 Oct 2021 10:00:00 +0300</pubDate>
+      <size>16106127360</size>
+      <category>2000</category>
+      <category>2040</category>
+      <torznab:attr name="seeders" value="120"/>
+      <torznab:attr name="peers" value="130"/>
+      <torznab:attr name="grabs" value="5000"/>
+    </item>
+    <item>
+      <title>Dune (2021) 2160p</title>
+      <guid>https://tracker.example/t/2</guid>
+      <link>{{BASE}}/dl/example/?file=Dune4k</link>
+      <pubDate>Sun, 17 Oct 2021 10:00:00 +0300</pubDate>
+      <size>53687091200</size>
+      <category>2045</category>
+      <torznab:attr name="seeders" value="40"/>
+      <torznab:attr name="peers" value="45"/>
+      <torznab:attr name="grabs" value="8000"/>
+      <torznab:attr name="magneturl" value="magnet:?xt=urn:btih:` + testMagnetHash + `&amp;dn=Dune"/>
+    </item>
+  </channel>
+</rss>`
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/torznab/api":
+			if r.URL.Query().Get("apikey") != testApiKey {
+				_, _ = w.Write([]byte(`<?xml version="1.0"?><error code="100" description="Invalid API Key"/>`))
+				return
+			}
+			require.Equal(t, "search", r.URL.Query().Get("t"))
+			require.Equal(t, "Dune", r.URL.Query().Get("q"))
+			require.Equal(t, "2000,5000", r.URL.Query().Get("cat"))
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = w.Write([]byte(strings.ReplaceAll(searchFeed, "{{BASE}}", server.URL)))
+		case r.URL.Path == "/dl/example/" && r.URL.Query().Get("file") == "Dune":
+			w.Header().Set("Content-Type", "application/x-bittorrent")
+			_, _ = w.Write([]byte("d8:announce26:http://tracker.example/ann4:info" + testInfoDict + "e"))
+		case r.URL.Path == "/dl/example/" && r.URL.Query().Get("file") == "Redirect":
+			http.Redirect(w, r, "magnet:?xt=urn:btih:"+strings.ToLower(testMagnetHash), http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestApi(t *testing.T, server *httptest.Server, apiKey string) *Api {
+	t.Helper()
+	api, err := NewApi(log.NewLogger(log.DebugLevel), Config{
+		Name:       "test",
+		BaseURL:    server.URL + "/torznab/",
+		ApiKey:     apiKey,
+		Categories: []int{2000, 5000},
+	})
+	require.NoError(t, err)
+	return api
+}
+
+func TestApi_SearchTorrents(t *testing.T) {
+	server := newTestServer(t)
+
+	t.Run("ok", func(t *testing.T) {
+		api := newTestApi(t, server, testApiKey)
+		res, err := api.SearchTorrents("Dune")
+		require.NoError(t, err)
+		require.Equal(t, 2, res.TotalResults)
+		require.Len(t, res.Results, 2)
+
+		// Сортировка по количеству скачиваний
+		first := res.Results[0]
+		require.Equal(t, "Dune (2021) 2160p", first.Title)
+		require.Equal(t, "magnet:?xt=urn:btih:"+testMagnetHash+"&dn=Dune", first.Href)
+		require.Equal(t, "Movies/UHD", first.Category)
+		require.Equal(t, uint32(40), first.Seeds)
+		require.Equal(t, uint32(5), first.Leeches)
+		require.Equal(t, uint32(8000), first.Downloads)
+		require.Equal(t, "50.00GB", first.SizePretty)
+
+		second := res.Results[1]
+		require.Equal(t, "Dune (2021) 1080p", second.Title)
+		require.Equal(t, server.URL+"/dl/example/?file=Dune", second.Href)
+		require.Equal(t, "Example", second.Indexer)
+		require.Equal(t, "Movies/HD", second.Category)
+		require.Equal(t, uint64(16106127360), second.SizeBytes)
+		require.Equal(t, "2021-10-16", second.AddedDate)
+	})
+
+	t.Run("invalid api key", func(t *testing.T) {
+		api := newTestApi(t, server, "wrong")
+		_, err := api.SearchTorrents("Dune")
+		require.ErrorIs(t, err, apierr.NotAuthorizedErr)
+	})
+}
+
+func TestApi_GetMagnetLink(t *testing.T) {
+	server := newTestServer(t)
+	api := newTestApi(t, server, testApiKey)
+
+	t.Run("magnet", func(t *testing.T) {
+		res, err := api.GetMagnetLink("magnet:?xt=urn:btih:" + strings.ToLower(testMagnetHash))
+		require.NoError(t, err)
+		require.Equal(t, testMagnetHash, res.Hash)
+	})
+
+	t.Run("torrent file", func(t *testing.T) {
+		sum := sha1.Sum([]byte(testInfoDict))
+		hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+		res, err := api.GetMagnetLink(server.URL + "/dl/example/?file=Dune")
+		require.NoError(t, err)
+		require.Equal(t, hash, res.Hash)
+		require.Equal(t, "magnet:?xt=urn:btih:"+hash+"&dn=Dune.2021&tr=http%3A%2F%2Ftracker.example%2Fann", res.Magnet)
+	})
+
+	t.Run("redirect to magnet", func(t *testing.T) {
+		res, err := api.GetMagnetLink(server.URL + "/dl/example/?file=Redirect")
+		require.NoError(t, err)
+		require.Equal(t, testMagnetHash, res.Hash)
+	})
+
+	t.Run("foreign link", func(t *testing.T) {
+		_, err := api.GetMagnetLink("https://other.example/dl/1")
+		require.Error(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := api.GetMagnetLink(server.URL + "/dl/missing")
+		require.ErrorIs(t, err, apierr.ContentNotFound)
+	})
+}
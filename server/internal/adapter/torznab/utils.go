@@ -0,0 +1,108 @@
+package torznab
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// categories стандартные категории newznab
+var categories = map[int]string{
+	2000: "Movies",
+	2010: "Movies/Foreign",
+	2020: "Movies/Other",
+	2030: "Movies/SD",
+	2040: "Movies/HD",
+	2045: "Movies/UHD",
+	2050: "Movies/BluRay",
+	2060: "Movies/3D",
+	2070: "Movies/DVD",
+	2080: "Movies/WEB-DL",
+	5000: "TV",
+	5010: "TV/WEB-DL",
+	5020: "TV/Foreign",
+	5030: "TV/SD",
+	5040: "TV/HD",
+	5045: "TV/UHD",
+	5050: "TV/Other",
+	5060: "TV/Sport",
+	5070: "TV/Anime",
+	5080: "TV/Documentary",
+}
+
+// categoryName возвращает наименование наиболее подробной известной категории
+func categoryName(ids []string) string {
+	best := 0
+	for _, id := range ids {
+		v, err := strconv.Atoi(strings.TrimSpace(id))
+		if err != nil {
+			continue
+		}
+		if _, ok := categories[v]; ok && v > best {
+			best = v
+		}
+	}
+	if best != 0 {
+		return categories[best]
+	}
+	return strings.Join(ids, ",")
+}
+
+// hashFromMagnet достает info hash из magnet ссылки в виде hex строки в верхнем регистре
+func hashFromMagnet(magnet string) (string, error) {
+	u, err := url.Parse(magnet)
+	if err != nil {
+		return "", fmt.Errorf("url.Parse: %w", err)
+	}
+	for _, xt := range u.Query()["xt"] {
+		hash, ok := strings.CutPrefix(xt, "urn:btih:")
+		if !ok {
+			continue
+		}
+		switch len(hash) {
+		case 40:
+			if _, err := hex.DecodeString(hash); err != nil {
+				return "", fmt.Errorf("invalid btih hash: %s", hash)
+			}
+			return strings.ToUpper(hash), nil
+		case 32:
+			raw, err := base32.StdEncoding.DecodeString(strings.ToUpper(hash))
+			if err != nil {
+				return "", fmt.Errorf("invalid btih hash: %s", hash)
+			}
+			return strings.ToUpper(hex.EncodeToString(raw)), nil
+		}
+	}
+	return "", fmt.Errorf("btih hash not found in magnet")
+}
+
+// FormatBytesWithPrecision преобразует с указанной точностью
+func formatBytesWithPrecision(bytes uint64, precision int) string {
+	if bytes == 0 {
+		return "0B"
+	}
+
+	units := []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+	base := float64(1024)
+
+	exponent := math.Floor(math.Log(float64(bytes)) / math.Log(base))
+	if exponent < 0 {
+		exponent = 0
+	}
+	if exponent > float64(len(units)-1) {
+		exponent = float64(len(units) - 1)
+	}
+
+	value := float64(bytes) / math.Pow(base, exponent)
+	unit := units[int(exponent)]
+
+	if exponent == 0 {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	return fmt.Sprintf("%.*f%s", precision, value, unit)
+}
@@ -0,0 +1,11 @@
+package trackers
+
+// Tracker торрент трекер, на котором ищем раздачи
+type Tracker interface {
+	// Name наименование источника, попадает в результаты поиска
+	Name() string
+	SearchTorrents(query string) (*TorrentResponse, error)
+	GetMagnetLink(torrentUrl string) (*MagnetInfo, error)
+	// IsOwnLink ссылка на раздачу принадлежит этому трекеру
+	IsOwnLink(torrentUrl string) bool
+}
@@ -0,0 +1,67 @@
+package trackers
+
+import (
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/adapter/rutracker"
+)
+
+const rutrackerSource = "rutracker"
+
+type rutrackerTracker struct {
+	api *rutracker.Api
+}
+
+// NewRutracker трекер rutracker.org
+func NewRutracker(api *rutracker.Api) Tracker {
+	return &rutrackerTracker{api: api}
+}
+
+func (t *rutrackerTracker) Name() string {
+	return rutrackerSource
+}
+
+func (t *rutrackerTracker) SearchTorrents(query string) (*TorrentResponse, error) {
+	res, err := t.api.SearchTorrents(query)
+	if err != nil {
+		return nil, fmt.Errorf("api.SearchTorrents: %w", err)
+	}
+
+	results := make([]Torrent, 0, len(res.Results))
+	for _, item := range res.Results {
+		results = append(results, Torrent{
+			Source:     rutrackerSource,
+			Title:      item.Title,
+			Href:       item.Href,
+			Author:     item.Author,
+			SizeBytes:  item.SizeBytes,
+			SizePretty: item.SizePretty,
+			Seeds:      item.Seeds,
+			Leeches:    item.Leeches,
+			Downloads:  item.Downloads,
+			AddedDate:  item.AddedDate,
+			Category:   item.Category,
+		})
+	}
+
+	return &TorrentResponse{
+		Page:         res.Page,
+		TotalResults: res.TotalResults,
+		Results:      results,
+	}, nil
+}
+
+func (t *rutrackerTracker) GetMagnetLink(torrentUrl string) (*MagnetInfo, error) {
+	res, err := t.api.GetMagnetLink(torrentUrl)
+	if err != nil {
+		return nil, fmt.Errorf("api.GetMagnetLink: %w", err)
+	}
+	return &MagnetInfo{
+		Magnet: res.Magnet,
+		Hash:   res.Hash,
+	}, nil
+}
+
+func (t *rutrackerTracker) IsOwnLink(torrentUrl string) bool {
+	return t.api.IsOwnLink(torrentUrl)
+}
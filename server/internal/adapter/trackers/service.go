@@ -0,0 +1,83 @@
+package trackers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/kkiling/goplatform/log"
+)
+
+// ErrUnknownLink ссылка на раздачу не принадлежит ни одному из трекеров
+var ErrUnknownLink = errors.New("unknown torrent link")
+
+// Service поиск раздач сразу на нескольких трекерах
+type Service struct {
+	trackers []Tracker
+	logger   log.Logger
+}
+
+func NewService(logger log.Logger, trackers ...Tracker) *Service {
+	return &Service{
+		trackers: trackers,
+		logger:   logger.Named("trackers"),
+	}
+}
+
+// SearchTorrents ищет раздачи на всех трекерах и объединяет результаты.
+// Ошибка возвращается только если ни один трекер не ответил
+func (s *Service) SearchTorrents(query string) (*TorrentResponse, error) {
+	type searchResult struct {
+		res *TorrentResponse
+		err error
+	}
+
+	results := make([]searchResult, len(s.trackers))
+	var wg sync.WaitGroup
+	for i, tracker := range s.trackers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := tracker.SearchTorrents(query)
+			results[i] = searchResult{res: res, err: err}
+		}()
+	}
+	wg.Wait()
+
+	response := &TorrentResponse{
+		Page:    1,
+		Results: []Torrent{},
+	}
+	var errs []error
+	for i, r := range results {
+		if r.err != nil {
+			name := s.trackers[i].Name()
+			s.logger.Errorf("search torrents on %s: %v", name, r.err)
+			errs = append(errs, fmt.Errorf("%s: %w", name, r.err))
+			continue
+		}
+		response.Results = append(response.Results, r.res.Results...)
+		response.TotalResults += r.res.TotalResults
+	}
+
+	if len(errs) > 0 && len(errs) == len(s.trackers) {
+		return nil, errors.Join(errs...)
+	}
+
+	return response, nil
+}
+
+// GetMagnetLink получение магнет ссылки у трекера, которому принадлежит раздача
+func (s *Service) GetMagnetLink(torrentUrl string) (*MagnetInfo, error) {
+	for _, tracker := range s.trackers {
+		if !tracker.IsOwnLink(torrentUrl) {
+			continue
+		}
+		res, err := tracker.GetMagnetLink(torrentUrl)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", tracker.Name(), err)
+		}
+		return res, nil
+	}
+	return nil, ErrUnknownLink
+}
@@ -0,0 +1,79 @@
+package trackers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTracker struct {
+	name     string
+	prefix   string
+	torrents []Torrent
+	err      error
+}
+
+func (f *fakeTracker) Name() string {
+	return f.name
+}
+
+func (f *fakeTracker) SearchTorrents(string) (*TorrentResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &TorrentResponse{Page: 1, TotalResults: len(f.torrents), Results: f.torrents}, nil
+}
+
+func (f *fakeTracker) GetMagnetLink(torrentUrl string) (*MagnetInfo, error) {
+	return &MagnetInfo{Magnet: "magnet:" + torrentUrl, Hash: f.name}, nil
+}
+
+func (f *fakeTracker) IsOwnLink(torrentUrl string) bool {
+	return strings.HasPrefix(torrentUrl, f.prefix)
+}
+
+func TestService_SearchTorrents(t *testing.T) {
+	logger := log.NewLogger(log.DebugLevel)
+	first := &fakeTracker{
+		name:     "first",
+		prefix:   "https://first/",
+		torrents: []Torrent{{Source: "first", Title: "a"}, {Source: "first", Title: "b"}},
+	}
+	second := &fakeTracker{
+		name:     "second",
+		prefix:   "https://second/",
+		torrents: []Torrent{{Source: "second", Title: "c"}},
+	}
+	broken := &fakeTracker{name: "broken", err: errors.New("unavailable")}
+
+	t.Run("merge results", func(t *testing.T) {
+		s := NewService(logger, first, broken, second)
+		res, err := s.SearchTorrents("query")
+		require.NoError(t, err)
+		require.Equal(t, 3, res.TotalResults)
+		require.Equal(t, []Torrent{
+			{Source: "first", Title: "a"},
+			{Source: "first", Title: "b"},
+			{Source: "second", Title: "c"},
+		}, res.Results)
+	})
+
+	t.Run("all trackers failed", func(t *testing.T) {
+		s := NewService(logger, broken)
+		_, err := s.SearchTorrents("query")
+		require.Error(t, err)
+	})
+
+	t.Run("magnet link routed by owner", func(t *testing.T) {
+		s := NewService(logger, first, second)
+		res, err := s.GetMagnetLink("https://second/1")
+		require.NoError(t, err)
+		require.Equal(t, "second", res.Hash)
+
+		_, err = s.GetMagnetLink("https://unknown/1")
+		require.ErrorIs(t, err, ErrUnknownLink)
+	})
+}
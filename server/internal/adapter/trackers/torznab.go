@@ -0,0 +1,70 @@
+package trackers
+
+import (
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/adapter/torznab"
+)
+
+type torznabTracker struct {
+	name string
+	api  *torznab.Api
+}
+
+// NewTorznab трекер доступный через Torznab API (Jackett, Prowlarr)
+func NewTorznab(name string, api *torznab.Api) Tracker {
+	return &torznabTracker{name: name, api: api}
+}
+
+func (t *torznabTracker) Name() string {
+	return t.name
+}
+
+func (t *torznabTracker) SearchTorrents(query string) (*TorrentResponse, error) {
+	res, err := t.api.SearchTorrents(query)
+	if err != nil {
+		return nil, fmt.Errorf("api.SearchTorrents: %w", err)
+	}
+
+	results := make([]Torrent, 0, len(res.Results))
+	for _, item := range res.Results {
+		// Агрегаторы отдают раздачи с разных трекеров, указываем конкретный индексер
+		source := t.name
+		if item.Indexer != "" {
+			source = t.name + "/" + item.Indexer
+		}
+		results = append(results, Torrent{
+			Source:     source,
+			Title:      item.Title,
+			Href:       item.Href,
+			SizeBytes:  item.SizeBytes,
+			SizePretty: item.SizePretty,
+			Seeds:      item.Seeds,
+			Leeches:    item.Leeches,
+			Downloads:  item.Downloads,
+			AddedDate:  item.AddedDate,
+			Category:   item.Category,
+		})
+	}
+
+	return &TorrentResponse{
+		Page:         res.Page,
+		TotalResults: res.TotalResults,
+		Results:      results,
+	}, nil
+}
+
+func (t *torznabTracker) GetMagnetLink(torrentUrl string) (*MagnetInfo, error) {
+	res, err := t.api.GetMagnetLink(torrentUrl)
+	if err != nil {
+		return nil, fmt.Errorf("api.GetMagnetLink: %w", err)
+	}
+	return &MagnetInfo{
+		Magnet: res.Magnet,
+		Hash:   res.Hash,
+	}, nil
+}
+
+func (t *torznabTracker) IsOwnLink(torrentUrl string) bool {
+	return t.api.IsOwnLink(torrentUrl)
+}
@@ -0,0 +1,35 @@
+package transmission
+
+import (
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+type torrentAddArguments struct {
+	Filename    string   `json:"filename"`
+	DownloadDir string   `json:"download-dir,omitempty"`
+	Paused      bool     `json:"paused"`
+	Labels      []string `json:"labels,omitempty"`
+}
+
+func (api *Api) AddTorrent(opts qbittorrent.TorrentAddOptions) error {
+	// Категорий в transmission нет, сохраняем ее как метку
+	labels := opts.Tags
+	if opts.Category != "" {
+		labels = append([]string{opts.Category}, labels...)
+	}
+
+	// Повторное добавление раздачи (torrent-duplicate) не считается ошибкой
+	err := api.call("torrent-add", torrentAddArguments{
+		Filename:    opts.Magnet,
+		DownloadDir: opts.SavePath,
+		Paused:      opts.Paused,
+		Labels:      labels,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("call torrent-add: %w", err)
+	}
+
+	return nil
+}
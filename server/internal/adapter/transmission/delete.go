@@ -0,0 +1,22 @@
+package transmission
+
+import (
+	"fmt"
+	"strings"
+)
+
+type torrentRemoveArguments struct {
+	IDs             []string `json:"ids"`
+	DeleteLocalData bool     `json:"delete-local-data"`
+}
+
+func (api *Api) DeleteTorrent(hash string, deleteFiles bool) error {
+	err := api.call("torrent-remove", torrentRemoveArguments{
+		IDs:             []string{strings.ToLower(hash)},
+		DeleteLocalData: deleteFiles,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("call torrent-remove: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package transmission
+
+import (
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+// GetTorrentFiles возвращает список файлов в торренте по его хешу
+func (api *Api) GetTorrentFiles(hash string) ([]qbittorrent.TorrentFile, error) {
+	raw, err := api.getTorrent(hash, []string{"hashString", "files"})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]qbittorrent.TorrentFile, len(raw.Files))
+	for i, file := range raw.Files {
+		var progress float64
+		if file.Length > 0 {
+			progress = float64(file.BytesCompleted) / float64(file.Length)
+		}
+		files[i] = qbittorrent.TorrentFile{
+			Index:    i,
+			Name:     file.Name,
+			Progress: progress,
+			Size:     file.Length,
+		}
+	}
+
+	return files, nil
+}
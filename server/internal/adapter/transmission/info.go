@@ -0,0 +1,75 @@
+package transmission
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+var infoFields = []string{
+	"hashString", "name", "downloadDir", "labels", "status", "error", "errorString",
+	"percentDone", "metadataPercentComplete", "addedDate", "doneDate", "eta", "leftUntilDone",
+	"haveValid", "downloadedEver", "uploadedEver", "sizeWhenDone", "totalSize", "rateDownload", "rateUpload",
+}
+
+type torrentGetArguments struct {
+	IDs    []string `json:"ids"`
+	Fields []string `json:"fields"`
+}
+
+func (api *Api) getTorrent(hash string, fields []string) (*rawTorrent, error) {
+	var res torrentGetResponse
+	err := api.call("torrent-get", torrentGetArguments{
+		IDs:    []string{strings.ToLower(hash)},
+		Fields: fields,
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("call torrent-get: %w", err)
+	}
+
+	if len(res.Torrents) == 0 {
+		return nil, apierr.ContentNotFound
+	}
+
+	return &res.Torrents[0], nil
+}
+
+func (api *Api) GetTorrentInfo(hash string) (*qbittorrent.TorrentInfo, error) {
+	raw, err := api.getTorrent(hash, infoFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw.Error != 0 {
+		api.logger.Warnf("torrent %s error: %s", raw.HashString, raw.ErrorString)
+	}
+
+	info := &qbittorrent.TorrentInfo{
+		Hash:        raw.HashString,
+		Name:        raw.Name,
+		Tags:        strings.Join(raw.Labels, ","),
+		SavePath:    raw.DownloadDir,
+		ContentPath: filepath.Join(raw.DownloadDir, raw.Name),
+		State:       mapState(*raw),
+		AddedOn:     time.Unix(raw.AddedDate, 0),
+		Eta:         raw.Eta,
+		AmountLeft:  raw.LeftUntilDone,
+		Completed:   raw.HaveValid,
+		Downloaded:  raw.DownloadedEver,
+		Uploaded:    raw.UploadedEver,
+		Size:        raw.SizeWhenDone,
+		TotalSize:   raw.TotalSize,
+		Progress:    raw.PercentDone,
+		DlSpeed:     raw.RateDownload,
+		UpSpeed:     raw.RateUpload,
+	}
+	if raw.DoneDate > 0 {
+		info.CompletionOn = time.Unix(raw.DoneDate, 0)
+	}
+
+	return info, nil
+}
@@ -0,0 +1,94 @@
+package transmission
+
+import (
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+// torrentStatus статус торрента в transmission (tr_torrent_activity)
+type torrentStatus int
+
+const (
+	// statusStopped торрент остановлен
+	statusStopped torrentStatus = 0
+	// statusCheckWait торрент в очереди на проверку файлов
+	statusCheckWait torrentStatus = 1
+	// statusCheck идет проверка файлов
+	statusCheck torrentStatus = 2
+	// statusDownloadWait торрент в очереди на загрузку
+	statusDownloadWait torrentStatus = 3
+	// statusDownload торрент загружается
+	statusDownload torrentStatus = 4
+	// statusSeedWait торрент в очереди на раздачу
+	statusSeedWait torrentStatus = 5
+	// statusSeed торрент раздается
+	statusSeed torrentStatus = 6
+)
+
+type rawTorrent struct {
+	HashString              string        `json:"hashString"`
+	Name                    string        `json:"name"`
+	DownloadDir             string        `json:"downloadDir"`
+	Labels                  []string      `json:"labels"`
+	Status                  torrentStatus `json:"status"`
+	Error                   int           `json:"error"`
+	ErrorString             string        `json:"errorString"`
+	PercentDone             float64       `json:"percentDone"`
+	MetadataPercentComplete float64       `json:"metadataPercentComplete"`
+	AddedDate               int64         `json:"addedDate"`
+	DoneDate                int64         `json:"doneDate"`
+	Eta                     int           `json:"eta"`
+	LeftUntilDone           int64         `json:"leftUntilDone"`
+	HaveValid               int64         `json:"haveValid"`
+	DownloadedEver          int64         `json:"downloadedEver"`
+	UploadedEver            int64         `json:"uploadedEver"`
+	SizeWhenDone            int64         `json:"sizeWhenDone"`
+	TotalSize               int64         `json:"totalSize"`
+	RateDownload            int64         `json:"rateDownload"`
+	RateUpload              int64         `json:"rateUpload"`
+	Files                   []rawFile     `json:"files"`
+}
+
+type rawFile struct {
+	Name           string `json:"name"`
+	Length         int64  `json:"length"`
+	BytesCompleted int64  `json:"bytesCompleted"`
+}
+
+type torrentGetResponse struct {
+	Torrents []rawTorrent `json:"torrents"`
+}
+
+// mapState статус transmission в терминах qbittorrent
+func mapState(raw rawTorrent) qbittorrent.TorrentState {
+	if raw.Error != 0 {
+		return qbittorrent.TorrentStateError
+	}
+	done := raw.PercentDone >= 1
+
+	switch raw.Status {
+	case statusStopped:
+		if done {
+			return qbittorrent.TorrentStatePausedUP
+		}
+		return qbittorrent.TorrentStateStoppedDL
+	case statusCheckWait:
+		return qbittorrent.TorrentStateCheckingResumeData
+	case statusCheck:
+		if done {
+			return qbittorrent.TorrentStateCheckingUP
+		}
+		return qbittorrent.TorrentStateCheckingDL
+	case statusDownloadWait:
+		return qbittorrent.TorrentStateQueuedDL
+	case statusDownload:
+		if raw.MetadataPercentComplete < 1 {
+			return qbittorrent.TorrentStateMetaDL
+		}
+		return qbittorrent.TorrentStateDownloading
+	case statusSeedWait:
+		return qbittorrent.TorrentStateQueuedUP
+	case statusSeed:
+		return qbittorrent.TorrentStateUploading
+	}
+	return qbittorrent.TorrentStateUnknown
+}
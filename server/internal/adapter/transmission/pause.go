@@ -0,0 +1,16 @@
+package transmission
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (api *Api) PauseTorrent(hash string) error {
+	err := api.call("torrent-stop", torrentActionArguments{
+		IDs: []string{strings.ToLower(hash)},
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("call torrent-stop: %w", err)
+	}
+	return nil
+}
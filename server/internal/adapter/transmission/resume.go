@@ -0,0 +1,20 @@
+package transmission
+
+import (
+	"fmt"
+	"strings"
+)
+
+type torrentActionArguments struct {
+	IDs []string `json:"ids"`
+}
+
+func (api *Api) ResumeTorrent(hash string) error {
+	err := api.call("torrent-start", torrentActionArguments{
+		IDs: []string{strings.ToLower(hash)},
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("call torrent-start: %w", err)
+	}
+	return nil
+}
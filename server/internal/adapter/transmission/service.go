@@ -0,0 +1,144 @@
+package transmission
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	// sessionIDHeader заголовок защиты от CSRF, без него transmission отвечает 409
+	sessionIDHeader = "X-Transmission-Session-Id"
+	resultSuccess   = "success"
+)
+
+// Api клиент для работы с JSON-RPC Transmission
+// Модели ответа совпадают с qbittorrent, что бы юзеркейсы не зависели от выбранного клиента
+type Api struct {
+	rpcUrl     *url.URL
+	username   string
+	password   string
+	httpClient *http.Client
+	logger     log.Logger
+
+	mu        sync.Mutex
+	sessionID string
+}
+
+// NewApi rpcURL адрес rpc эндпоинта, например http://localhost:9091/transmission/rpc
+func NewApi(logger log.Logger, rpcURL, username, password string) (*Api, error) {
+	rpcUrl, err := url.Parse(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %w", err)
+	}
+	if rpcUrl.Scheme == "" || rpcUrl.Host == "" {
+		return nil, fmt.Errorf("invalid rpc url: %s", rpcURL)
+	}
+
+	return &Api{
+		rpcUrl:     rpcUrl,
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logger:     logger.Named("transmission"),
+	}, nil
+}
+
+type rpcRequest struct {
+	Method    string `json:"method"`
+	Arguments any    `json:"arguments,omitempty"`
+}
+
+type rpcResponse struct {
+	Result    string          `json:"result"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+func (api *Api) getSessionID() string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.sessionID
+}
+
+func (api *Api) setSessionID(sessionID string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.sessionID = sessionID
+}
+
+func (api *Api) post(body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, api.rpcUrl.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequest: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if sessionID := api.getSessionID(); sessionID != "" {
+		req.Header.Set(sessionIDHeader, sessionID)
+	}
+	if api.username != "" {
+		req.SetBasicAuth(api.username, api.password)
+	}
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, apierr.HandleRequestError(api.logger, err)
+	}
+	return resp, nil
+}
+
+// call выполняет rpc метод, result - куда декодировать arguments ответа (может быть nil)
+func (api *Api) call(method string, arguments any, result any) error {
+	body, err := json.Marshal(rpcRequest{Method: method, Arguments: arguments})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	resp, err := api.post(body)
+	if err != nil {
+		return fmt.Errorf("post: %w", err)
+	}
+	// Сессия устарела или еще не получена, transmission возвращает новый идентификатор в заголовке
+	if resp.StatusCode == http.StatusConflict {
+		resp.Body.Close()
+		api.logger.Debugf("Update session id")
+		api.setSessionID(resp.Header.Get(sessionIDHeader))
+
+		resp, err = api.post(body)
+		if err != nil {
+			return fmt.Errorf("post: %w", err)
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusUnauthorized {
+			return apierr.AuthenticationFailedErr
+		}
+		return apierr.HandleStatusCodeError(api.logger, resp)
+	}
+
+	var rpcResp rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if rpcResp.Result != resultSuccess {
+		return fmt.Errorf("%s failed: %s", method, rpcResp.Result)
+	}
+
+	if result != nil && len(rpcResp.Arguments) > 0 {
+		if err := json.Unmarshal(rpcResp.Arguments, result); err != nil {
+			return fmt.Errorf("failed to decode arguments: %w", err)
+		}
+	}
+
+	return nil
+}
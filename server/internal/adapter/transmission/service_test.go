@@ -0,0 +1,191 @@
+package transmission
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+const (
+	testSessionID = "session-1"
+	testHash      = "0123456789abcdef0123456789abcdef01234567"
+)
+
+type fakeTransmission struct {
+	mu       sync.Mutex
+	requests []rpcRequest
+	// handshakes количество ответов 409
+	handshakes int
+	torrents   []map[string]any
+}
+
+func (f *fakeTransmission) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.Header.Get(sessionIDHeader) != testSessionID {
+		f.handshakes++
+		w.Header().Set(sessionIDHeader, testSessionID)
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	var req struct {
+		Method    string          `json:"method"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var args map[string]any
+	_ = json.Unmarshal(req.Arguments, &args)
+	f.requests = append(f.requests, rpcRequest{Method: req.Method, Arguments: args})
+
+	resp := map[string]any{"result": resultSuccess, "arguments": map[string]any{}}
+	switch req.Method {
+	case "torrent-add":
+		resp["arguments"] = map[string]any{
+			"torrent-added": map[string]any{"hashString": testHash, "id": 1, "name": "Dark"},
+		}
+	case "torrent-get":
+		var found []map[string]any
+		for _, torrent := range f.torrents {
+			if torrent["hashString"] == args["ids"].([]any)[0] {
+				found = append(found, torrent)
+			}
+		}
+		resp["arguments"] = map[string]any{"torrents": found}
+	case "torrent-start", "torrent-stop", "torrent-remove":
+	default:
+		resp["result"] = "method name not recognized"
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func newTestApi(t *testing.T, fake *fakeTransmission) *Api {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	api, err := NewApi(log.NewLogger(log.DebugLevel), server.URL+"/transmission/rpc", "user", "pass")
+	require.NoError(t, err)
+	return api
+}
+
+func TestApi_SessionHandshake(t *testing.T) {
+	fake := &fakeTransmission{}
+	api := newTestApi(t, fake)
+
+	require.NoError(t, api.ResumeTorrent(testHash))
+	require.NoError(t, api.PauseTorrent(testHash))
+	// Идентификатор сессии получен один раз и переиспользуется
+	require.Equal(t, 1, fake.handshakes)
+
+	// Transmission перезапустили - сессия сменилась
+	api.setSessionID("expired")
+	require.NoError(t, api.DeleteTorrent(testHash, true))
+	require.Equal(t, 2, fake.handshakes)
+
+	require.Len(t, fake.requests, 3)
+	require.Equal(t, "torrent-remove", fake.requests[2].Method)
+	require.Equal(t, map[string]any{
+		"ids":               []any{testHash},
+		"delete-local-data": true,
+	}, fake.requests[2].Arguments)
+
+	api.username = "wrong"
+	require.ErrorIs(t, api.ResumeTorrent(testHash), apierr.AuthenticationFailedErr)
+}
+
+func TestApi_AddTorrent(t *testing.T) {
+	fake := &fakeTransmission{}
+	api := newTestApi(t, fake)
+
+	err := api.AddTorrent(qbittorrent.TorrentAddOptions{
+		Magnet:   "magnet:?xt=urn:btih:" + testHash,
+		SavePath: "/downloads/tvshows",
+		Category: "tvshow",
+		Tags:     []string{"media-delivery"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, fake.requests, 1)
+	require.Equal(t, "torrent-add", fake.requests[0].Method)
+	require.Equal(t, map[string]any{
+		"filename":     "magnet:?xt=urn:btih:" + testHash,
+		"download-dir": "/downloads/tvshows",
+		"paused":       false,
+		"labels":       []any{"tvshow", "media-delivery"},
+	}, fake.requests[0].Arguments)
+}
+
+func TestApi_GetTorrentInfo(t *testing.T) {
+	fake := &fakeTransmission{
+		torrents: []map[string]any{{
+			"hashString":              testHash,
+			"name":                    "Dark.S01.1080p",
+			"downloadDir":             "/downloads",
+			"status":                  int(statusDownload),
+			"percentDone":             0.5,
+			"metadataPercentComplete": 1,
+			"sizeWhenDone":            2048,
+			"leftUntilDone":           1024,
+			"files": []map[string]any{
+				{"name": "Dark.S01.1080p/Dark.S01E01.mkv", "length": 1024, "bytesCompleted": 1024},
+				{"name": "Dark.S01.1080p/Dark.S01E02.mkv", "length": 1024, "bytesCompleted": 0},
+			},
+		}},
+	}
+	api := newTestApi(t, fake)
+
+	info, err := api.GetTorrentInfo(testHash)
+	require.NoError(t, err)
+	require.Equal(t, qbittorrent.TorrentStateDownloading, info.State)
+	require.Equal(t, "/downloads/Dark.S01.1080p", info.ContentPath)
+	require.Equal(t, "/downloads", info.SavePath)
+	require.Equal(t, 0.5, info.Progress)
+	require.Equal(t, int64(1024), info.AmountLeft)
+
+	files, err := api.GetTorrentFiles(testHash)
+	require.NoError(t, err)
+	require.Equal(t, []qbittorrent.TorrentFile{
+		{Index: 0, Name: "Dark.S01.1080p/Dark.S01E01.mkv", Progress: 1, Size: 1024},
+		{Index: 1, Name: "Dark.S01.1080p/Dark.S01E02.mkv", Progress: 0, Size: 1024},
+	}, files)
+
+	_, err = api.GetTorrentInfo("ffffffffffffffffffffffffffffffffffffffff")
+	require.ErrorIs(t, err, apierr.ContentNotFound)
+}
+
+func TestMapState(t *testing.T) {
+	tests := []struct {
+		raw  rawTorrent
+		want qbittorrent.TorrentState
+	}{
+		{rawTorrent{Status: statusStopped, PercentDone: 0.3}, qbittorrent.TorrentStateStoppedDL},
+		{rawTorrent{Status: statusStopped, PercentDone: 1}, qbittorrent.TorrentStatePausedUP},
+		{rawTorrent{Status: statusCheck, PercentDone: 0.3}, qbittorrent.TorrentStateCheckingDL},
+		{rawTorrent{Status: statusDownloadWait}, qbittorrent.TorrentStateQueuedDL},
+		{rawTorrent{Status: statusDownload, MetadataPercentComplete: 0.1}, qbittorrent.TorrentStateMetaDL},
+		{rawTorrent{Status: statusDownload, MetadataPercentComplete: 1}, qbittorrent.TorrentStateDownloading},
+		{rawTorrent{Status: statusSeedWait, PercentDone: 1}, qbittorrent.TorrentStateQueuedUP},
+		{rawTorrent{Status: statusSeed, PercentDone: 1}, qbittorrent.TorrentStateUploading},
+		{rawTorrent{Status: statusSeed, Error: 3}, qbittorrent.TorrentStateError},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, mapState(tt.raw))
+	}
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	// SignatureHeader подпись тела запроса: sha256=hex(hmac_sha256(secret, body))
+	SignatureHeader = "X-Media-Delivery-Signature"
+	// EventHeader тип события
+	EventHeader = "X-Media-Delivery-Event"
+	// DeliveryHeader идентификатор отправки, одинаковый для всех повторных попыток
+	DeliveryHeader = "X-Media-Delivery-Delivery"
+)
+
+// Client отправка событий на вебхуки получателей
+type Client struct {
+	httpClient *http.Client
+	logger     log.Logger
+}
+
+func NewClient(logger log.Logger) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logger:     logger.Named("webhook"),
+	}
+}
+
+// Sign подпись тела запроса секретом получателя
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send POST запрос с json событием, успехом считается любой 2xx ответ
+func (c *Client) Send(ctx context.Context, url, secret string, eventType string, deliveryID uuid.UUID, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(DeliveryHeader, deliveryID.String())
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, payload))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", apierr.HandleRequestError(c.logger, err))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status: %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Send(t *testing.T) {
+	const secret = "secret"
+	payload := []byte(`{"type":"delivered"}`)
+	deliveryID := uuid.New()
+
+	var headers http.Header
+	var body []byte
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := NewClient(log.NewLogger(log.DebugLevel))
+	ctx := context.Background()
+
+	require.NoError(t, client.Send(ctx, server.URL, secret, "delivered", deliveryID, payload))
+	require.Equal(t, payload, body)
+	require.Equal(t, "application/json", headers.Get("Content-Type"))
+	require.Equal(t, "delivered", headers.Get(EventHeader))
+	require.Equal(t, deliveryID.String(), headers.Get(DeliveryHeader))
+	// echo -n '{"type":"delivered"}' | openssl dgst -sha256 -hmac secret
+	require.Equal(t, "sha256=c1d7466b423035537479b21ee70bcc13753a7247827eeef5fc13281875d872af", Sign(secret, payload))
+	require.Equal(t, Sign(secret, payload), headers.Get(SignatureHeader))
+
+	// Без секрета подпись не отправляется
+	require.NoError(t, client.Send(ctx, server.URL, "", "delivered", deliveryID, payload))
+	require.Empty(t, headers.Get(SignatureHeader))
+
+	status = http.StatusInternalServerError
+	require.Error(t, client.Send(ctx, server.URL, secret, "delivered", deliveryID, payload))
+}
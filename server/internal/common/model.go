@@ -2,6 +2,9 @@ package common
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
 
 	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 )
@@ -14,6 +17,9 @@ type TVShowID struct {
 type ContentID struct {
 	MovieID *uint64
 	TVShow  *TVShowID
+	// VideoContentID конкретный видеоконтент (раздача) фильма/сезона,
+	// обязателен если к фильму/сезону привязано несколько видеоконтентов
+	VideoContentID *uuid.UUID
 }
 
 func (c *ContentID) Validate() error {
@@ -29,5 +35,45 @@ func (c *ContentID) Validate() error {
 	if c.TVShow != nil && c.TVShow.ID == uint64(0) {
 		return fmt.Errorf("tvShowID must be positive: %w", ucerr.InvalidArgument)
 	}
+	if c.VideoContentID != nil && *c.VideoContentID == uuid.Nil {
+		return fmt.Errorf("videoContentID is invalid: %w", ucerr.InvalidArgument)
+	}
 	return nil
 }
+
+// WithVersion добавляет к имени файла тег версии видеоконтента
+/*
+	Movie Name (2010) - 4K
+	S01E01 Episode Name - 4K
+	Медиасервер (Emby) по такому суффиксу показывает файлы как версии одного фильма / эпизода.
+	Суффикс добавляется к файлам, а не к каталогу: версии лежат в общем каталоге фильма / сезона,
+	в отдельных каталогах Emby показал бы их как разные фильмы / дубли сезона
+*/
+func WithVersion(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return fmt.Sprintf("%s - %s", name, version)
+}
+
+// pathNameReplacer символы, недопустимые в именах каталогов и файлов
+var pathNameReplacer = strings.NewReplacer(
+	"/", "-",
+	"\\", "-",
+	":", "",
+	"*", "",
+	"?", "",
+	"\"", "",
+	"<", "",
+	">", "",
+	"|", "",
+)
+
+// PathName имя каталога / файла из названия контента в themoviedb
+/*
+	Mission: Impossible -> Mission Impossible
+	Face/Off -> Face-Off
+*/
+func PathName(name string) string {
+	return strings.TrimSpace(pathNameReplacer.Replace(name))
+}
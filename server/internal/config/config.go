@@ -13,10 +13,30 @@ const (
 	TheMovieDbName = "the_movie_db"
 	// RutrackerName конфиг Rutracker api
 	RutrackerName = "rutracker"
+	// TrackersName конфиг дополнительных торрент трекеров
+	TrackersName = "trackers"
 	// QBitTorrentName конфиг QBitTorrent api
 	QBitTorrentName = "qbittorrent"
+	// TorrentClientName выбор торрент клиента
+	TorrentClientName = "torrent_client"
+	// TransmissionName конфиг Transmission rpc
+	TransmissionName = "transmission"
 	// EmbyName конфиг Emby api
 	EmbyName = "emby"
+	// MediaServerName выбор медиасервера
+	MediaServerName = "media_server"
+	// JellyfinName конфиг Jellyfin api
+	JellyfinName = "jellyfin"
+	// PlexName конфиг Plex api
+	PlexName = "plex"
+	// TelegramName конфиг telegram бота для уведомлений
+	TelegramName = "telegram"
+	// WebhooksName конфиг исходящих вебхуков
+	WebhooksName = "webhooks"
+	// RemindersName конфиг напоминаний о доставках, ожидающих пользователя
+	RemindersName = "reminders"
+	// SeasonWatchName конфиг проверки выхода новых эпизодов доставленных сезонов
+	SeasonWatchName = "season_watch"
 	// SqliteName конфиг sqlite
 	PostgresqlName = "postgresql"
 	// DeliveryName конфиг sqlite
@@ -25,13 +45,23 @@ const (
 
 // AppConfig объединяет все конфигурации
 type AppConfig struct {
-	Server         ServerConfig      `yaml:"server"`
-	TheMovieDb     TheMovieDbConfig  `yaml:"the_movie_db"`
-	Rutracker      RutrackerConfig   `yaml:"rutracker"`
-	QBittorrent    QBittorrentConfig `yaml:"qbittorrent"`
-	Emby           EmbyConfig        `yaml:"emby"`
-	Postgresql     PostgresqlConfig  `yaml:"postgresql"`
-	DeliveryConfig DeliveryConfig    `yaml:"delivery"`
+	Server         ServerConfig        `yaml:"server"`
+	TheMovieDb     TheMovieDbConfig    `yaml:"the_movie_db"`
+	Rutracker      RutrackerConfig     `yaml:"rutracker"`
+	Trackers       TrackersConfig      `yaml:"trackers"`
+	TorrentClient  TorrentClientConfig `yaml:"torrent_client"`
+	QBittorrent    QBittorrentConfig   `yaml:"qbittorrent"`
+	Transmission   TransmissionConfig  `yaml:"transmission"`
+	MediaServer    MediaServerConfig   `yaml:"media_server"`
+	Emby           EmbyConfig          `yaml:"emby"`
+	Jellyfin       JellyfinConfig      `yaml:"jellyfin"`
+	Plex           PlexConfig          `yaml:"plex"`
+	Telegram       TelegramConfig      `yaml:"telegram"`
+	Webhooks       WebhooksConfig      `yaml:"webhooks"`
+	Reminders      RemindersConfig     `yaml:"reminders"`
+	SeasonWatch    SeasonWatchConfig   `yaml:"season_watch"`
+	Postgresql     PostgresqlConfig    `yaml:"postgresql"`
+	DeliveryConfig DeliveryConfig      `yaml:"delivery"`
 }
 
 // TheMovieDbConfig конфигурация для The Movie DB API
@@ -46,6 +76,24 @@ type RutrackerConfig struct {
 	Password   string  `yaml:"password"`
 	CookiesDir string  `yaml:"cookie_dir"`
 	ProxyURL   *string `yaml:"proxy_url" optional:"true"`
+	// MaxPages сколько страниц выдачи поиска читать (по 50 раздач)
+	MaxPages int `yaml:"max_pages" optional:"true"`
+	// ForumIDs искать только в указанных форумах
+	ForumIDs []int `yaml:"forum_ids" optional:"true"`
+}
+
+// TrackersConfig дополнительные торрент трекеры, поиск раздач идет по всем сразу
+type TrackersConfig struct {
+	Torznab []TorznabConfig `yaml:"torznab" optional:"true"`
+}
+
+// TorznabConfig конфигурация для Torznab API (Jackett, Prowlarr)
+type TorznabConfig struct {
+	Name       string  `yaml:"name"`
+	BaseURL    string  `yaml:"base_url"`
+	ApiKey     string  `yaml:"api_key"`
+	Categories []int   `yaml:"categories" optional:"true"`
+	ProxyURL   *string `yaml:"proxy_url" optional:"true"`
 }
 
 // QBittorrentConfig конфигурация для QBittorrent
@@ -56,12 +104,96 @@ type QBittorrentConfig struct {
 	ApiUrl    string `yaml:"api_url"`
 }
 
+// Поддерживаемые торрент клиенты
+const (
+	TorrentClientQBittorrent  = "qbittorrent"
+	TorrentClientTransmission = "transmission"
+)
+
+// TorrentClientConfig выбор торрент клиента, по умолчанию qbittorrent
+type TorrentClientConfig struct {
+	Type string `yaml:"type" optional:"true"`
+}
+
+// TransmissionConfig конфигурация для Transmission
+type TransmissionConfig struct {
+	// RpcUrl адрес rpc, например http://localhost:9091/transmission/rpc
+	RpcUrl   string `yaml:"rpc_url" optional:"true"`
+	Username string `yaml:"username" optional:"true"`
+	Password string `yaml:"password" optional:"true"`
+}
+
 // EmbyConfig конфигурация для Emby Api
 type EmbyConfig struct {
 	ApiKey string `yaml:"api_key"`
 	ApiUrl string `yaml:"api_url"`
 }
 
+// Поддерживаемые медиасерверы
+const (
+	MediaServerEmby     = "emby"
+	MediaServerJellyfin = "jellyfin"
+	MediaServerPlex     = "plex"
+)
+
+// MediaServerConfig выбор медиасервера, по умолчанию emby
+type MediaServerConfig struct {
+	Kind string `yaml:"kind" optional:"true"`
+}
+
+// JellyfinConfig конфигурация для Jellyfin Api
+type JellyfinConfig struct {
+	ApiKey string `yaml:"api_key" optional:"true"`
+	ApiUrl string `yaml:"api_url" optional:"true"`
+}
+
+// PlexConfig конфигурация для Plex Api
+type PlexConfig struct {
+	ApiUrl string `yaml:"api_url" optional:"true"`
+	Token  string `yaml:"token" optional:"true"`
+	// MatchGuid шаблон guid агента для исправления метча, %d - id themoviedb
+	MatchGuid string `yaml:"match_guid" optional:"true"`
+}
+
+// TelegramConfig конфигурация telegram бота, если токен не указан уведомления не отправляются
+type TelegramConfig struct {
+	Token    string  `yaml:"token" optional:"true"`
+	ChatID   string  `yaml:"chat_id" optional:"true"`
+	ApiUrl   string  `yaml:"api_url" optional:"true"`
+	ProxyURL *string `yaml:"proxy_url" optional:"true"`
+}
+
+// WebhooksConfig получатели событий об изменении стейтов доставки и удаления
+type WebhooksConfig struct {
+	// MaxAttempts сколько раз пытаться отправить событие, по умолчанию 10
+	MaxAttempts int             `yaml:"max_attempts" optional:"true"`
+	Hooks       []WebhookConfig `yaml:"hooks" optional:"true"`
+}
+
+// WebhookConfig получатель событий
+type WebhookConfig struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Secret ключ HMAC-SHA256 подписи тела запроса
+	Secret string `yaml:"secret" optional:"true"`
+	// Events фильтр событий, пустой - все события
+	Events []string `yaml:"events" optional:"true"`
+}
+
+// RemindersConfig напоминания о доставках, которые долго ждут действий пользователя
+type RemindersConfig struct {
+	// WaitingUserInputMinutes через сколько минут ожидания напоминать, 0 - не напоминать
+	WaitingUserInputMinutes int `yaml:"waiting_user_input_minutes" optional:"true"`
+	// WebURL адрес веб интерфейса для ссылки на контент
+	WebURL string `yaml:"web_url" optional:"true"`
+}
+
+// SeasonWatchConfig проверка выхода новых эпизодов доставленных сезонов
+type SeasonWatchConfig struct {
+	// CheckIntervalMinutes как часто проверять сезоны, 0 - не проверять
+	CheckIntervalMinutes int `yaml:"check_interval_minutes" optional:"true"`
+}
+
 type PostgresqlConfig struct {
 	ConnString string `yaml:"conn_string"`
 }
@@ -87,6 +219,14 @@ type DeliveryConfig struct {
 	BasePath                   string `yaml:"base_path"`
 	TVShowTorrentSavePath      string `yaml:"tv_show_torrent_save_path"`
 	TVShowMediaSaveTvShowsPath string `yaml:"tv_show_media_save_tv_shows_path"`
+	MovieTorrentSavePath       string `yaml:"movie_torrent_save_path"`
+	MovieMediaSavePath         string `yaml:"movie_media_save_path"`
+	// AutoSelectTorrentThreshold оценка раздачи (0 - 100), начиная с которой она выбирается автоматически
+	AutoSelectTorrentThreshold float64 `yaml:"auto_select_torrent_threshold" optional:"true"`
+	// AutoConfirmFileMatches подтверждать однозначный метч файлов без участия пользователя
+	AutoConfirmFileMatches bool `yaml:"auto_confirm_file_matches" optional:"true"`
+	// AutoConfirmMinConfidence минимальная уверенность (0 - 1) сопоставления каждого видеофайла для автоподтверждения
+	AutoConfirmMinConfidence float64 `yaml:"auto_confirm_min_confidence" optional:"true"`
 }
 
 func loadCfg[T any](cfgName string, cfgProvider config.Provider) (*T, error) {
@@ -109,16 +249,66 @@ func NewEnvConfig(cfgProvider config.Provider) (*AppConfig, error) {
 		return nil, err
 	}
 
+	trackersConfig, err := loadCfg[TrackersConfig](TrackersName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	qBittorrentConfig, err := loadCfg[QBittorrentConfig](QBitTorrentName, cfgProvider)
 	if err != nil {
 		return nil, err
 	}
 
+	torrentClientConfig, err := loadCfg[TorrentClientConfig](TorrentClientName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	transmissionConfig, err := loadCfg[TransmissionConfig](TransmissionName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	embyConfig, err := loadCfg[EmbyConfig](EmbyName, cfgProvider)
 	if err != nil {
 		return nil, err
 	}
 
+	mediaServerConfig, err := loadCfg[MediaServerConfig](MediaServerName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	jellyfinConfig, err := loadCfg[JellyfinConfig](JellyfinName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	plexConfig, err := loadCfg[PlexConfig](PlexName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	telegramConfig, err := loadCfg[TelegramConfig](TelegramName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooksConfig, err := loadCfg[WebhooksConfig](WebhooksName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	remindersConfig, err := loadCfg[RemindersConfig](RemindersName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	seasonWatchConfig, err := loadCfg[SeasonWatchConfig](SeasonWatchName, cfgProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	postgresqlConfg, err := loadCfg[PostgresqlConfig](PostgresqlName, cfgProvider)
 	if err != nil {
 		return nil, err
@@ -138,8 +328,18 @@ func NewEnvConfig(cfgProvider config.Provider) (*AppConfig, error) {
 		Server:         *serverConfig,
 		TheMovieDb:     *movieDbConfig,
 		Rutracker:      *rutrackerConfig,
+		Trackers:       *trackersConfig,
+		TorrentClient:  *torrentClientConfig,
 		QBittorrent:    *qBittorrentConfig,
+		Transmission:   *transmissionConfig,
+		MediaServer:    *mediaServerConfig,
 		Emby:           *embyConfig,
+		Jellyfin:       *jellyfinConfig,
+		Plex:           *plexConfig,
+		Telegram:       *telegramConfig,
+		Webhooks:       *webhooksConfig,
+		Reminders:      *remindersConfig,
+		SeasonWatch:    *seasonWatchConfig,
 		Postgresql:     *postgresqlConfg,
 		DeliveryConfig: *deliveryConfig,
 	}, nil
@@ -3,35 +3,55 @@ package container
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/kkiling/goplatform/log"
 	"github.com/kkiling/goplatform/storagebase/postgrebase"
 	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
 
-	"github.com/kkiling/media-delivery/internal/adapter/emby"
 	prepareTVShow "github.com/kkiling/media-delivery/internal/adapter/matchtvshow"
+	"github.com/kkiling/media-delivery/internal/adapter/mediaprobe"
 	"github.com/kkiling/media-delivery/internal/adapter/mkvmerge"
 	mkvPostgresql "github.com/kkiling/media-delivery/internal/adapter/mkvmerge/storage/postgresql"
-	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
 	"github.com/kkiling/media-delivery/internal/adapter/rutracker"
 	"github.com/kkiling/media-delivery/internal/adapter/themoviedb"
+	"github.com/kkiling/media-delivery/internal/adapter/torznab"
+	"github.com/kkiling/media-delivery/internal/adapter/trackers"
+	"github.com/kkiling/media-delivery/internal/adapter/webhook"
 	"github.com/kkiling/media-delivery/internal/config"
 	"github.com/kkiling/media-delivery/internal/usercase/labels"
 	labelsPostgreSql "github.com/kkiling/media-delivery/internal/usercase/labels/storage/postgresql"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+	movieLibraryPostgreSql "github.com/kkiling/media-delivery/internal/usercase/movielibrary/storage/postgresql"
 	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
 	tvShowLibraryPostgreSql "github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary/storage/postgresql"
 	contentDelivery "github.com/kkiling/media-delivery/internal/usercase/videocontent/content"
 	contentPostgreSql "github.com/kkiling/media-delivery/internal/usercase/videocontent/content/storage/postgresql"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelete"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+	qualityProfilePostgreSql "github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile/storage/postgresql"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeletestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowcancelstate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeletestate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowupdatestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowcancel"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelete"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
+	webhooksPostgreSql "github.com/kkiling/media-delivery/internal/usercase/webhooks/storage/postgresql"
 )
 
 type Container struct {
 	logger           log.Logger
 	tvShowLibrary    *tvshowlibrary.Service
+	movieLibrary     *movielibrary.Service
 	contentDelivery  *contentDelivery.Service
+	qualityProfiles  *qualityprofile.Service
+	webhooks         *webhooks.Service
 	mkvMergePipeline *mkvmerge.Pipeline
 }
 
@@ -49,9 +69,12 @@ func NewContainer(ctx context.Context, cfg *config.AppConfig) (*Container, error
 
 	stateStorage := statemachine.NewStorage(pgPool)
 	tvShowLibraryStorage := tvShowLibraryPostgreSql.NewStorage(pgPool)
+	movieLibraryStorage := movieLibraryPostgreSql.NewStorage(pgPool)
 	contentStorage := contentPostgreSql.NewStorage(pgPool)
 	mkvPipelineStorage := mkvPostgresql.NewStorage(pgPool)
 	labelsStorage := labelsPostgreSql.NewStorage(pgPool)
+	qualityProfileStorage := qualityProfilePostgreSql.NewStorage(pgPool)
+	webhooksStorage := webhooksPostgreSql.NewStorage(pgPool)
 	// *** *** ***
 
 	// Adapter
@@ -66,20 +89,19 @@ func NewContainer(ctx context.Context, cfg *config.AppConfig) (*Container, error
 		return nil, fmt.Errorf("themoviedb.NewApi: %w", err)
 	}
 
-	embyApi, err := emby.NewApi(cfg.Emby.ApiKey, cfg.Emby.ApiUrl, logger)
+	mediaServerApi, err := newMediaServer(logger, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("emby.NewApi: %w", err)
+		return nil, fmt.Errorf("newMediaServer: %w", err)
 	}
 
-	qBittorrentApi, err := qbittorrent.NewApi(
-		logger,
-		cfg.QBittorrent.ApiUrl,
-		cfg.QBittorrent.Username,
-		cfg.QBittorrent.Password,
-		cfg.QBittorrent.CookieDir,
-	)
+	torrentClientApi, err := newTorrentClient(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("newTorrentClient: %w", err)
+	}
+
+	deliveryNotifierApi, err := newNotifier(logger, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("qbittorrent.NewApi: %w", err)
+		return nil, fmt.Errorf("newNotifier: %w", err)
 	}
 
 	rutrackerApi, err := rutracker.NewApi(
@@ -89,35 +111,83 @@ func NewContainer(ctx context.Context, cfg *config.AppConfig) (*Container, error
 			Password:   cfg.Rutracker.Password,
 			CookiesDir: cfg.Rutracker.CookiesDir,
 			ProxyURL:   cfg.Rutracker.ProxyURL,
+			MaxPages:   cfg.Rutracker.MaxPages,
+			ForumIDs:   cfg.Rutracker.ForumIDs,
 		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("rutracker.NewApi: %w", err)
 	}
 
+	torrentTrackers := []trackers.Tracker{trackers.NewRutracker(rutrackerApi)}
+	for _, torznabCfg := range cfg.Trackers.Torznab {
+		torznabApi, err := torznab.NewApi(
+			logger,
+			torznab.Config{
+				Name:       torznabCfg.Name,
+				BaseURL:    torznabCfg.BaseURL,
+				ApiKey:     torznabCfg.ApiKey,
+				Categories: torznabCfg.Categories,
+				ProxyURL:   torznabCfg.ProxyURL,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("torznab.NewApi: %w", err)
+		}
+		torrentTrackers = append(torrentTrackers, trackers.NewTorznab(torznabCfg.Name, torznabApi))
+	}
+	trackersService := trackers.NewService(logger, torrentTrackers...)
+
 	mkvMerge := mkvmerge.NewMerge(logger)
 	mkvPipeline := mkvmerge.NewPipeline(mkvMerge, mkvPipelineStorage, logger)
 
 	prepareTVShowService := prepareTVShow.NewService()
+	mediaProbeService := mediaprobe.NewService()
 
 	labelsService := labels.NewService(labelsStorage)
+	qualityProfileService := qualityprofile.NewService(qualityProfileStorage)
+
+	webhooksConfig := webhooks.Config{
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		Webhooks: lo.Map(cfg.Webhooks.Hooks, func(item config.WebhookConfig, _ int) webhooks.Webhook {
+			return webhooks.Webhook{
+				Name:   item.Name,
+				URL:    item.URL,
+				Secret: item.Secret,
+				Events: lo.Map(item.Events, func(event string, _ int) webhooks.EventType {
+					return webhooks.EventType(event)
+				}),
+			}
+		}),
+	}
+	if err = webhooksConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("webhooksConfig.Validate: %w", err)
+	}
+	webhooksService := webhooks.NewService(logger, webhooksConfig, webhooksStorage, webhook.NewClient(logger))
 
 	// UserCase
 	tvShowLibrary := tvshowlibrary.NewService(tvShowLibraryStorage, themoviedbApi)
+	movieLibrary := movielibrary.NewService(movieLibraryStorage, themoviedbApi)
 
 	tvShowDeliveryService := tvshowdelivery.NewService(
+		logger,
 		tvshowdelivery.Config{
 			BasePath:                   cfg.DeliveryConfig.BasePath,
 			TVShowTorrentSavePath:      cfg.DeliveryConfig.TVShowTorrentSavePath,
 			TVShowMediaSaveTvShowsPath: cfg.DeliveryConfig.TVShowMediaSaveTvShowsPath,
+			AutoSelectTorrentThreshold: cfg.DeliveryConfig.AutoSelectTorrentThreshold,
+			AutoConfirmFileMatches:     cfg.DeliveryConfig.AutoConfirmFileMatches,
+			AutoConfirmMinConfidence:   cfg.DeliveryConfig.AutoConfirmMinConfidence,
 		},
 		tvShowLibrary,
-		rutrackerApi,
-		qBittorrentApi,
-		embyApi,
+		trackersService,
+		torrentClientApi,
+		mediaServerApi,
 		prepareTVShowService,
+		mediaProbeService,
 		mkvPipeline,
 		labelsService,
+		deliveryNotifierApi,
 	)
 	tvShowDeleteService := tvshowdelete.NewService(
 		tvshowdelete.Config{
@@ -125,27 +195,79 @@ func NewContainer(ctx context.Context, cfg *config.AppConfig) (*Container, error
 			TVShowTorrentSavePath:      cfg.DeliveryConfig.TVShowTorrentSavePath,
 			TVShowMediaSaveTvShowsPath: cfg.DeliveryConfig.TVShowMediaSaveTvShowsPath,
 		},
-		qBittorrentApi,
-		embyApi,
+		torrentClientApi,
+		mediaServerApi,
+		labelsService,
+	)
+	tvShowCancelService := tvshowcancel.NewService(
+		tvshowcancel.Config{
+			BasePath: cfg.DeliveryConfig.BasePath,
+		},
+		torrentClientApi,
+		mkvPipeline,
+		labelsService,
+	)
+
+	movieDeliveryService := moviedelivery.NewService(
+		moviedelivery.Config{
+			BasePath:             cfg.DeliveryConfig.BasePath,
+			MovieTorrentSavePath: cfg.DeliveryConfig.MovieTorrentSavePath,
+			MovieMediaSavePath:   cfg.DeliveryConfig.MovieMediaSavePath,
+		},
+		movieLibrary,
+		trackersService,
+		torrentClientApi,
+		mediaServerApi,
+		prepareTVShowService,
+		mkvPipeline,
+		labelsService,
+	)
+	movieDeleteService := moviedelete.NewService(
+		moviedelete.Config{
+			BasePath: cfg.DeliveryConfig.BasePath,
+		},
+		torrentClientApi,
+		mediaServerApi,
 		labelsService,
 	)
 
 	tvShowDeliveryStateMachine := tvshowdeliverystate.NewState(tvShowDeliveryService, stateStorage)
 	tvShowDeleteStateMachine := tvshowdeletestate.NewState(tvShowDeleteService, stateStorage)
+	tvShowCancelStateMachine := tvshowcancelstate.NewState(tvShowCancelService, stateStorage)
+	tvShowUpdateStateMachine := tvshowupdatestate.NewState(tvShowDeliveryService, stateStorage)
+	movieDeliveryStateMachine := moviedeliverystate.NewState(movieDeliveryService, stateStorage)
+	movieDeleteStateMachine := moviedeletestate.NewState(movieDeleteService, stateStorage)
 
 	deliveryContent := contentDelivery.NewService(
 		logger,
+		contentDelivery.Config{
+			WaitingUserInputReminder: time.Duration(cfg.Reminders.WaitingUserInputMinutes) * time.Minute,
+			WebURL:                   cfg.Reminders.WebURL,
+			SeasonWatchInterval:      time.Duration(cfg.SeasonWatch.CheckIntervalMinutes) * time.Minute,
+		},
 		contentStorage,
 		tvShowLibrary,
+		tvShowDeliveryService,
 		tvShowDeliveryStateMachine,
 		tvShowDeleteStateMachine,
+		tvShowCancelStateMachine,
+		tvShowUpdateStateMachine,
+		movieLibrary,
+		movieDeliveryStateMachine,
+		movieDeleteStateMachine,
 		labelsService,
+		qualityProfileService,
+		webhooksService,
+		deliveryNotifierApi,
 	)
 
 	return &Container{
 		logger:           logger,
 		tvShowLibrary:    tvShowLibrary,
+		movieLibrary:     movieLibrary,
 		contentDelivery:  deliveryContent,
+		qualityProfiles:  qualityProfileService,
+		webhooks:         webhooksService,
 		mkvMergePipeline: mkvPipeline,
 	}, nil
 }
@@ -154,10 +276,22 @@ func (c *Container) GetTvShowLibrary() *tvshowlibrary.Service {
 	return c.tvShowLibrary
 }
 
+func (c *Container) GetMovieLibrary() *movielibrary.Service {
+	return c.movieLibrary
+}
+
 func (c *Container) GetContentDelivery() *contentDelivery.Service {
 	return c.contentDelivery
 }
 
+func (c *Container) GetQualityProfiles() *qualityprofile.Service {
+	return c.qualityProfiles
+}
+
+func (c *Container) GetWebhooks() *webhooks.Service {
+	return c.webhooks
+}
+
 func (c *Container) MkvMergePipeline() *mkvmerge.Pipeline {
 	return c.mkvMergePipeline
 }
@@ -0,0 +1,49 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+	"github.com/kkiling/media-delivery/internal/adapter/jellyfin"
+	"github.com/kkiling/media-delivery/internal/adapter/plex"
+	"github.com/kkiling/media-delivery/internal/config"
+)
+
+// mediaServer методы медиасервера, которые используются при доставке и удалении контента
+type mediaServer interface {
+	ScanPath(path string) error
+	ResetMetadata(embyID uint64) error
+	RemoteSearchApply(embyID, theMovieDBID uint64) error
+	GetCatalogInfo(path string) (*emby.CatalogInfo, error)
+}
+
+// newMediaServer создает клиент медиасервера выбранного в конфиге
+func newMediaServer(logger log.Logger, cfg *config.AppConfig) (mediaServer, error) {
+	switch cfg.MediaServer.Kind {
+	case "", config.MediaServerEmby:
+		api, err := emby.NewApi(cfg.Emby.ApiKey, cfg.Emby.ApiUrl, logger)
+		if err != nil {
+			return nil, fmt.Errorf("emby.NewApi: %w", err)
+		}
+		return api, nil
+	case config.MediaServerJellyfin:
+		api, err := jellyfin.NewApi(cfg.Jellyfin.ApiKey, cfg.Jellyfin.ApiUrl, logger)
+		if err != nil {
+			return nil, fmt.Errorf("jellyfin.NewApi: %w", err)
+		}
+		return api, nil
+	case config.MediaServerPlex:
+		api, err := plex.NewApi(plex.Config{
+			BaseURL:   cfg.Plex.ApiUrl,
+			Token:     cfg.Plex.Token,
+			MatchGuid: cfg.Plex.MatchGuid,
+		}, logger)
+		if err != nil {
+			return nil, fmt.Errorf("plex.NewApi: %w", err)
+		}
+		return api, nil
+	}
+	return nil, fmt.Errorf("unknown media server: %s", cfg.MediaServer.Kind)
+}
@@ -0,0 +1,34 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/notifier"
+	"github.com/kkiling/media-delivery/internal/config"
+)
+
+// deliveryNotifier отправка уведомлений о доставке контента
+type deliveryNotifier interface {
+	Send(ctx context.Context, text string) error
+}
+
+// newNotifier создает telegram бота, если он настроен, иначе уведомления только пишутся в лог
+func newNotifier(logger log.Logger, cfg *config.AppConfig) (deliveryNotifier, error) {
+	if cfg.Telegram.Token == "" {
+		return notifier.NewNoop(logger), nil
+	}
+
+	telegram, err := notifier.NewTelegram(logger, notifier.TelegramConfig{
+		Token:    cfg.Telegram.Token,
+		ChatID:   cfg.Telegram.ChatID,
+		ApiURL:   cfg.Telegram.ApiUrl,
+		ProxyURL: cfg.Telegram.ProxyURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("notifier.NewTelegram: %w", err)
+	}
+	return telegram, nil
+}
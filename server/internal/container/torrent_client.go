@@ -0,0 +1,50 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/kkiling/goplatform/log"
+
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+	"github.com/kkiling/media-delivery/internal/adapter/transmission"
+	"github.com/kkiling/media-delivery/internal/config"
+)
+
+// torrentClient методы торрент клиента, которые используются при доставке и удалении контента
+type torrentClient interface {
+	AddTorrent(opts qbittorrent.TorrentAddOptions) error
+	GetTorrentInfo(hash string) (*qbittorrent.TorrentInfo, error)
+	GetTorrentFiles(hash string) ([]qbittorrent.TorrentFile, error)
+	ResumeTorrent(hash string) error
+	DeleteTorrent(hash string, deleteFiles bool) error
+}
+
+// newTorrentClient создает торрент клиент выбранный в конфиге
+func newTorrentClient(logger log.Logger, cfg *config.AppConfig) (torrentClient, error) {
+	switch cfg.TorrentClient.Type {
+	case "", config.TorrentClientQBittorrent:
+		api, err := qbittorrent.NewApi(
+			logger,
+			cfg.QBittorrent.ApiUrl,
+			cfg.QBittorrent.Username,
+			cfg.QBittorrent.Password,
+			cfg.QBittorrent.CookieDir,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("qbittorrent.NewApi: %w", err)
+		}
+		return api, nil
+	case config.TorrentClientTransmission:
+		api, err := transmission.NewApi(
+			logger,
+			cfg.Transmission.RpcUrl,
+			cfg.Transmission.Username,
+			cfg.Transmission.Password,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("transmission.NewApi: %w", err)
+		}
+		return api, nil
+	}
+	return nil, fmt.Errorf("unknown torrent client: %s", cfg.TorrentClient.Type)
+}
@@ -0,0 +1,63 @@
+package mapto
+
+import (
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+	desc "github.com/kkiling/media-delivery/pkg/gen/media-delivery"
+)
+
+func Image(res *movielibrary.Image) *desc.Image {
+	if res == nil {
+		return nil
+	}
+	return &desc.Image{
+		Id:       res.ID,
+		W92:      lo.FromPtr(res.W92),
+		W185:     lo.FromPtr(res.W185),
+		W342:     lo.FromPtr(res.W342),
+		Original: res.Original,
+	}
+}
+
+func MovieShort(res movielibrary.MovieShort) *desc.MovieShort {
+	return &desc.MovieShort{
+		Id:            res.ID,
+		Title:         res.Title,
+		OriginalTitle: res.OriginalTitle,
+		Overview:      res.Overview,
+		Poster:        Image(res.Poster),
+		ReleaseDate:   timestamppb.New(res.ReleaseDate),
+		VoteAverage:   res.VoteAverage,
+		VoteCount:     uint32(res.VoteCount),
+		Popularity:    res.Popularity,
+	}
+}
+
+func MovieShorts(res []movielibrary.MovieShort) []*desc.MovieShort {
+	return lo.Map(res, func(item movielibrary.MovieShort, _ int) *desc.MovieShort {
+		return MovieShort(item)
+	})
+}
+
+func Movie(res *movielibrary.Movie) *desc.Movie {
+	return &desc.Movie{
+		Id:            res.ID,
+		Title:         res.Title,
+		OriginalTitle: res.OriginalTitle,
+		Overview:      res.Overview,
+		Poster:        Image(res.Poster),
+		ReleaseDate:   timestamppb.New(res.ReleaseDate),
+		VoteAverage:   res.VoteAverage,
+		VoteCount:     uint32(res.VoteCount),
+		Popularity:    res.Popularity,
+		Backdrop:      Image(res.Backdrop),
+		Genres:        res.Genres,
+		ImdbId:        res.ImdbID,
+		OriginCountry: res.OriginCountry,
+		Runtime:       uint32(res.Runtime),
+		Status:        res.Status,
+		Tagline:       res.Tagline,
+	}
+}
@@ -0,0 +1,48 @@
+package movielibrary
+
+import (
+	"context"
+
+	"github.com/kkiling/media-delivery/internal/server/handler"
+	"github.com/kkiling/media-delivery/internal/server/handler/movielibrary/mapto"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+	desc "github.com/kkiling/media-delivery/pkg/gen/media-delivery"
+)
+
+func (h *Handler) SearchMovie(ctx context.Context, request *desc.SearchMovieRequest) (*desc.SearchMovieResponse, error) {
+	result, err := h.movieLibrary.SearchMovie(ctx, movielibrary.MovieSearchParams{
+		Query: request.Query,
+	})
+
+	if err != nil {
+		return nil, handler.HandleError(err, "movieLibrary.SearchMovie")
+	}
+
+	return &desc.SearchMovieResponse{
+		Items: mapto.MovieShorts(result.Items),
+	}, nil
+}
+
+func (h *Handler) GetMovieInfo(ctx context.Context, request *desc.GetMovieInfoRequest) (*desc.GetMovieInfoResponse, error) {
+	result, err := h.movieLibrary.GetMovieInfo(ctx, movielibrary.GetMovieParams{
+		MovieID: request.MovieId,
+	})
+
+	if err != nil {
+		return nil, handler.HandleError(err, "movieLibrary.GetMovieInfo")
+	}
+
+	return &desc.GetMovieInfoResponse{Result: mapto.Movie(result.Result)}, nil
+}
+
+func (h *Handler) GetMoviesFromLibrary(ctx context.Context, request *desc.GetMoviesFromLibraryRequest) (*desc.GetMoviesFromLibraryResponse, error) {
+	result, err := h.movieLibrary.GetMoviesFromLibrary(ctx, movielibrary.GetMoviesFromLibraryParams{})
+
+	if err != nil {
+		return nil, handler.HandleError(err, "movieLibrary.GetMoviesFromLibrary")
+	}
+
+	return &desc.GetMoviesFromLibraryResponse{
+		Items: mapto.MovieShorts(result.Items),
+	}, nil
+}
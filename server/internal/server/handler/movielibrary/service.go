@@ -0,0 +1,50 @@
+package movielibrary
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/kkiling/goplatform/server"
+	"google.golang.org/grpc"
+
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+	desc "github.com/kkiling/media-delivery/pkg/gen/media-delivery"
+)
+
+// MovieLibrary юзеркейс работы с библиотекой фильмов
+type MovieLibrary interface {
+	SearchMovie(ctx context.Context, params movielibrary.MovieSearchParams) (*movielibrary.MovieSearchResult, error)
+	GetMovieInfo(ctx context.Context, params movielibrary.GetMovieParams) (*movielibrary.GetMovieResult, error)
+	GetMoviesFromLibrary(ctx context.Context, params movielibrary.GetMoviesFromLibraryParams) (*movielibrary.GetMoviesFromLibraryResult, error)
+}
+
+type Handler struct {
+	desc.MovieLibraryServiceServer
+	logger       log.Logger
+	movieLibrary MovieLibrary
+}
+
+// NewHandler новый хендлер
+func NewHandler(logger log.Logger,
+	movieLibrary MovieLibrary,
+) *Handler {
+	return &Handler{
+		logger:       logger.Named("movie_library"),
+		movieLibrary: movieLibrary,
+	}
+}
+
+// RegistrationServerHandlers .
+func (h *Handler) RegistrationServerHandlers(_ *http.ServeMux) {
+}
+
+// RegisterServiceHandlerFromEndpoint .
+func (h *Handler) RegisterServiceHandlerFromEndpoint() server.HandlerFromEndpoint {
+	return desc.RegisterMovieLibraryServiceHandlerFromEndpoint
+}
+
+// RegisterServiceServer регистрация
+func (h *Handler) RegisterServiceServer(server *grpc.Server) {
+	desc.RegisterMovieLibraryServiceServer(server, h)
+}
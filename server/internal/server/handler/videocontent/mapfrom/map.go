@@ -1,8 +1,12 @@
 package mapfrom
 
 import (
+	"fmt"
+
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 	desc "github.com/kkiling/media-delivery/pkg/gen/media-delivery"
@@ -26,6 +30,15 @@ func ContentID(id *desc.ContentID) videocontent.ContentID {
 		}
 	}
 
+	if id.VideoContentId != nil {
+		// Невалидный id отсекается при валидации ContentID
+		videoContentID, err := uuid.Parse(*id.VideoContentId)
+		if err != nil {
+			videoContentID = uuid.Nil
+		}
+		result.VideoContentID = &videoContentID
+	}
+
 	return result
 }
 
@@ -98,3 +111,26 @@ func ContentMatches(match *desc.ContentMatches) *videocontent.ContentMatches {
 		Options:     options,
 	}
 }
+
+func QualityProfileID(id string) (uuid.UUID, error) {
+	result, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid quality profile id: %w", ucerr.InvalidArgument)
+	}
+	return result, nil
+}
+
+func QualityProfileParams(params *desc.QualityProfileParams) videocontent.CreateQualityProfileParams {
+	if params == nil {
+		return videocontent.CreateQualityProfileParams{}
+	}
+	return videocontent.CreateQualityProfileParams{
+		Name:              params.Name,
+		MinResolution:     int(params.MinResolution),
+		MaxResolution:     int(params.MaxResolution),
+		PreferredCodecs:   params.PreferredCodecs,
+		ForbiddenCodecs:   params.ForbiddenCodecs,
+		RequiredAudio:     params.RequiredAudio,
+		MaxSizePerEpisode: params.MaxSizePerEpisode,
+	}
+}
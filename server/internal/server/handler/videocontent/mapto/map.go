@@ -32,6 +32,37 @@ func VideoContent(in videocontent.VideoContent) *desc.VideoContent {
 		Id:             in.ID.String(),
 		CreatedAt:      timestamppb.New(in.CreatedAt),
 		DeliveryStatus: deliveryStatus(in.DeliveryStatus),
+		Version:        in.Version,
+	}
+}
+
+func SeasonCheck(in *videocontent.SeasonCheck) *desc.SeasonCheck {
+	result := &desc.SeasonCheck{
+		CheckedAt:              timestamppb.New(in.CheckedAt),
+		Result:                 seasonCheckResult(in.Result),
+		EpisodesCount:          int32(in.EpisodesCount),
+		AiredEpisodesCount:     int32(in.AiredEpisodesCount),
+		DeliveredEpisodesCount: int32(in.DeliveredEpisodesCount),
+		Error:                  in.Error,
+	}
+	if in.NextEpisodeAirDate != nil {
+		result.NextEpisodeAirDate = timestamppb.New(*in.NextEpisodeAirDate)
+	}
+	return result
+}
+
+func seasonCheckResult(result videocontent.SeasonCheckResult) desc.SeasonCheckResult {
+	switch result {
+	case videocontent.SeasonCheckUpToDate:
+		return desc.SeasonCheckResult_SeasonCheckResultUpToDate
+	case videocontent.SeasonCheckWaitingTorrent:
+		return desc.SeasonCheckResult_SeasonCheckResultWaitingTorrent
+	case videocontent.SeasonCheckUpdateStarted:
+		return desc.SeasonCheckResult_SeasonCheckResultUpdateStarted
+	case videocontent.SeasonCheckError:
+		return desc.SeasonCheckResult_SeasonCheckResultError
+	default:
+		return desc.SeasonCheckResult_SeasonCheckResultUnknown
 	}
 }
 
@@ -49,6 +80,58 @@ func tracks(tracks []videocontent.Track) []*desc.Track {
 			Name:         item.Name,
 			Language:     item.Language,
 			Type:         trackType(item.Type),
+			Match:        trackMatch(item.Match),
+			Media:        trackMedia(item.Media),
+		}
+	})
+}
+
+func trackMedia(media *videocontent.TrackMedia) *desc.TrackMedia {
+	if media == nil {
+		return nil
+	}
+	return &desc.TrackMedia{
+		Codec:           media.Codec,
+		Channels:        uint32(media.Channels),
+		DurationSeconds: media.Duration.Seconds(),
+		Language:        media.Language,
+		EmbeddedTracks: lo.Map(media.EmbeddedTracks, func(item videocontent.EmbeddedTrack, _ int) *desc.EmbeddedTrack {
+			return &desc.EmbeddedTrack{
+				Type:         trackType(item.Type),
+				Codec:        item.Codec,
+				Language:     item.Language,
+				Name:         item.Name,
+				Channels:     uint32(item.Channels),
+				DefaultTrack: item.Default,
+				ForcedTrack:  item.Forced,
+			}
+		}),
+	}
+}
+
+func trackMatch(match *videocontent.TrackMatch) *desc.TrackMatch {
+	if match == nil {
+		return nil
+	}
+	return &desc.TrackMatch{
+		SeasonNumber:  uint32(match.SeasonNumber),
+		EpisodeNumber: uint32(match.EpisodeNumber),
+		Pattern:       match.Pattern,
+		Confidence:    match.Confidence,
+	}
+}
+
+func matchWarnings(warnings []videocontent.MatchWarning) []desc.ContentMatch_Warning {
+	return lo.Map(warnings, func(item videocontent.MatchWarning, _ int) desc.ContentMatch_Warning {
+		switch item {
+		case videocontent.MatchWarningDuplicateEpisode:
+			return desc.ContentMatch_WARNING_DUPLICATE_EPISODE
+		case videocontent.MatchWarningVideoClaimedTwice:
+			return desc.ContentMatch_WARNING_VIDEO_CLAIMED_TWICE
+		case videocontent.MatchWarningAudioTracksCountMismatch:
+			return desc.ContentMatch_WARNING_AUDIO_TRACKS_COUNT_MISMATCH
+		default:
+			return desc.ContentMatch_WARNING_UNKNOWN
 		}
 	})
 }
@@ -63,19 +146,41 @@ func tvShowDeliveryData(state *videocontent.TVShowDeliveryState) *desc.TVShowDel
 				return state.Data.SearchQuery.Query
 			}(),
 		},
+		Restored:                 state.Data.Restore != nil,
+		FileMatchesAutoConfirmed: state.Data.FileMatchesAutoConfirmed,
+	}
+	if state.Data.Pack != nil {
+		result.PackSourceSeasonNumber = lo.ToPtr(uint32(state.Data.Pack.SourceSeasonNumber))
+	}
+	if state.Data.QualityProfile != nil {
+		result.QualityProfile = QualityProfile(*state.Data.QualityProfile)
 	}
+	result.RetryHistory = lo.Map(state.Data.RetryHistory, func(item videocontent.RetryAttempt, _ int) *desc.RetryAttempt {
+		return &desc.RetryAttempt{
+			Step:                  tvShowDeliveryStep(item.Step),
+			Error:                 item.Error,
+			RetriedAt:             timestamppb.New(item.RetriedAt),
+			Href:                  item.Href,
+			ContentMatchesChanged: item.ContentMatchesChanged,
+		}
+	})
 
 	if state.Step == videocontent.WaitingUserChoseTorrent {
 		result.TorrentSearch = lo.Map(state.Data.TorrentSearch, func(item videocontent.TorrentSearch, _ int) *desc.TorrentSearch {
 			return &desc.TorrentSearch{
-				Title:     item.Title,
-				Href:      item.Href,
-				Size:      item.SizePretty,
-				Seeds:     int64(item.Seeds),
-				Leeches:   int64(item.Leeches),
-				Downloads: int64(item.Downloads),
-				AddedDate: item.AddedDate,
-				Category:  item.Category,
+				Title:             item.Title,
+				Href:              item.Href,
+				Size:              item.SizePretty,
+				Seeds:             int64(item.Seeds),
+				Leeches:           int64(item.Leeches),
+				Downloads:         int64(item.Downloads),
+				AddedDate:         item.AddedDate,
+				Category:          item.Category,
+				Source:            item.Source,
+				Score:             item.Score,
+				ScoreReasons:      item.ScoreReasons,
+				MatchesProfile:    item.MatchesProfile,
+				ProfileMismatches: item.ProfileMismatches,
 			}
 		})
 	}
@@ -93,9 +198,12 @@ func tvShowDeliveryData(state *videocontent.TVShowDeliveryState) *desc.TVShowDel
 					RelativePath: item.Video.File.RelativePath,
 					FullPath:     item.Video.File.FullPath,
 					Type:         trackType(item.Video.Type),
+					Match:        trackMatch(item.Video.Match),
+					Media:        trackMedia(item.Video.Media),
 				},
 				AudioTracks: tracks(item.AudioTracks),
 				Subtitles:   tracks(item.Subtitles),
+				Warnings:    matchWarnings(item.Warnings),
 			}
 		})
 
@@ -243,6 +351,10 @@ func deliveryStatus(deliveryStatus videocontent.DeliveryStatus) desc.DeliverySta
 		return desc.DeliveryStatus_DeliveryStatusDeleting
 	case videocontent.DeliveryStatusDeleted:
 		return desc.DeliveryStatus_DeliveryStatusDeleted
+	case videocontent.DeliveryStatusCanceling:
+		return desc.DeliveryStatus_DeliveryStatusCanceling
+	case videocontent.DeliveryStatusCanceled:
+		return desc.DeliveryStatus_DeliveryStatusCanceled
 	default:
 		return desc.DeliveryStatus_DeliveryStatusUnknown
 	}
@@ -286,6 +398,8 @@ func tvShowDeliveryStep(step videocontent.StepDelivery) desc.TVShowDeliveryStep
 		return desc.TVShowDeliveryStep_WaitingTorrentFiles
 	case videocontent.GetEpisodesData:
 		return desc.TVShowDeliveryStep_GetEpisodesData
+	case videocontent.ProbeMediaFiles:
+		return desc.TVShowDeliveryStep_ProbeMediaFiles
 
 	default:
 		return desc.TVShowDeliveryStep_TVShowDeliveryStepUnknown
@@ -308,3 +422,315 @@ func tvShowDeleteError(state *videocontent.TVShowDeleteState) *desc.TVShowDelete
 		ErrorType: desc.TVShowDeleteError_TVShowDeleteError_Unknown,
 	}
 }
+
+func TVShowCancelState(state *videocontent.TVShowCancelState) *desc.TVShowCancelState {
+	return &desc.TVShowCancelState{
+		Step:   tvShowCancelStep(state.Step),
+		Status: status(state.Status),
+		Error:  tvShowCancelError(state),
+	}
+}
+
+func tvShowCancelStep(step videocontent.StepCancel) desc.TVShowCancelStep {
+	switch step {
+	case videocontent.StartCancelTVShowDelivery:
+		return desc.TVShowCancelStep_StartCancelTVShowDelivery
+	case videocontent.CancelMergeVideoFiles:
+		return desc.TVShowCancelStep_CancelMergeVideoFiles
+	case videocontent.CancelDeleteTorrentFromTorrentClient:
+		return desc.TVShowCancelStep_CancelDeleteTorrentFromTorrentClient
+	case videocontent.CancelDeleteTorrentFiles:
+		return desc.TVShowCancelStep_CancelDeleteTorrentFiles
+	case videocontent.CancelDeleteSeasonCatalog:
+		return desc.TVShowCancelStep_CancelDeleteSeasonCatalog
+	case videocontent.CancelDeleteLabel:
+		return desc.TVShowCancelStep_CancelDeleteLabel
+	default:
+		return desc.TVShowCancelStep_TVShowCancelStepUnknown
+	}
+}
+
+func tvShowCancelError(state *videocontent.TVShowCancelState) *desc.TVShowCancelError {
+	if state.Error == nil {
+		return nil
+	}
+	return &desc.TVShowCancelError{
+		RawError:  *state.Error,
+		ErrorType: desc.TVShowCancelError_TVShowCancelError_Unknown,
+	}
+}
+
+func TVShowUpdateState(state *videocontent.TVShowUpdateState) *desc.TVShowUpdateState {
+	return &desc.TVShowUpdateState{
+		Step:             tvShowUpdateStep(state.Step),
+		Status:           status(state.Status),
+		Error:            tvShowUpdateError(state),
+		TorrentChanged:   state.Data.TorrentChanged(),
+		NewEpisodesCount: int32(state.Data.NewEpisodesCount()),
+	}
+}
+
+func tvShowUpdateStep(step videocontent.StepUpdate) desc.TVShowUpdateStep {
+	switch step {
+	case videocontent.StartUpdateTVShowSeason:
+		return desc.TVShowUpdateStep_StartUpdateTVShowSeason
+	case videocontent.UpdateGetMagnetLink:
+		return desc.TVShowUpdateStep_UpdateGetMagnetLink
+	case videocontent.UpdateAddTorrentToTorrentClient:
+		return desc.TVShowUpdateStep_UpdateAddTorrentToTorrentClient
+	case videocontent.UpdateDeleteOldTorrentFromTorrentClient:
+		return desc.TVShowUpdateStep_UpdateDeleteOldTorrentFromTorrentClient
+	case videocontent.UpdateWaitingTorrentFiles:
+		return desc.TVShowUpdateStep_UpdateWaitingTorrentFiles
+	case videocontent.UpdateGetEpisodesData:
+		return desc.TVShowUpdateStep_UpdateGetEpisodesData
+	case videocontent.UpdatePrepareNewFileMatches:
+		return desc.TVShowUpdateStep_UpdatePrepareNewFileMatches
+	case videocontent.UpdateWaitingTorrentDownloadComplete:
+		return desc.TVShowUpdateStep_UpdateWaitingTorrentDownloadComplete
+	case videocontent.UpdateProbeMediaFiles:
+		return desc.TVShowUpdateStep_UpdateProbeMediaFiles
+	case videocontent.UpdateDeterminingNeedConvertFiles:
+		return desc.TVShowUpdateStep_UpdateDeterminingNeedConvertFiles
+	case videocontent.UpdateStartMergeVideoFiles:
+		return desc.TVShowUpdateStep_UpdateStartMergeVideoFiles
+	case videocontent.UpdateWaitingMergeVideoFiles:
+		return desc.TVShowUpdateStep_UpdateWaitingMergeVideoFiles
+	case videocontent.UpdateCreateHardLinkCopy:
+		return desc.TVShowUpdateStep_UpdateCreateHardLinkCopy
+	case videocontent.UpdateSetMediaMetaData:
+		return desc.TVShowUpdateStep_UpdateSetMediaMetaData
+	default:
+		return desc.TVShowUpdateStep_TVShowUpdateStepUnknown
+	}
+}
+
+func tvShowUpdateError(state *videocontent.TVShowUpdateState) *desc.TVShowUpdateError {
+	if state.Error == nil {
+		return nil
+	}
+	return &desc.TVShowUpdateError{
+		RawError:  *state.Error,
+		ErrorType: desc.TVShowUpdateError_TVShowUpdateError_Unknown,
+	}
+}
+
+func movieTracks(tracks []videocontent.MovieTrack) []*desc.Track {
+	return lo.Map(tracks, func(item videocontent.MovieTrack, _ int) *desc.Track {
+		return &desc.Track{
+			RelativePath: item.File.RelativePath,
+			FullPath:     item.File.FullPath,
+			Name:         item.Name,
+			Language:     item.Language,
+			Type:         movieTrackType(item.Type),
+		}
+	})
+}
+
+func movieTrackType(typeTrack videocontent.MovieTrackType) desc.Track_TrackType {
+	return trackType(videocontent.TrackType(typeTrack))
+}
+
+func movieDeliveryData(state *videocontent.MovieDeliveryState) *desc.MovieDeliveryData {
+	result := &desc.MovieDeliveryData{
+		SearchQuery: &desc.SearchQuery{
+			Query: func() string {
+				if state.Data.SearchQuery == nil {
+					return ""
+				}
+				return state.Data.SearchQuery.Query
+			}(),
+		},
+		RetryHistory: lo.Map(state.Data.RetryHistory, func(item videocontent.MovieRetryAttempt, _ int) *desc.MovieRetryAttempt {
+			return &desc.MovieRetryAttempt{
+				Step:      movieDeliveryStep(item.Step),
+				Error:     item.Error,
+				RetriedAt: timestamppb.New(item.RetriedAt),
+				Href:      item.Href,
+			}
+		}),
+	}
+
+	if state.Step == videocontent.MovieWaitingUserChoseTorrent {
+		result.TorrentSearch = lo.Map(state.Data.TorrentSearch, func(item videocontent.MovieTorrentSearch, _ int) *desc.TorrentSearch {
+			return &desc.TorrentSearch{
+				Title:        item.Title,
+				Href:         item.Href,
+				Size:         item.SizePretty,
+				Seeds:        int64(item.Seeds),
+				Leeches:      int64(item.Leeches),
+				Downloads:    int64(item.Downloads),
+				AddedDate:    item.AddedDate,
+				Category:     item.Category,
+				Source:       item.Source,
+				Score:        item.Score,
+				ScoreReasons: item.ScoreReasons,
+			}
+		})
+	}
+
+	if mm := state.Data.MovieMatch; mm != nil {
+		result.MovieMatch = &desc.MovieMatch{
+			MovieFile:   mm.MovieFile.FullPath,
+			Video:       movieTracks([]videocontent.MovieTrack{mm.Video})[0],
+			AudioTracks: movieTracks(mm.AudioTracks),
+			Subtitles:   movieTracks(mm.Subtitles),
+			Unallocated: movieTracks(mm.Unallocated),
+		}
+	}
+
+	if state.Step == videocontent.MovieWaitingTorrentDownloadComplete || state.Step == videocontent.MovieWaitingTorrentFiles {
+		st := state.Data.TorrentDownloadStatus
+		if st != nil {
+			result.TorrentDownloadStatus = &desc.TorrentDownloadStatus{
+				State:      movieTorrentState(st.State),
+				Progress:   float32(st.Progress),
+				IsComplete: st.IsComplete,
+			}
+		}
+	}
+
+	if state.Step == videocontent.MovieWaitingMergeVideoFiles {
+		if st := state.Data.MergeVideoStatus; st != nil {
+			result.MergeVideoStatus = &desc.MergeVideoStatus{
+				Progress:   float32(st.Progress),
+				IsComplete: st.IsComplete,
+			}
+		}
+	}
+
+	if state.Status == statemachine.CompletedStatus && state.Data.MovieCatalogInfo != nil {
+		inf := state.Data.MovieCatalogInfo
+		result.MovieCatalogInfo = &desc.MovieCatalog{
+			TorrentPath:       inf.TorrentPath,
+			TorrentSizePretty: inf.TorrentSizePretty,
+			MediaServerPath: &desc.MovieCatalogPath{
+				MoviePath: inf.MediaServerPath.MoviePath,
+				FileName:  inf.MediaServerPath.FileName,
+			},
+			MediaServerSizePretty:    inf.MediaServerSizePretty,
+			IsCopyFilesInMediaServer: inf.IsCopyFilesInMediaServer,
+		}
+		if state.Data.Torrent != nil {
+			result.Torrent = &desc.Torrent{
+				Href: state.Data.Torrent.Href,
+			}
+		}
+	}
+
+	return result
+}
+
+func movieTorrentState(state videocontent.MovieTorrentState) desc.TorrentDownloadStatus_TorrentState {
+	return torrentState(videocontent.TorrentState(state))
+}
+
+func movieDeliveryError(state *videocontent.MovieDeliveryState) *desc.MovieDeliveryError {
+	if state.Error == nil {
+		return nil
+	}
+	errorType := desc.MovieDeliveryError_MovieDeliveryError_Unknown
+	if state.Step == videocontent.MovieSearchTorrents {
+		if strings.Contains(*state.Error, "Forbidden") {
+			errorType = desc.MovieDeliveryError_MovieTorrentSiteForbidden
+		}
+	}
+	if state.Step == videocontent.MovieCreateVideoContentCatalogs {
+		if strings.Contains(*state.Error, "already exists") {
+			errorType = desc.MovieDeliveryError_MovieFilesAlreadyExist
+		}
+	}
+
+	return &desc.MovieDeliveryError{
+		RawError:  *state.Error,
+		ErrorType: errorType,
+	}
+}
+
+func MovieDeliveryState(state *videocontent.MovieDeliveryState) *desc.MovieDeliveryState {
+	return &desc.MovieDeliveryState{
+		Data:   movieDeliveryData(state),
+		Step:   movieDeliveryStep(state.Step),
+		Status: status(state.Status),
+		Error:  movieDeliveryError(state),
+	}
+}
+
+func movieDeliveryStep(step videocontent.StepMovieDelivery) desc.MovieDeliveryStep {
+	switch step {
+	case videocontent.MovieGenerateSearchQuery:
+		return desc.MovieDeliveryStep_MovieGenerateSearchQuery
+	case videocontent.MovieSearchTorrents:
+		return desc.MovieDeliveryStep_MovieSearchTorrents
+	case videocontent.MovieWaitingUserChoseTorrent:
+		return desc.MovieDeliveryStep_MovieWaitingUserChoseTorrent
+	case videocontent.MovieGetMagnetLink:
+		return desc.MovieDeliveryStep_MovieGetMagnetLink
+	case videocontent.MovieAddTorrentToTorrentClient:
+		return desc.MovieDeliveryStep_MovieAddTorrentToTorrentClient
+	case videocontent.MovieWaitingTorrentFiles:
+		return desc.MovieDeliveryStep_MovieWaitingTorrentFiles
+	case videocontent.MovieGetMovieData:
+		return desc.MovieDeliveryStep_MovieGetMovieData
+	case videocontent.MoviePrepareMovieMatch:
+		return desc.MovieDeliveryStep_MoviePrepareMovieMatch
+	case videocontent.MovieWaitingTorrentDownloadComplete:
+		return desc.MovieDeliveryStep_MovieWaitingTorrentDownloadComplete
+	case videocontent.MovieCreateVideoContentCatalogs:
+		return desc.MovieDeliveryStep_MovieCreateVideoContentCatalogs
+	case videocontent.MovieDeterminingNeedConvertFiles:
+		return desc.MovieDeliveryStep_MovieDeterminingNeedConvertFiles
+	case videocontent.MovieStartMergeVideoFiles:
+		return desc.MovieDeliveryStep_MovieStartMergeVideoFiles
+	case videocontent.MovieWaitingMergeVideoFiles:
+		return desc.MovieDeliveryStep_MovieWaitingMergeVideoFiles
+	case videocontent.MovieCreateHardLinkCopy:
+		return desc.MovieDeliveryStep_MovieCreateHardLinkCopy
+	case videocontent.MovieGetCatalogsSize:
+		return desc.MovieDeliveryStep_MovieGetCatalogsSize
+	case videocontent.MovieSetMediaMetaData:
+		return desc.MovieDeliveryStep_MovieSetMediaMetaData
+	case videocontent.MovieAddLabel:
+		return desc.MovieDeliveryStep_MovieAddLabel
+	default:
+		return desc.MovieDeliveryStep_MovieDeliveryStepUnknown
+	}
+}
+
+func MovieDeleteState(state *videocontent.MovieDeleteState) *desc.MovieDeleteState {
+	return &desc.MovieDeleteState{
+		Status: status(state.Status),
+		Error:  movieDeleteError(state),
+	}
+}
+
+func movieDeleteError(state *videocontent.MovieDeleteState) *desc.MovieDeleteError {
+	if state.Error == nil {
+		return nil
+	}
+	return &desc.MovieDeleteError{
+		RawError:  *state.Error,
+		ErrorType: desc.MovieDeleteError_MovieDeleteError_Unknown,
+	}
+}
+
+func QualityProfile(in videocontent.QualityProfile) *desc.QualityProfile {
+	return &desc.QualityProfile{
+		Id:                in.ID.String(),
+		Name:              in.Name,
+		CreatedAt:         timestamppb.New(in.CreatedAt),
+		UpdatedAt:         timestamppb.New(in.UpdatedAt),
+		MinResolution:     int32(in.MinResolution),
+		MaxResolution:     int32(in.MaxResolution),
+		PreferredCodecs:   in.PreferredCodecs,
+		ForbiddenCodecs:   in.ForbiddenCodecs,
+		RequiredAudio:     in.RequiredAudio,
+		MaxSizePerEpisode: in.MaxSizePerEpisode,
+	}
+}
+
+func QualityProfiles(items []videocontent.QualityProfile) []*desc.QualityProfile {
+	return lo.Map(items, func(it videocontent.QualityProfile, _ int) *desc.QualityProfile {
+		return QualityProfile(it)
+	})
+}
@@ -15,6 +15,7 @@ func (h *Handler) CreateVideoContent(ctx context.Context, request *desc.CreateVi
 
 	result, err := h.videoContent.CreateVideoContent(ctx, videocontent.CreateVideoContentParams{
 		ContentID: contentID,
+		Version:   request.Version,
 	})
 
 	if err != nil {
@@ -39,12 +40,39 @@ func (h *Handler) GetVideoContent(ctx context.Context, request *desc.GetVideoCon
 	}, nil
 }
 
+func (h *Handler) GetSeasonCheck(ctx context.Context, request *desc.GetSeasonCheckRequest) (*desc.GetSeasonCheckResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	check, err := h.videoContent.GetSeasonCheck(ctx, contentID)
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.GetSeasonCheck")
+	}
+
+	return &desc.GetSeasonCheckResponse{
+		Result: mapto.SeasonCheck(check),
+	}, nil
+}
+
 func (h *Handler) CreateDeliveryState(ctx context.Context, request *desc.CreateDeliveryStateRequest) (*desc.CreateDeliveryStateResponse, error) {
 	contentID := mapfrom.ContentID(request.ContentId)
 
-	state, err := h.videoContent.CreateDeliveryState(ctx, videocontent.DeliveryVideoContentParams{
+	params := videocontent.DeliveryVideoContentParams{
 		ContentID: contentID,
-	})
+		Restore:   request.Restore,
+	}
+	if request.QualityProfileId != nil {
+		profileID, err := mapfrom.QualityProfileID(*request.QualityProfileId)
+		if err != nil {
+			return nil, handler.HandleError(err, "mapfrom.QualityProfileID")
+		}
+		params.QualityProfileID = &profileID
+	}
+	if request.PackSource != nil {
+		packSource := mapfrom.ContentID(request.PackSource)
+		params.PackSource = &packSource
+	}
+
+	state, err := h.videoContent.CreateDeliveryState(ctx, params)
 
 	if err != nil {
 		return nil, handler.HandleError(err, "videoContent.CreateDeliveryState")
@@ -100,6 +128,140 @@ func (h *Handler) ChoseFileMatchesOptions(ctx context.Context, request *desc.Cho
 	}, nil
 }
 
+func (h *Handler) CreateMovieDeliveryState(ctx context.Context, request *desc.CreateMovieDeliveryStateRequest) (*desc.CreateMovieDeliveryStateResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.CreateMovieDeliveryState(ctx, videocontent.DeliveryVideoContentParams{
+		ContentID: contentID,
+	})
+
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.CreateMovieDeliveryState")
+	}
+
+	return &desc.CreateMovieDeliveryStateResponse{
+		Result: mapto.MovieDeliveryState(state),
+	}, nil
+}
+
+func (h *Handler) GetMovieDeliveryData(ctx context.Context, request *desc.GetMovieDeliveryDataRequest) (*desc.GetMovieDeliveryDataResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.GetMovieDeliveryData(ctx, contentID)
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.GetMovieDeliveryData")
+	}
+
+	return &desc.GetMovieDeliveryDataResponse{
+		Result: mapto.MovieDeliveryState(state),
+	}, nil
+}
+
+func (h *Handler) ChoseMovieTorrentOptions(ctx context.Context, request *desc.ChoseMovieTorrentOptionsRequest) (*desc.ChoseMovieTorrentOptionsResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.ChoseMovieTorrentOptions(ctx, contentID, videocontent.ChoseMovieTorrentOptions{
+		Href:           request.Href,
+		NewSearchQuery: request.NewSearchQuery,
+	})
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.ChoseMovieTorrentOptions")
+	}
+
+	return &desc.ChoseMovieTorrentOptionsResponse{
+		Result: mapto.MovieDeliveryState(state),
+	}, nil
+}
+
+func (h *Handler) RetryMovieDeliveryStep(ctx context.Context, request *desc.RetryMovieDeliveryStepRequest) (*desc.RetryMovieDeliveryStepResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.RetryMovieDeliveryStep(ctx, videocontent.RetryMovieDeliveryStepParams{
+		ContentID: contentID,
+		Href:      request.Href,
+	})
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.RetryMovieDeliveryStep")
+	}
+
+	return &desc.RetryMovieDeliveryStepResponse{
+		Result: mapto.MovieDeliveryState(state),
+	}, nil
+}
+
+func (h *Handler) RetryDeliveryStep(ctx context.Context, request *desc.RetryDeliveryStepRequest) (*desc.RetryDeliveryStepResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.RetryDeliveryStep(ctx, videocontent.RetryDeliveryStepParams{
+		ContentID:      contentID,
+		Href:           request.Href,
+		ContentMatches: mapfrom.ContentMatches(request.ContentMatches),
+	})
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.RetryDeliveryStep")
+	}
+
+	return &desc.RetryDeliveryStepResponse{
+		Result: mapto.TVShowDeliveryState(state),
+	}, nil
+}
+
+func (h *Handler) CancelDelivery(ctx context.Context, request *desc.CancelDeliveryRequest) (*desc.CancelDeliveryResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.CancelDelivery(ctx, videocontent.CancelDeliveryParams{
+		ContentID: contentID,
+	})
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.CancelDelivery")
+	}
+
+	return &desc.CancelDeliveryResponse{
+		Result: mapto.TVShowCancelState(state),
+	}, nil
+}
+
+func (h *Handler) GetCancelData(ctx context.Context, request *desc.GetCancelDataRequest) (*desc.GetCancelDataResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.GetCancelData(ctx, contentID)
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.GetCancelData")
+	}
+
+	return &desc.GetCancelDataResponse{
+		Result: mapto.TVShowCancelState(state),
+	}, nil
+}
+
+func (h *Handler) CreateUpdateState(ctx context.Context, request *desc.CreateUpdateStateRequest) (*desc.CreateUpdateStateResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.CreateUpdateState(ctx, videocontent.UpdateDeliveryParams{
+		ContentID: contentID,
+	})
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.CreateUpdateState")
+	}
+
+	return &desc.CreateUpdateStateResponse{
+		Result: mapto.TVShowUpdateState(state),
+	}, nil
+}
+
+func (h *Handler) GetUpdateData(ctx context.Context, request *desc.GetUpdateDataRequest) (*desc.GetUpdateDataResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.GetUpdateData(ctx, contentID)
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.GetUpdateData")
+	}
+
+	return &desc.GetUpdateDataResponse{
+		Result: mapto.TVShowUpdateState(state),
+	}, nil
+}
+
 func (h *Handler) CreateDeleteState(ctx context.Context, request *desc.CreateDeleteStateRequest) (*desc.CreateDeleteStateResponse, error) {
 	contentID := mapfrom.ContentID(request.ContentId)
 
@@ -128,3 +290,32 @@ func (h *Handler) GetDeleteData(ctx context.Context, request *desc.GetDeleteData
 		Result: mapto.TVShowDeleteState(state),
 	}, nil
 }
+
+func (h *Handler) CreateMovieDeleteState(ctx context.Context, request *desc.CreateMovieDeleteStateRequest) (*desc.CreateMovieDeleteStateResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.CreateMovieDeleteState(ctx, videocontent.CreateDeleteStateParams{
+		ContentID: contentID,
+	})
+
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.CreateMovieDeleteState")
+	}
+
+	return &desc.CreateMovieDeleteStateResponse{
+		Result: mapto.MovieDeleteState(state),
+	}, nil
+}
+
+func (h *Handler) GetMovieDeleteData(ctx context.Context, request *desc.GetMovieDeleteDataRequest) (*desc.GetMovieDeleteDataResponse, error) {
+	contentID := mapfrom.ContentID(request.ContentId)
+
+	state, err := h.videoContent.GetMovieDeleteData(ctx, contentID)
+	if err != nil {
+		return nil, handler.HandleError(err, "videoContent.GetMovieDeleteData")
+	}
+
+	return &desc.GetMovieDeleteDataResponse{
+		Result: mapto.MovieDeleteState(state),
+	}, nil
+}
@@ -0,0 +1,83 @@
+package videocontent
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/kkiling/media-delivery/internal/server/handler"
+	"github.com/kkiling/media-delivery/internal/server/handler/videocontent/mapfrom"
+	"github.com/kkiling/media-delivery/internal/server/handler/videocontent/mapto"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent"
+	desc "github.com/kkiling/media-delivery/pkg/gen/media-delivery"
+)
+
+func (h *Handler) CreateQualityProfile(ctx context.Context, request *desc.CreateQualityProfileRequest) (*desc.CreateQualityProfileResponse, error) {
+	result, err := h.qualityProfiles.CreateQualityProfile(ctx, mapfrom.QualityProfileParams(request.Params))
+	if err != nil {
+		return nil, handler.HandleError(err, "qualityProfiles.CreateQualityProfile")
+	}
+
+	return &desc.CreateQualityProfileResponse{
+		Result: mapto.QualityProfile(*result),
+	}, nil
+}
+
+func (h *Handler) GetQualityProfiles(ctx context.Context, _ *desc.GetQualityProfilesRequest) (*desc.GetQualityProfilesResponse, error) {
+	items, err := h.qualityProfiles.GetQualityProfiles(ctx)
+	if err != nil {
+		return nil, handler.HandleError(err, "qualityProfiles.GetQualityProfiles")
+	}
+
+	return &desc.GetQualityProfilesResponse{
+		Items: mapto.QualityProfiles(items),
+	}, nil
+}
+
+func (h *Handler) GetQualityProfile(ctx context.Context, request *desc.GetQualityProfileRequest) (*desc.GetQualityProfileResponse, error) {
+	id, err := mapfrom.QualityProfileID(request.Id)
+	if err != nil {
+		return nil, handler.HandleError(err, "mapfrom.QualityProfileID")
+	}
+
+	result, err := h.qualityProfiles.GetQualityProfile(ctx, id)
+	if err != nil {
+		return nil, handler.HandleError(err, "qualityProfiles.GetQualityProfile")
+	}
+
+	return &desc.GetQualityProfileResponse{
+		Result: mapto.QualityProfile(*result),
+	}, nil
+}
+
+func (h *Handler) UpdateQualityProfile(ctx context.Context, request *desc.UpdateQualityProfileRequest) (*desc.UpdateQualityProfileResponse, error) {
+	id, err := mapfrom.QualityProfileID(request.Id)
+	if err != nil {
+		return nil, handler.HandleError(err, "mapfrom.QualityProfileID")
+	}
+
+	result, err := h.qualityProfiles.UpdateQualityProfile(ctx, videocontent.UpdateQualityProfileParams{
+		ID:                         id,
+		CreateQualityProfileParams: mapfrom.QualityProfileParams(request.Params),
+	})
+	if err != nil {
+		return nil, handler.HandleError(err, "qualityProfiles.UpdateQualityProfile")
+	}
+
+	return &desc.UpdateQualityProfileResponse{
+		Result: mapto.QualityProfile(*result),
+	}, nil
+}
+
+func (h *Handler) DeleteQualityProfile(ctx context.Context, request *desc.DeleteQualityProfileRequest) (*emptypb.Empty, error) {
+	id, err := mapfrom.QualityProfileID(request.Id)
+	if err != nil {
+		return nil, handler.HandleError(err, "mapfrom.QualityProfileID")
+	}
+
+	if err = h.qualityProfiles.DeleteQualityProfile(ctx, id); err != nil {
+		return nil, handler.HandleError(err, "qualityProfiles.DeleteQualityProfile")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/kkiling/goplatform/log"
 	"github.com/kkiling/goplatform/server"
 	"google.golang.org/grpc"
@@ -16,27 +17,51 @@ import (
 type VideoContent interface {
 	CreateVideoContent(ctx context.Context, params videocontent.CreateVideoContentParams) (*videocontent.VideoContent, error)
 	GetVideoContent(ctx context.Context, contentID videocontent.ContentID) ([]videocontent.VideoContent, error)
+	GetSeasonCheck(ctx context.Context, contentID videocontent.ContentID) (*videocontent.SeasonCheck, error)
 	CreateDeliveryState(ctx context.Context, params videocontent.DeliveryVideoContentParams) (*videocontent.TVShowDeliveryState, error)
 	GetDeliveryData(ctx context.Context, contentID videocontent.ContentID) (*videocontent.TVShowDeliveryState, error)
 	ChoseTorrentOptions(ctx context.Context, contentID videocontent.ContentID, opts videocontent.ChoseTorrentOptions) (*videocontent.TVShowDeliveryState, error)
 	ChoseFileMatchesOptions(ctx context.Context, contentID videocontent.ContentID, opts videocontent.ChoseFileMatchesOptions) (*videocontent.TVShowDeliveryState, error)
+	RetryDeliveryStep(ctx context.Context, params videocontent.RetryDeliveryStepParams) (*videocontent.TVShowDeliveryState, error)
+	CancelDelivery(ctx context.Context, params videocontent.CancelDeliveryParams) (*videocontent.TVShowCancelState, error)
+	GetCancelData(ctx context.Context, contentID videocontent.ContentID) (*videocontent.TVShowCancelState, error)
+	CreateUpdateState(ctx context.Context, params videocontent.UpdateDeliveryParams) (*videocontent.TVShowUpdateState, error)
+	GetUpdateData(ctx context.Context, contentID videocontent.ContentID) (*videocontent.TVShowUpdateState, error)
+	CreateMovieDeliveryState(ctx context.Context, params videocontent.DeliveryVideoContentParams) (*videocontent.MovieDeliveryState, error)
+	GetMovieDeliveryData(ctx context.Context, contentID videocontent.ContentID) (*videocontent.MovieDeliveryState, error)
+	ChoseMovieTorrentOptions(ctx context.Context, contentID videocontent.ContentID, opts videocontent.ChoseMovieTorrentOptions) (*videocontent.MovieDeliveryState, error)
+	RetryMovieDeliveryStep(ctx context.Context, params videocontent.RetryMovieDeliveryStepParams) (*videocontent.MovieDeliveryState, error)
 	CreateDeleteState(ctx context.Context, params videocontent.CreateDeleteStateParams) (*videocontent.TVShowDeleteState, error)
 	GetDeleteData(ctx context.Context, contentID videocontent.ContentID) (*videocontent.TVShowDeleteState, error)
+	CreateMovieDeleteState(ctx context.Context, params videocontent.CreateDeleteStateParams) (*videocontent.MovieDeleteState, error)
+	GetMovieDeleteData(ctx context.Context, contentID videocontent.ContentID) (*videocontent.MovieDeleteState, error)
+}
+
+// QualityProfiles юзеркейс работы с профилями качества
+type QualityProfiles interface {
+	CreateQualityProfile(ctx context.Context, params videocontent.CreateQualityProfileParams) (*videocontent.QualityProfile, error)
+	UpdateQualityProfile(ctx context.Context, params videocontent.UpdateQualityProfileParams) (*videocontent.QualityProfile, error)
+	GetQualityProfile(ctx context.Context, id uuid.UUID) (*videocontent.QualityProfile, error)
+	GetQualityProfiles(ctx context.Context) ([]videocontent.QualityProfile, error)
+	DeleteQualityProfile(ctx context.Context, id uuid.UUID) error
 }
 
 type Handler struct {
 	desc.VideoContentServiceServer
-	logger       log.Logger
-	videoContent VideoContent
+	logger          log.Logger
+	videoContent    VideoContent
+	qualityProfiles QualityProfiles
 }
 
 // NewHandler новый хендлер
 func NewHandler(logger log.Logger,
 	videoContent VideoContent,
+	qualityProfiles QualityProfiles,
 ) *Handler {
 	return &Handler{
-		logger:       logger.Named("video_content"),
-		videoContent: videoContent,
+		logger:          logger.Named("video_content"),
+		videoContent:    videoContent,
+		qualityProfiles: qualityProfiles,
 	}
 }
 
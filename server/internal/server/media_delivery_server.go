@@ -6,6 +6,7 @@ import (
 	"github.com/kkiling/goplatform/log"
 	"github.com/kkiling/goplatform/server"
 
+	"github.com/kkiling/media-delivery/internal/server/handler/movielibrary"
 	"github.com/kkiling/media-delivery/internal/server/handler/tvshowlibrary"
 	"github.com/kkiling/media-delivery/internal/server/handler/videocontent"
 )
@@ -20,14 +21,17 @@ func NewMediaDeliveryServer(
 	logger log.Logger,
 	cfg server.Config,
 	tvShowLibrary tvshowlibrary.TVShowLibrary,
+	movieLibrary movielibrary.MovieLibrary,
 	videoContent videocontent.VideoContent,
+	qualityProfiles videocontent.QualityProfiles,
 ) *MediaDeliveryServer {
 	return &MediaDeliveryServer{
 		CustomServer: NewCustomServer(
 			logger,
 			cfg,
 			tvshowlibrary.NewHandler(logger, tvShowLibrary),
-			videocontent.NewHandler(logger, videoContent),
+			movielibrary.NewHandler(logger, movieLibrary),
+			videocontent.NewHandler(logger, videoContent, qualityProfiles),
 		),
 	}
 }
@@ -0,0 +1,18 @@
+package movielibrary
+
+import (
+	"context"
+
+	"github.com/kkiling/media-delivery/internal/adapter/themoviedb"
+)
+
+type TheMovieDb interface {
+	SearchMovie(ctx context.Context, params themoviedb.SearchQuery) (*themoviedb.MovieSearchResponse, error)
+	GetMovie(ctx context.Context, movieID uint64, language themoviedb.Language) (*themoviedb.Movie, error)
+}
+
+type Storage interface {
+	SaveMovie(ctx context.Context, movie *Movie) error
+	GetMovie(ctx context.Context, movieID uint64) (*Movie, error)
+	GetMovies(ctx context.Context) ([]MovieShort, error)
+}
@@ -0,0 +1,53 @@
+package movielibrary
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/adapter/themoviedb"
+)
+
+func mapImage(image *themoviedb.Image) *Image {
+	if image == nil {
+		return nil
+	}
+	return &Image{
+		ID:       image.ID,
+		W92:      image.W92,
+		W185:     image.W185,
+		W342:     image.W342,
+		Original: image.Original,
+	}
+}
+
+func mapMovieShort(item themoviedb.MovieShort) *MovieShort {
+	return &MovieShort{
+		ID:            item.ID,
+		Title:         item.Title,
+		OriginalTitle: item.OriginalTitle,
+		Overview:      item.Overview,
+		Poster:        mapImage(item.Poster),
+		ReleaseDate:   item.ReleaseDate,
+		VoteAverage:   item.VoteAverage,
+		VoteCount:     item.VoteCount,
+		Popularity:    item.Popularity,
+	}
+}
+
+func mapMovieShorts(items []themoviedb.MovieShort) []MovieShort {
+	return lo.Map(items, func(item themoviedb.MovieShort, index int) MovieShort {
+		return *mapMovieShort(item)
+	})
+}
+
+func mapMovie(response *themoviedb.Movie) *Movie {
+	return &Movie{
+		MovieShort:    *mapMovieShort(response.MovieShort),
+		Backdrop:      mapImage(response.Backdrop),
+		Genres:        response.Genres,
+		ImdbID:        response.ImdbID,
+		OriginCountry: response.OriginCountry,
+		Runtime:       response.Runtime,
+		Status:        response.Status,
+		Tagline:       response.Tagline,
+	}
+}
@@ -0,0 +1,57 @@
+package movielibrary
+
+import "time"
+
+type Image struct {
+	// ID изображения
+	ID string
+	// Урезанная версия изображения ширина 92px
+	W92 *string
+	// Урезанная версия изображения ширина 185px
+	W185 *string
+	// Урезанная версия изображения ширина 342px
+	W342 *string
+	// Оригинальный путь до изображения
+	Original string
+}
+
+// MovieShort базовая информация о фильме (отображение при поиске фильма например)
+type MovieShort struct {
+	// TheMovieDb Movie ID
+	ID uint64
+	// Наименование фильма (зависит от языка выбранного при запросе к api)
+	Title string
+	// Наименование фильма на оригинальном языке
+	OriginalTitle string
+	// Описание фильма (зависит от языка выбранного при запросе к api)
+	Overview string
+	// Изображение постера фильма
+	Poster *Image
+	// Дата выхода фильма (например 2019-07-08)
+	ReleaseDate time.Time
+	// Средняя оценка от 0.0 до 10.0
+	VoteAverage float64
+	// Количество оценок
+	VoteCount int
+	// Популярность фильма
+	Popularity float64
+}
+
+// Movie расширенная информация о фильме (отображении в карточке фильма)
+type Movie struct {
+	MovieShort
+	// Фоновое изображение
+	Backdrop *Image
+	// Список жанров (зависит от языка выбранного при запросе к api)
+	Genres []string
+	// IMDb ID фильма
+	ImdbID string
+	// Список стран участвующих в производстве (jp, ru и тд)
+	OriginCountry []string
+	// Продолжительность фильма (минуты)
+	Runtime int
+	// Статус (Released, In Production и тд)
+	Status string
+	// Слоган фильма (зависит от языка выбранного при запросе к api)
+	Tagline string
+}
@@ -0,0 +1,28 @@
+package movielibrary
+
+type MovieSearchParams struct {
+	Query string
+}
+
+type MovieSearchResult struct {
+	Items []MovieShort
+}
+
+type GetMovieParams struct {
+	MovieID uint64
+}
+
+type GetMovieResult struct {
+	Result *Movie
+}
+
+type GetMoviesFromLibraryParams struct {
+}
+
+type GetMoviesFromLibraryResult struct {
+	Items []MovieShort
+}
+
+type AddMovieInLibraryParams struct {
+	MovieID uint64
+}
@@ -0,0 +1,103 @@
+package movielibrary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kkiling/goplatform/storagebase"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/themoviedb"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+)
+
+const (
+	language       = themoviedb.LanguageRU
+	perPageDefault = 20
+)
+
+type Service struct {
+	theMovieDb TheMovieDb
+	storage    Storage
+}
+
+func NewService(
+	storage Storage,
+	theMovieDb TheMovieDb,
+) *Service {
+	return &Service{
+		theMovieDb: theMovieDb,
+		storage:    storage,
+	}
+}
+
+// SearchMovie поиск фильмов по названию
+func (s *Service) SearchMovie(ctx context.Context, params MovieSearchParams) (*MovieSearchResult, error) {
+	response, err := s.theMovieDb.SearchMovie(ctx, themoviedb.SearchQuery{
+		Language: language,
+		Query:    params.Query,
+		Page:     1,
+		PerPage:  perPageDefault,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("theMovieDb.SearchMovie: %w", err)
+	}
+
+	return &MovieSearchResult{
+		Items: mapMovieShorts(response.Results),
+	}, nil
+}
+
+// GetMovieInfo получение подробной информации о фильме
+func (s *Service) GetMovieInfo(ctx context.Context, params GetMovieParams) (*GetMovieResult, error) {
+	response, err := s.theMovieDb.GetMovie(ctx, params.MovieID, language)
+	if err != nil {
+		if errors.Is(err, apierr.ContentNotFound) {
+			return nil, ucerr.NotFound
+		}
+		return nil, fmt.Errorf("theMovieDb.GetMovie: %w", err)
+	}
+
+	return &GetMovieResult{
+		Result: mapMovie(response),
+	}, nil
+}
+
+// GetMoviesFromLibrary получение списка фильмов из библиотеки
+func (s *Service) GetMoviesFromLibrary(ctx context.Context, _ GetMoviesFromLibraryParams) (*GetMoviesFromLibraryResult, error) {
+	movies, err := s.storage.GetMovies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetMovies: %w", err)
+	}
+	return &GetMoviesFromLibraryResult{
+		Items: movies,
+	}, nil
+}
+
+// AddMovieInLibrary добавление фильма в библиотеку
+func (s *Service) AddMovieInLibrary(ctx context.Context, params AddMovieInLibraryParams) error {
+	// Фильм уже может быть в библиотеке (например после удаления файлов)
+	_, err := s.storage.GetMovie(ctx, params.MovieID)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, storagebase.ErrNotFound):
+	default:
+		return fmt.Errorf("storage.GetMovie: %w", err)
+	}
+
+	response, err := s.theMovieDb.GetMovie(ctx, params.MovieID, language)
+	if err != nil {
+		if errors.Is(err, apierr.ContentNotFound) {
+			return ucerr.NotFound
+		}
+		return fmt.Errorf("theMovieDb.GetMovie: %w", err)
+	}
+
+	if err = s.storage.SaveMovie(ctx, mapMovie(response)); err != nil {
+		return fmt.Errorf("storage.SaveMovie: %w", err)
+	}
+
+	return nil
+}
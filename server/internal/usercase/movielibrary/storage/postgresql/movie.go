@@ -0,0 +1,169 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary/storage/db"
+)
+
+func (s *Storage) saveImage(ctx context.Context, img *movielibrary.Image) error {
+	queries := s.getQueries(ctx)
+
+	err := queries.SaveImage(ctx, db.SaveImageParams{
+		ID:       img.ID,
+		W92:      img.W92,
+		W185:     img.W185,
+		W342:     img.W342,
+		Original: img.Original,
+	})
+
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) SaveMovie(ctx context.Context, movie *movielibrary.Movie) error {
+	return s.RunTransaction(ctx, func(tCtx context.Context) error {
+		queries := s.getQueries(tCtx)
+
+		var posterID *string
+		var backdropID *string
+
+		if movie.Poster != nil {
+			posterID = &movie.Poster.ID
+			if err := s.saveImage(tCtx, movie.Poster); err != nil {
+				return fmt.Errorf("saveImage: %w", err)
+			}
+		}
+
+		if movie.Backdrop != nil {
+			backdropID = &movie.Backdrop.ID
+			if err := s.saveImage(tCtx, movie.Backdrop); err != nil {
+				return fmt.Errorf("saveImage: %w", err)
+			}
+		}
+
+		err := queries.SaveMovie(tCtx, db.SaveMovieParams{
+			ID:            int64(movie.ID),
+			Title:         movie.Title,
+			OriginalTitle: movie.OriginalTitle,
+			Overview:      movie.Overview,
+			PosterID:      posterID,
+			ReleaseDate:   movie.ReleaseDate,
+			VoteAverage:   movie.VoteAverage,
+			VoteCount:     movie.VoteCount,
+			Popularity:    movie.Popularity,
+			BackdropID:    backdropID,
+			Genres:        movie.Genres,
+			ImdbID:        movie.ImdbID,
+			OriginCountry: movie.OriginCountry,
+			Runtime:       movie.Runtime,
+			Status:        movie.Status,
+			Tagline:       movie.Tagline,
+		})
+		if err != nil {
+			return s.base.HandleError(err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Storage) getImage(ctx context.Context, imageID string) (*movielibrary.Image, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetImage(ctx, imageID)
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	return &movielibrary.Image{
+		ID:       res.ID,
+		W92:      res.W92,
+		W185:     res.W185,
+		W342:     res.W342,
+		Original: res.Original,
+	}, nil
+}
+
+func (s *Storage) GetMovie(ctx context.Context, movieID uint64) (*movielibrary.Movie, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetMovie(ctx, int64(movieID))
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	var poster, backdrop *movielibrary.Image
+	if res.PosterID != nil {
+		poster, err = s.getImage(ctx, *res.PosterID)
+		if err != nil {
+			return nil, fmt.Errorf("getImage: %w", err)
+		}
+	}
+	if res.BackdropID != nil {
+		backdrop, err = s.getImage(ctx, *res.BackdropID)
+		if err != nil {
+			return nil, fmt.Errorf("getImage: %w", err)
+		}
+	}
+
+	return &movielibrary.Movie{
+		MovieShort: movielibrary.MovieShort{
+			ID:            uint64(res.ID),
+			Title:         res.Title,
+			OriginalTitle: res.OriginalTitle,
+			Overview:      res.Overview,
+			Poster:        poster,
+			ReleaseDate:   res.ReleaseDate,
+			VoteAverage:   res.VoteAverage,
+			VoteCount:     res.VoteCount,
+			Popularity:    res.Popularity,
+		},
+		Backdrop:      backdrop,
+		Genres:        res.Genres,
+		ImdbID:        res.ImdbID,
+		OriginCountry: res.OriginCountry,
+		Runtime:       res.Runtime,
+		Status:        res.Status,
+		Tagline:       res.Tagline,
+	}, nil
+}
+
+func (s *Storage) GetMovies(ctx context.Context) ([]movielibrary.MovieShort, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetMovies(ctx)
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	results := make([]movielibrary.MovieShort, 0, len(res))
+	for _, item := range res {
+		var poster *movielibrary.Image
+		if item.PosterID != nil {
+			poster, err = s.getImage(ctx, *item.PosterID)
+			if err != nil {
+				return nil, fmt.Errorf("getImage: %w", err)
+			}
+		}
+
+		results = append(results, movielibrary.MovieShort{
+			ID:            uint64(item.ID),
+			Title:         item.Title,
+			OriginalTitle: item.OriginalTitle,
+			Overview:      item.Overview,
+			Poster:        poster,
+			ReleaseDate:   item.ReleaseDate,
+			VoteAverage:   item.VoteAverage,
+			VoteCount:     item.VoteCount,
+			Popularity:    item.Popularity,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,235 @@
+package postgresql
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/kkiling/goplatform/storagebase/testutils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+)
+
+func randID() uint64 {
+	return uint64(rand.Uint32())
+}
+
+func equalImg(t *testing.T, img1, img2 *movielibrary.Image) {
+	require.True(t, (img1 == nil && img2 == nil) || (img1 != nil && img2 != nil))
+	if img1 == nil || img2 == nil {
+		return
+	}
+	require.Equal(t, img1.ID, img2.ID)
+	require.Equal(t, img1.W92, img2.W92)
+	require.Equal(t, img1.W185, img2.W185)
+	require.Equal(t, img1.W342, img2.W342)
+	require.Equal(t, img1.Original, img2.Original)
+}
+
+func equalMovieShort(t *testing.T, m1, m2 *movielibrary.MovieShort) {
+	require.Equal(t, m1.ID, m2.ID)
+	require.Equal(t, m1.Title, m2.Title)
+	require.Equal(t, m1.OriginalTitle, m2.OriginalTitle)
+	require.Equal(t, m1.Overview, m2.Overview)
+	equalImg(t, m1.Poster, m2.Poster)
+	require.WithinDuration(t, m1.ReleaseDate, m2.ReleaseDate, time.Second)
+	require.Equal(t, m1.VoteAverage, m2.VoteAverage)
+	require.Equal(t, m1.VoteCount, m2.VoteCount)
+	require.Equal(t, m1.Popularity, m2.Popularity)
+}
+
+func equalMovie(t *testing.T, m1, m2 *movielibrary.Movie) {
+	require.True(t, (m1 == nil && m2 == nil) || (m1 != nil && m2 != nil))
+	if m1 == nil || m2 == nil {
+		return
+	}
+
+	equalMovieShort(t, &m1.MovieShort, &m2.MovieShort)
+
+	equalImg(t, m1.Backdrop, m2.Backdrop)
+	require.Equal(t, m1.Genres, m2.Genres)
+	require.Equal(t, m1.ImdbID, m2.ImdbID)
+	require.Equal(t, m1.OriginCountry, m2.OriginCountry)
+	require.Equal(t, m1.Runtime, m2.Runtime)
+	require.Equal(t, m1.Status, m2.Status)
+	require.Equal(t, m1.Tagline, m2.Tagline)
+}
+
+func TestStorage_SaveMovie(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	t.Run("save movie - successfully with images", func(t *testing.T) {
+		t.Parallel()
+
+		movie := &movielibrary.Movie{
+			MovieShort: movielibrary.MovieShort{
+				ID:            randID(),
+				Title:         "Test Movie",
+				OriginalTitle: "Test Movie Original",
+				Overview:      "Test overview",
+				Poster: &movielibrary.Image{
+					ID:       uuid.NewString(),
+					W92:      lo.ToPtr("poster/w92"),
+					W185:     lo.ToPtr("poster/w185"),
+					W342:     lo.ToPtr("poster/w342"),
+					Original: "poster/original",
+				},
+				ReleaseDate: time.Now(),
+				VoteAverage: 7.8,
+				VoteCount:   1200,
+				Popularity:  64.5,
+			},
+			Backdrop: &movielibrary.Image{
+				ID:       uuid.NewString(),
+				W92:      lo.ToPtr("backdrop/w92"),
+				W185:     lo.ToPtr("backdrop/w185"),
+				W342:     lo.ToPtr("backdrop/w342"),
+				Original: "backdrop/original",
+			},
+			Genres:        []string{"Drama", "Thriller"},
+			ImdbID:        "tt0000001",
+			OriginCountry: []string{"US"},
+			Runtime:       128,
+			Status:        "Released",
+			Tagline:       "Great movie",
+		}
+
+		err := testStorage.SaveMovie(ctx, movie)
+		require.NoError(t, err)
+
+		savedMovie, err := testStorage.GetMovie(ctx, movie.ID)
+		require.NoError(t, err)
+
+		equalMovie(t, movie, savedMovie)
+	})
+
+	t.Run("save movie - successfully without optional fields", func(t *testing.T) {
+		t.Parallel()
+
+		movie := &movielibrary.Movie{
+			MovieShort: movielibrary.MovieShort{
+				ID:            randID(),
+				Title:         "Minimal Movie",
+				OriginalTitle: "Minimal Movie Original",
+				Overview:      "Minimal overview",
+				ReleaseDate:   time.Now(),
+				VoteAverage:   5.0,
+				VoteCount:     10,
+				Popularity:    1.5,
+			},
+			Genres:        []string{},
+			OriginCountry: []string{},
+		}
+
+		err := testStorage.SaveMovie(ctx, movie)
+		require.NoError(t, err)
+
+		savedMovie, err := testStorage.GetMovie(ctx, movie.ID)
+		require.NoError(t, err)
+
+		equalMovie(t, movie, savedMovie)
+	})
+
+	t.Run("save movie - already exists", func(t *testing.T) {
+		t.Parallel()
+
+		movie := &movielibrary.Movie{
+			MovieShort: movielibrary.MovieShort{
+				ID:            randID(),
+				Title:         "Duplicate Movie",
+				OriginalTitle: "Duplicate Movie Original",
+				Overview:      "Duplicate overview",
+				ReleaseDate:   time.Now(),
+			},
+		}
+
+		err := testStorage.SaveMovie(ctx, movie)
+		require.NoError(t, err)
+
+		err = testStorage.SaveMovie(ctx, movie)
+		require.Error(t, err)
+		require.ErrorIs(t, storagebase.ErrAlreadyExists, err)
+	})
+}
+
+func TestStorage_GetMovies(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	t.Run("save multiple movies - check GetMovies", func(t *testing.T) {
+		t.Parallel()
+
+		movies := []*movielibrary.Movie{
+			{
+				MovieShort: movielibrary.MovieShort{
+					ID:            randID(),
+					Title:         "Movie A",
+					OriginalTitle: "Movie A Original",
+					Overview:      "Overview A",
+					ReleaseDate:   time.Now(),
+					VoteAverage:   8.0,
+					VoteCount:     100,
+					Popularity:    40.0,
+				},
+			},
+			{
+				MovieShort: movielibrary.MovieShort{
+					ID:            randID(),
+					Title:         "Movie B",
+					OriginalTitle: "Movie B Original",
+					Overview:      "Overview B",
+					ReleaseDate:   time.Now(),
+					VoteAverage:   7.5,
+					VoteCount:     80,
+					Popularity:    35.0,
+				},
+			},
+		}
+
+		for _, movie := range movies {
+			err := testStorage.SaveMovie(ctx, movie)
+			require.NoError(t, err)
+		}
+
+		savedMovies, err := testStorage.GetMovies(ctx)
+		require.NoError(t, err)
+
+		// Находим наши фильмы среди всех сохраненных
+		foundCount := 0
+		for _, saved := range savedMovies {
+			for _, original := range movies {
+				if saved.ID == original.ID {
+					foundCount++
+					equalMovieShort(t, &saved, &original.MovieShort)
+				}
+			}
+		}
+		require.Equal(t, 2, foundCount)
+	})
+}
+
+func TestStorage_GetMovie(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	t.Run("get movie - not found", func(t *testing.T) {
+		t.Parallel()
+
+		nonExistentID := uint64(999999)
+		_, err := testStorage.GetMovie(ctx, nonExistentID)
+		require.Error(t, err)
+		require.ErrorIs(t, storagebase.ErrNotFound, err)
+	})
+}
@@ -17,6 +17,8 @@ type Storage interface {
 	SaveTVShow(ctx context.Context, tvShow *TVShow) error
 	GetTVShows(ctx context.Context) ([]TVShowShort, error)
 	SaveEpisodes(ctx context.Context, tvID uint64, seasonNumber uint8, episodes []Episode) error
+	UpsertEpisodes(ctx context.Context, tvID uint64, seasonNumber uint8, episodes []Episode) error
+	GetEpisodes(ctx context.Context, tvShowID uint64, seasonNumber uint8) ([]Episode, error)
 }
 
 // Clock интерфейс для работы со временем (реальный или мок)
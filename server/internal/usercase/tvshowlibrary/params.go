@@ -29,6 +29,20 @@ type GetSeasonInfoResult struct {
 	Result *SeasonWithEpisodes
 }
 
+type GetSeasonEpisodesParams struct {
+	TVShowID     uint64
+	SeasonNumber uint8
+}
+
+type GetSeasonEpisodesResult struct {
+	Items []Episode
+}
+
+type RefreshSeasonEpisodesParams struct {
+	TVShowID     uint64
+	SeasonNumber uint8
+}
+
 type GetTVShowsFromLibraryParams struct {
 }
 
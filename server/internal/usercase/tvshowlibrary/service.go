@@ -83,6 +83,39 @@ func (s *Service) GetSeasonInfo(ctx context.Context, params GetSeasonInfoParams)
 	}, nil
 }
 
+// GetSeasonEpisodes получение серий сезона, сохраненных в библиотеке при добавлении сериала
+func (s *Service) GetSeasonEpisodes(ctx context.Context, params GetSeasonEpisodesParams) (*GetSeasonEpisodesResult, error) {
+	episodes, err := s.storage.GetEpisodes(ctx, params.TVShowID, params.SeasonNumber)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetEpisodes: %w", err)
+	}
+
+	return &GetSeasonEpisodesResult{
+		Items: episodes,
+	}, nil
+}
+
+// RefreshSeasonEpisodes обновление серий сезона в библиотеке из themoviedb
+// У онгоингов со временем появляются новые серии и даты выхода
+func (s *Service) RefreshSeasonEpisodes(ctx context.Context, params RefreshSeasonEpisodesParams) (*GetSeasonEpisodesResult, error) {
+	response, err := s.theMovieDb.GetSeason(ctx, params.TVShowID, params.SeasonNumber, language)
+	if err != nil {
+		if errors.Is(err, apierr.ContentNotFound) {
+			return nil, ucerr.NotFound
+		}
+		return nil, fmt.Errorf("theMovieDb.GetSeason: %w", err)
+	}
+
+	episodes := mapEpisodes(response.Episodes)
+	if err = s.storage.UpsertEpisodes(ctx, params.TVShowID, params.SeasonNumber, episodes); err != nil {
+		return nil, fmt.Errorf("storage.UpsertEpisodes: %w", err)
+	}
+
+	return &GetSeasonEpisodesResult{
+		Items: episodes,
+	}, nil
+}
+
 // GetTVShowsFromLibrary получение списка сериалов из библиотеки
 func (s *Service) GetTVShowsFromLibrary(ctx context.Context, _ GetTVShowsFromLibraryParams) (*GetTVShowsFromLibraryResult, error) {
 	tvShows, err := s.storage.GetTVShows(ctx)
@@ -368,3 +368,77 @@ func (q *Queries) SaveTVShow(ctx context.Context, arg SaveTVShowParams) error {
 	)
 	return err
 }
+
+const upsertEpisode = `-- name: UpsertEpisode :exec
+INSERT INTO episodes (
+    tv_show_id, season_number, air_date, episode_number,
+    episode_type, name, overview, runtime,
+    still_id, vote_average, vote_count
+) VALUES ($1, $2, $3, $4,
+          $5, $6, $7, $8, $9,
+          $10, $11)
+ON CONFLICT (tv_show_id, season_number, episode_number) DO UPDATE SET air_date=EXCLUDED.air_date,
+                                                                      episode_type=EXCLUDED.episode_type,
+                                                                      name=EXCLUDED.name,
+                                                                      overview=EXCLUDED.overview,
+                                                                      runtime=EXCLUDED.runtime,
+                                                                      still_id=EXCLUDED.still_id,
+                                                                      vote_average=EXCLUDED.vote_average,
+                                                                      vote_count=EXCLUDED.vote_count
+`
+
+type UpsertEpisodeParams struct {
+	TvShowID      int64
+	SeasonNumber  int
+	AirDate       time.Time
+	EpisodeNumber int
+	EpisodeType   *string
+	Name          string
+	Overview      string
+	Runtime       int
+	StillID       *string
+	VoteAverage   float32
+	VoteCount     int
+}
+
+func (q *Queries) UpsertEpisode(ctx context.Context, arg UpsertEpisodeParams) error {
+	_, err := q.db.Exec(ctx, upsertEpisode,
+		arg.TvShowID,
+		arg.SeasonNumber,
+		arg.AirDate,
+		arg.EpisodeNumber,
+		arg.EpisodeType,
+		arg.Name,
+		arg.Overview,
+		arg.Runtime,
+		arg.StillID,
+		arg.VoteAverage,
+		arg.VoteCount,
+	)
+	return err
+}
+
+const upsertImage = `-- name: UpsertImage :exec
+INSERT INTO images (id, w92, w185, w342, original)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO NOTHING
+`
+
+type UpsertImageParams struct {
+	ID       string
+	W92      *string
+	W185     *string
+	W342     *string
+	Original string
+}
+
+func (q *Queries) UpsertImage(ctx context.Context, arg UpsertImageParams) error {
+	_, err := q.db.Exec(ctx, upsertImage,
+		arg.ID,
+		arg.W92,
+		arg.W185,
+		arg.W342,
+		arg.Original,
+	)
+	return err
+}
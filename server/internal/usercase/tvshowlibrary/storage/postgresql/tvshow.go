@@ -146,6 +146,49 @@ func (s *Storage) SaveEpisodes(ctx context.Context, tvID uint64, seasonNumber ui
 	})
 }
 
+// UpsertEpisodes сохранение эпизодов сезона с обновлением уже сохраненных
+func (s *Storage) UpsertEpisodes(ctx context.Context, tvID uint64, seasonNumber uint8, episodes []tvshowlibrary.Episode) error {
+	return s.RunTransaction(ctx, func(tCtx context.Context) error {
+		queries := s.getQueries(tCtx)
+
+		for _, episode := range episodes {
+			var stillID *string
+			if episode.Still != nil {
+				stillID = &episode.Still.ID
+				err := queries.UpsertImage(tCtx, db.UpsertImageParams{
+					ID:       episode.Still.ID,
+					W92:      episode.Still.W92,
+					W185:     episode.Still.W185,
+					W342:     episode.Still.W342,
+					Original: episode.Still.Original,
+				})
+				if err != nil {
+					return s.base.HandleError(err)
+				}
+			}
+
+			err := queries.UpsertEpisode(tCtx, db.UpsertEpisodeParams{
+				TvShowID:      int64(tvID),
+				SeasonNumber:  int(seasonNumber),
+				AirDate:       episode.AirDate,
+				EpisodeNumber: episode.EpisodeNumber,
+				EpisodeType:   episode.EpisodeType,
+				Name:          episode.Name,
+				Overview:      episode.Overview,
+				Runtime:       int(episode.Runtime),
+				StillID:       stillID,
+				VoteAverage:   episode.VoteAverage,
+				VoteCount:     int(episode.VoteCount),
+			})
+			if err != nil {
+				return s.base.HandleError(err)
+			}
+		}
+
+		return nil
+	})
+}
+
 func (s *Storage) getImage(ctx context.Context, imageID string) (*tvshowlibrary.Image, error) {
 	queries := s.getQueries(ctx)
 
@@ -669,3 +669,56 @@ func TestStorage_SaveEpisodes(t *testing.T) {
 		require.Equal(t, 3, savedEpisodes[2].EpisodeNumber)
 	})
 }
+
+func TestStorage_UpsertEpisodes(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	t.Run("upsert episodes - update existing and add new", func(t *testing.T) {
+		t.Parallel()
+
+		tvShowID := uint64(10)
+		seasonNumber := uint8(1)
+		stillID := uuid.NewString()
+
+		episodes := []tvshowlibrary.Episode{
+			{
+				EpisodeNumber: 1,
+				Name:          "Episode 1",
+				Overview:      "First episode",
+				Runtime:       45,
+				Still: &tvshowlibrary.Image{
+					ID:       stillID,
+					Original: "still/original",
+				},
+				VoteAverage: 8.0,
+				VoteCount:   50,
+			},
+		}
+
+		err := testStorage.SaveEpisodes(ctx, tvShowID, seasonNumber, episodes)
+		require.NoError(t, err)
+
+		// Объявлена дата выхода первого эпизода и добавлен второй
+		episodes[0].AirDate = time.Now()
+		episodes[0].Name = "Pilot"
+		episodes = append(episodes, tvshowlibrary.Episode{
+			AirDate:       time.Now().AddDate(0, 0, 7),
+			EpisodeNumber: 2,
+			Name:          "Episode 2",
+			Overview:      "Second episode",
+			Runtime:       42,
+			VoteAverage:   7.5,
+			VoteCount:     40,
+		})
+
+		err = testStorage.UpsertEpisodes(ctx, tvShowID, seasonNumber, episodes)
+		require.NoError(t, err)
+
+		savedEpisodes, err := testStorage.GetEpisodes(ctx, tvShowID, seasonNumber)
+		require.NoError(t, err)
+		equalEpisodes(t, episodes, savedEpisodes)
+	})
+}
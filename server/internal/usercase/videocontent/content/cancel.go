@@ -0,0 +1,170 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowcancelstate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowcancel"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+// catalogCreatedSteps шаги доставки, на которых каталог сезона уже создан самой доставкой
+// На шаге CreateVideoContentCatalogs каталог мог существовать до доставки, его не трогаем
+var catalogCreatedSteps = []tvshowdeliverystate.StepDelivery{
+	tvshowdeliverystate.DeterminingNeedConvertFiles,
+	tvshowdeliverystate.StartMergeVideoFiles,
+	tvshowdeliverystate.WaitingMergeVideoFiles,
+	tvshowdeliverystate.CreateHardLinkCopy,
+	tvshowdeliverystate.GetCatalogsSize,
+	tvshowdeliverystate.SetMediaMetaData,
+	tvshowdeliverystate.AddLabel,
+	tvshowdeliverystate.SendDeliveryNotification,
+}
+
+// cancelData что успела сделать доставка и что нужно откатить
+func cancelData(deliveryState *tvshowdeliverystate.State) tvshowcancelstate.TVShowCancelData {
+	data := deliveryState.Data
+	result := tvshowcancelstate.TVShowCancelData{
+		DeliveryStateID: deliveryState.ID,
+		DeliveryStep:    string(deliveryState.Step),
+		MergeIDs:        data.MergeIDs,
+	}
+	if data.Torrent != nil && data.Torrent.MagnetLink != nil {
+		result.MagnetHash = lo.ToPtr(data.Torrent.MagnetLink.Hash)
+	}
+	if data.TorrentFilesData != nil {
+		result.TorrentPath = lo.ToPtr(data.TorrentFilesData.ContentFullPath)
+	}
+	if data.EpisodesData != nil && lo.Contains(catalogCreatedSteps, deliveryState.Step) {
+		result.TVShowCatalogPath = &tvshowcancel.TVShowCatalogPath{
+			TVShowPath: data.EpisodesData.TVShowCatalogPath.TVShowPath,
+			SeasonPath: data.EpisodesData.TVShowCatalogPath.SeasonPath,
+		}
+		result.EpisodeFiles = lo.Map(data.EpisodesData.Episodes, func(item tvshowdelivery.EpisodeInfo, _ int) string {
+			return item.RelativePath
+		})
+	}
+	return result
+}
+
+// CancelDelivery отмена доставки сезона сериала
+// Стейт доставки больше не добивается, а все что он успел сделать откатывается стейтом отмены
+func (s *Service) CancelDelivery(ctx context.Context, params CancelDeliveryParams) (*tvshowcancelstate.State, error) {
+	if err := params.ContentID.Validate(); err != nil {
+		return nil, err
+	}
+	// Отмена доставки фильмов не поддерживается
+	if params.ContentID.TVShow == nil {
+		return nil, fmt.Errorf("tvShow is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, params.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	switch content.DeliveryStatus {
+	case DeliveryStatusInProgress:
+	case DeliveryStatusFailed:
+	default:
+		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
+	}
+
+	stateID := getLastState(content, runners.TVShowDelivery)
+	if stateID == nil {
+		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	}
+
+	deliveryState, err := s.tvShowDeliveryState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("tvShowDeliveryState.GetStateByID: %w", err)
+	}
+	if deliveryState == nil {
+		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	}
+
+	data := cancelData(deliveryState)
+	// Раздачу-пак не удаляем, пока ее используют другие сезоны
+	if data.MagnetHash != nil {
+		keepTorrent, err := s.torrentUsedByOtherContents(ctx, content, *data.MagnetHash)
+		if err != nil {
+			return nil, fmt.Errorf("torrentUsedByOtherContents: %w", err)
+		}
+		if keepTorrent {
+			data.MagnetHash = nil
+			data.TorrentPath = nil
+		}
+	}
+
+	data.KeepLabel, err = s.otherVersionsHaveFiles(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("otherVersionsHaveFiles: %w", err)
+	}
+	// Каталог сезона общий для всех версий, пока в нем есть другие версии удаляются только файлы этой
+	data.KeepCatalog, err = s.otherVersionsUseCatalog(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("otherVersionsUseCatalog: %w", err)
+	}
+
+	options := tvshowcancelstate.CreateOptions{
+		Index:          len(content.States),
+		VideoContentID: content.ID,
+		TVShowID:       *params.ContentID.TVShow,
+		Data:           data,
+	}
+
+	var result *tvshowcancelstate.State
+	//  TODO: одна транзакция
+	{
+		result, err = s.tvShowCancelState.Create(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("tvShowCancelState.Create: %w", err)
+		}
+
+		// Контент переводится в статус отмены, стейт доставки больше не добивается
+		updateVideoContent := UpdateVideoContent{
+			DeliveryStatus: DeliveryStatusCanceling,
+			States: append(content.States, State{
+				StateID:   result.ID,
+				CreatedAt: result.CreatedAt,
+				Type:      runners.TVShowCancel,
+			}),
+		}
+
+		if err = s.storage.UpdateVideoContent(ctx, content.ID, &updateVideoContent); err != nil {
+			return nil, fmt.Errorf("storage.UpdateVideoContent: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetCancelData(ctx context.Context, contentID common.ContentID) (*tvshowcancelstate.State, error) {
+	if err := contentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.getVideoContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	stateID := getLastState(content, runners.TVShowCancel)
+	if stateID == nil {
+		return nil, fmt.Errorf("TVShowCancel: %w", ucerr.NotFound)
+	}
+
+	result, err := s.tvShowCancelState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("s.GetStateByID: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowcancel"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+func TestCancelData(t *testing.T) {
+	stateID := uuid.New()
+	mergeID := uuid.New()
+	newState := func(step tvshowdeliverystate.StepDelivery, data tvshowdeliverystate.TVShowDeliveryData) *tvshowdeliverystate.State {
+		return &tvshowdeliverystate.State{ID: stateID, Step: step, Data: data}
+	}
+	fullData := tvshowdeliverystate.TVShowDeliveryData{
+		Torrent: &tvshowdelivery.Torrent{
+			Href:       "https://rutracker.org/forum/viewtopic.php?t=1",
+			MagnetLink: &tvshowdelivery.MagnetLink{Hash: "abc"},
+		},
+		TorrentFilesData: &tvshowdelivery.TorrentFilesData{ContentFullPath: "/nfs/downloads/Dark.S02"},
+		EpisodesData: &tvshowdelivery.EpisodesData{
+			TVShowCatalogPath: tvshowdelivery.TVShowCatalogPath{
+				TVShowPath: "/nfs/tvshows/Тьма (2017)",
+				SeasonPath: "Season 2",
+			},
+			Episodes: []tvshowdelivery.EpisodeInfo{{SeasonNumber: 2, EpisodeNumber: 1, RelativePath: "S02E01 Эпизод 1 - 4K"}},
+		},
+		MergeIDs: []uuid.UUID{mergeID},
+	}
+
+	t.Run("nothing to roll back", func(t *testing.T) {
+		got := cancelData(newState(tvshowdeliverystate.WaitingUserChoseTorrent, tvshowdeliverystate.TVShowDeliveryData{}))
+		require.Equal(t, stateID, got.DeliveryStateID)
+		require.Equal(t, string(tvshowdeliverystate.WaitingUserChoseTorrent), got.DeliveryStep)
+		require.Nil(t, got.MagnetHash)
+		require.Nil(t, got.TorrentPath)
+		require.Nil(t, got.TVShowCatalogPath)
+		require.Empty(t, got.MergeIDs)
+	})
+
+	t.Run("catalog not created by delivery", func(t *testing.T) {
+		got := cancelData(newState(tvshowdeliverystate.CreateVideoContentCatalogs, fullData))
+		require.Equal(t, lo.ToPtr("abc"), got.MagnetHash)
+		require.Equal(t, lo.ToPtr("/nfs/downloads/Dark.S02"), got.TorrentPath)
+		require.Nil(t, got.TVShowCatalogPath)
+	})
+
+	t.Run("merge in progress", func(t *testing.T) {
+		got := cancelData(newState(tvshowdeliverystate.WaitingMergeVideoFiles, fullData))
+		require.Equal(t, []uuid.UUID{mergeID}, got.MergeIDs)
+		require.Equal(t, &tvshowcancel.TVShowCatalogPath{
+			TVShowPath: "/nfs/tvshows/Тьма (2017)",
+			SeasonPath: "Season 2",
+		}, got.TVShowCatalogPath)
+		require.Equal(t, []string{"S02E01 Эпизод 1 - 4K"}, got.EpisodeFiles)
+	})
+}
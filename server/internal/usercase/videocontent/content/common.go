@@ -3,7 +3,9 @@ package content
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -33,9 +35,69 @@ func (s *Service) getVideoContent(ctx context.Context, contentID common.ContentI
 		return VideoContent{}, fmt.Errorf("storage.GetVideoContent: %w", err)
 	}
 
-	if len(contents) != 1 {
+	contents = filterVideoContents(contents, contentID)
+	switch len(contents) {
+	case 0:
 		return VideoContent{}, ucerr.NotFound
+	case 1:
+		return contents[0], nil
+	default:
+		// К фильму/сезону привязано несколько видеоконтентов, нужно указать конкретный
+		return VideoContent{}, fmt.Errorf("videoContentID is required: %w", ucerr.InvalidArgument)
 	}
+}
+
+// filterVideoContents оставляет видеоконтент, указанный в contentID (если указан)
+func filterVideoContents(contents []VideoContent, contentID common.ContentID) []VideoContent {
+	if contentID.VideoContentID == nil {
+		return contents
+	}
+	return lo.Filter(contents, func(item VideoContent, _ int) bool {
+		return item.ID == *contentID.VideoContentID
+	})
+}
+
+// otherVersionsHaveFiles у фильма / сезона есть другие версии видеоконтента с доставленными файлами
+// Лейбл HasVideoContentFiles общий для всех версий, удалять его можно только вместе с последней
+func (s *Service) otherVersionsHaveFiles(ctx context.Context, content VideoContent) (bool, error) {
+	contentID := content.ContentID
+	contentID.VideoContentID = nil
+	contents, err := s.storage.GetVideoContents(ctx, contentID)
+	if err != nil {
+		return false, fmt.Errorf("storage.GetVideoContents: %w", err)
+	}
+
+	return lo.ContainsBy(contents, func(item VideoContent) bool {
+		if item.ID == content.ID {
+			return false
+		}
+		return item.DeliveryStatus == DeliveryStatusDelivered || item.DeliveryStatus == DeliveryStatusUpdating
+	}), nil
+}
+
+// otherVersionsUseCatalog у фильма / сезона есть другие версии видеоконтента, которые могли положить файлы в общий каталог
+func (s *Service) otherVersionsUseCatalog(ctx context.Context, content VideoContent) (bool, error) {
+	contentID := content.ContentID
+	contentID.VideoContentID = nil
+	contents, err := s.storage.GetVideoContents(ctx, contentID)
+	if err != nil {
+		return false, fmt.Errorf("storage.GetVideoContents: %w", err)
+	}
+
+	return lo.ContainsBy(contents, func(item VideoContent) bool {
+		if item.ID == content.ID {
+			return false
+		}
+		switch item.DeliveryStatus {
+		case DeliveryStatusNew, DeliveryStatusDeleted, DeliveryStatusCanceled:
+			return false
+		default:
+			return true
+		}
+	}), nil
+}
 
-	return contents[0], nil
+// fileNameWithoutExt имя файла без расширения
+func fileNameWithoutExt(name string) string {
+	return strings.TrimSuffix(name, filepath.Ext(name))
 }
@@ -0,0 +1,46 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/common"
+)
+
+func TestOtherVersionsHaveFiles(t *testing.T) {
+	tvShowID := &common.TVShowID{ID: 70523, SeasonNumber: 1}
+	newContent := func(status DeliveryStatus) VideoContent {
+		id := uuid.New()
+		return VideoContent{
+			ID:             id,
+			ContentID:      common.ContentID{TVShow: tvShowID, VideoContentID: &id},
+			DeliveryStatus: status,
+		}
+	}
+	current := newContent(DeliveryStatusDelivered)
+
+	for _, tc := range []struct {
+		name   string
+		others []VideoContent
+		want   bool
+	}{
+		{name: "single version", want: false},
+		{name: "other version delivered", others: []VideoContent{newContent(DeliveryStatusDelivered)}, want: true},
+		{name: "other version updating", others: []VideoContent{newContent(DeliveryStatusUpdating)}, want: true},
+		{
+			name:   "other versions without files",
+			others: []VideoContent{newContent(DeliveryStatusNew), newContent(DeliveryStatusInProgress), newContent(DeliveryStatusDeleted)},
+			want:   false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Service{storage: &fakeVideoContentStorage{items: append([]VideoContent{current}, tc.others...)}}
+			got, err := s.otherVersionsHaveFiles(context.Background(), current)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
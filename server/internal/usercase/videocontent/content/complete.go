@@ -38,6 +38,23 @@ func (s *Service) Complete(ctx context.Context) error {
 		return fmt.Errorf("failed run cron complete: %w", err)
 	}
 
+	// Проверка выхода новых эпизодов доставленных сезонов
+	if s.config.SeasonWatchInterval > 0 {
+		_, err = scheduler.Every(s.config.SeasonWatchInterval).Do(func() {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				if err := s.watchOngoingSeasons(ctx); err != nil {
+					s.logger.Errorf("watchOngoingSeasons: %v", err)
+				}
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed run cron season watch: %w", err)
+		}
+	}
+
 	// Запускаем планировщик (асинхронно)
 	scheduler.StartAsync()
 
@@ -62,6 +79,10 @@ func (s *Service) completeActiveVideoContent(ctx context.Context) error {
 	var runnerList = []runnerCommon{
 		deliveryRunner{s.tvShowDeliveryState},
 		deleteRunner{s.tvShowDeleteState},
+		cancelRunner{s.tvShowCancelState},
+		updateRunner{s.tvShowUpdateState},
+		movieDeliveryRunner{s.movieDeliveryState},
+		movieDeleteRunner{s.movieDeleteState},
 	}
 
 	statusIn := lo.Map(runnerList, func(item runnerCommon, index int) DeliveryStatus {
@@ -77,7 +98,7 @@ func (s *Service) completeActiveVideoContent(ctx context.Context) error {
 
 	for _, content := range contents {
 		runner, find := lo.Find(runnerList, func(item runnerCommon) bool {
-			return item.TargetDeliveryStatus() == content.DeliveryStatus
+			return item.TargetDeliveryStatus() == content.DeliveryStatus && item.SupportContent(content.ContentID)
 		})
 		if !find {
 			return fmt.Errorf("find item %v: %w", content, statemachine.ErrNotFound)
@@ -127,6 +148,11 @@ func (s *Service) completeVideoContent(ctx context.Context, content VideoContent
 	if stateID == nil {
 		return fmt.Errorf("no state ID found for content %v", content.ContentID)
 	}
+	// Стейт до добивания, нужен что бы понять что изменилось
+	prevState, err := runner.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return fmt.Errorf("runner.GetStateByID: %w", err)
+	}
 	// Complete
 	// Добиваем стейт
 	newState, executeErr, err := runner.Complete(ctx, *stateID)
@@ -137,6 +163,7 @@ func (s *Service) completeVideoContent(ctx context.Context, content VideoContent
 	// Ошибка в логике выполнения выпуска
 	if executeErr != nil {
 		s.logger.Errorf("executeError: %v", executeErr)
+		s.publishStateEvent(ctx, content.ContentID, *stateID, runner.RunnerType(), prevState, newState, executeErr)
 		return fmt.Errorf("runner.Complete executeError: %w", executeErr)
 	}
 	if getActualState {
@@ -146,6 +173,10 @@ func (s *Service) completeVideoContent(ctx context.Context, content VideoContent
 			return fmt.Errorf("runner.GetStateByID: %w", err)
 		}
 	}
+	s.publishStateEvent(ctx, content.ContentID, *stateID, runner.RunnerType(), prevState, newState, nil)
+	if err = s.remindWaitingUser(ctx, content.ContentID, *stateID, runner.RunnerType(), newState); err != nil {
+		s.logger.Warnf("remindWaitingUser: %v", err)
+	}
 
 	if newState.status == statemachine.CompletedStatus {
 		s.logger.Debugf("state is completed")
@@ -162,7 +193,7 @@ func (s *Service) completeVideoContent(ctx context.Context, content VideoContent
 			States:         content.States,
 		}
 		// Обновляем VideoContent статус
-		s.logger.Debugf("update video content: %d (season %d)", content.ContentID.TVShow.ID, content.ContentID.TVShow.SeasonNumber)
+		s.logger.Debugf("update video content: %s", content.ID)
 		err = s.storage.UpdateVideoContent(ctx, content.ID, &updateVideoContent)
 		if err != nil {
 			return fmt.Errorf("storage.UpdateVideoContent: %w", err)
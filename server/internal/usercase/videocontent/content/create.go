@@ -2,39 +2,80 @@ package content
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/kkiling/goplatform/storagebase"
 	"github.com/samber/lo"
 
 	"github.com/kkiling/media-delivery/internal/common"
 	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 	"github.com/kkiling/media-delivery/internal/usercase/labels"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
 	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
 )
 
+// maxVersionLength максимальная длина версии видеоконтента
+const maxVersionLength = 32
+
+func validateVersion(version string) error {
+	if version != strings.TrimSpace(version) {
+		return fmt.Errorf("version must not start or end with spaces: %w", ucerr.InvalidArgument)
+	}
+	if utf8.RuneCountInString(version) > maxVersionLength {
+		return fmt.Errorf("version is too long: %w", ucerr.InvalidArgument)
+	}
+	// Версия становится частью имени каталога и файлов
+	if strings.ContainsAny(version, `/\:*?"<>|`) {
+		return fmt.Errorf("version contains invalid characters: %w", ucerr.InvalidArgument)
+	}
+	return nil
+}
+
 func (s *Service) validateCreateVideoContentParams(ctx context.Context, params CreateVideoContentParams) error {
 	if err := params.ContentID.Validate(); err != nil {
 		return err
 	}
-	// Фильмы пока не доступны
-	if params.ContentID.MovieID != nil {
-		return fmt.Errorf("movieID is not support: %w", ucerr.InvalidArgument)
+	if err := validateVersion(params.Version); err != nil {
+		return err
 	}
-
-	// Ограничиваем одну раздачу на один фильм/сериал
-	found, err := s.GetVideoContent(ctx, params.ContentID)
+	// К одному фильму/сезону можно привязать несколько видеоконтентов, но с разными версиями
+	contentID := params.ContentID
+	contentID.VideoContentID = nil
+	found, err := s.GetVideoContent(ctx, contentID)
 	if err != nil {
 		return fmt.Errorf("getVideoContent: %w", err)
 	}
-	if len(found) > 0 {
+	if lo.ContainsBy(found, func(item VideoContent) bool {
+		return item.Version == params.Version
+	}) {
 		return ucerr.AlreadyExists
 	}
 
 	return nil
 }
 
+func (s *Service) checkMovieExistInLibrary(ctx context.Context, movieID uint64) error {
+	movieInfo, err := s.movieLibrary.GetMovieInfo(ctx, movielibrary.GetMovieParams{
+		MovieID: movieID,
+	})
+	if err != nil {
+		return fmt.Errorf("movieLibrary.GetMovieInfo: %w", err)
+	}
+	if movieInfo == nil {
+		return fmt.Errorf("movie: %w", ucerr.NotFound)
+	}
+
+	return nil
+}
+
 func (s *Service) checkContentExistInLibrary(ctx context.Context, contentID common.ContentID) error {
 	// Проверяем наличие сезона сериала изи фильма
+	if contentID.MovieID != nil {
+		return s.checkMovieExistInLibrary(ctx, *contentID.MovieID)
+	}
 	// Получаем инфу о сериале
 	tvShowInfo, err := s.tvShowLibrary.GetTVShowInfo(ctx, tvshowlibrary.GetTVShowParams{
 		TVShowID: contentID.TVShow.ID,
@@ -68,27 +109,19 @@ func (s *Service) CreateVideoContent(ctx context.Context, params CreateVideoCont
 
 	now := s.clock.Now()
 
+	id := s.uuidGenerator.New()
+	contentID := params.ContentID
+	contentID.VideoContentID = &id
 	videoContent := VideoContent{
-		ID:             s.uuidGenerator.New(),
+		ID:             id,
 		CreatedAt:      now,
-		ContentID:      params.ContentID,
+		ContentID:      contentID,
 		DeliveryStatus: DeliveryStatusNew,
-	}
-
-	tvShow := tvshowlibrary.AddTVShowInLibraryParams{
-		TVShowID:     params.ContentID.TVShow.ID,
-		SeasonNumber: params.ContentID.TVShow.SeasonNumber,
-	}
-
-	contentID := common.ContentID{
-		TVShow: &common.TVShowID{
-			ID:           params.ContentID.TVShow.ID,
-			SeasonNumber: params.ContentID.TVShow.SeasonNumber,
-		},
+		Version:        params.Version,
 	}
 
 	labelContentInLibrary := labels.Label{
-		ContentID: contentID,
+		ContentID: params.ContentID,
 		TypeLabel: labels.ContentInLibrary,
 		CreatedAt: now,
 	}
@@ -96,15 +129,35 @@ func (s *Service) CreateVideoContent(ctx context.Context, params CreateVideoCont
 	// TODO: !!! !!! !!! подумать как обернуть в одну транзакцию
 	{
 		// Добавили сериал в библиотеку
-		if err := s.tvShowLibrary.AddTVShowInLibrary(ctx, tvShow); err != nil {
-			return nil, fmt.Errorf("tvShowLibrary.AddTVShowInLibrary: %w", err)
+		if params.ContentID.TVShow != nil {
+			tvShow := tvshowlibrary.AddTVShowInLibraryParams{
+				TVShowID:     params.ContentID.TVShow.ID,
+				SeasonNumber: params.ContentID.TVShow.SeasonNumber,
+			}
+			if err := s.tvShowLibrary.AddTVShowInLibrary(ctx, tvShow); err != nil {
+				return nil, fmt.Errorf("tvShowLibrary.AddTVShowInLibrary: %w", err)
+			}
+		}
+		// Добавили фильм в библиотеку
+		if params.ContentID.MovieID != nil {
+			movie := movielibrary.AddMovieInLibraryParams{
+				MovieID: *params.ContentID.MovieID,
+			}
+			if err := s.movieLibrary.AddMovieInLibrary(ctx, movie); err != nil {
+				return nil, fmt.Errorf("movieLibrary.AddMovieInLibrary: %w", err)
+			}
 		}
-		// Добавили лейбл что сериал в библиотеке
-		if err := s.labels.AddLabel(ctx, labelContentInLibrary); err != nil {
+		// Добавили лейбл что контент в библиотеке
+		// Лейбл общий для всех версий фильма / сезона, при создании следующей версии он уже есть
+		if err := s.labels.AddLabel(ctx, labelContentInLibrary); err != nil && !errors.Is(err, ucerr.AlreadyExists) {
 			return nil, fmt.Errorf("labels.AddLabel: %w", err)
 		}
 		// Создали сущность видео контента
 		if err := s.storage.SaveVideoContent(ctx, &videoContent); err != nil {
+			// Версия уже создана параллельным запросом (уникальный индекс по фильму / сезону и версии)
+			if errors.Is(err, storagebase.ErrAlreadyExists) {
+				return nil, fmt.Errorf("storage.SaveVideoContent: %w", ucerr.AlreadyExists)
+			}
 			return nil, fmt.Errorf("storage.SaveVideoContent: %w", err)
 		}
 	}
@@ -0,0 +1,120 @@
+package content
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/labels"
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+)
+
+func TestValidateVersion(t *testing.T) {
+	for _, version := range []string{"", "4K", "1080p Кубик в Кубе", strings.Repeat("Я", maxVersionLength)} {
+		require.NoError(t, validateVersion(version), version)
+	}
+
+	for _, version := range []string{" 4K", "4K ", "4K/HDR", `4K\HDR`, "4K: HDR", strings.Repeat("Я", maxVersionLength+1)} {
+		require.ErrorIs(t, validateVersion(version), ucerr.InvalidArgument, version)
+	}
+}
+
+type fakeVideoContentStorage struct {
+	Storage
+	items []VideoContent
+}
+
+func (f *fakeVideoContentStorage) SaveVideoContent(_ context.Context, videoContent *VideoContent) error {
+	f.items = append(f.items, *videoContent)
+	return nil
+}
+
+func (f *fakeVideoContentStorage) GetVideoContents(_ context.Context, contentID common.ContentID) ([]VideoContent, error) {
+	var result []VideoContent
+	for _, item := range f.items {
+		if contentID.TVShow != nil && item.ContentID.TVShow != nil && *contentID.TVShow == *item.ContentID.TVShow {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeVideoContentStorage) GetTVShowVideoContents(_ context.Context, tvShowID uint64) ([]VideoContent, error) {
+	return lo.Filter(f.items, func(item VideoContent, _ int) bool {
+		return item.ContentID.TVShow != nil && item.ContentID.TVShow.ID == tvShowID
+	}), nil
+}
+
+func (f *fakeVideoContentStorage) UpdateVideoContent(_ context.Context, id uuid.UUID, videoContent *UpdateVideoContent) error {
+	for i := range f.items {
+		if f.items[i].ID == id {
+			f.items[i].DeliveryStatus = videoContent.DeliveryStatus
+			f.items[i].States = videoContent.States
+		}
+	}
+	return nil
+}
+
+type fakeTVShowLibrary struct {
+	TVShowLibrary
+	tvShow *tvshowlibrary.TVShow
+}
+
+func (f *fakeTVShowLibrary) GetTVShowInfo(_ context.Context, _ tvshowlibrary.GetTVShowParams) (*tvshowlibrary.GetTVShowResult, error) {
+	return &tvshowlibrary.GetTVShowResult{Result: f.tvShow}, nil
+}
+
+func (f *fakeTVShowLibrary) AddTVShowInLibrary(_ context.Context, _ tvshowlibrary.AddTVShowInLibraryParams) error {
+	return nil
+}
+
+// fakeLabels лейблы уникальны для сезона / фильма, как в content_label
+type fakeLabels struct {
+	items []labels.Label
+}
+
+func (f *fakeLabels) AddLabel(_ context.Context, label labels.Label) error {
+	for _, item := range f.items {
+		if item.TypeLabel == label.TypeLabel && *item.ContentID.TVShow == *label.ContentID.TVShow {
+			return ucerr.AlreadyExists
+		}
+	}
+	f.items = append(f.items, label)
+	return nil
+}
+
+func TestCreateVideoContentVersions(t *testing.T) {
+	storage := &fakeVideoContentStorage{}
+	contentLabels := &fakeLabels{}
+	s := &Service{
+		storage: storage,
+		tvShowLibrary: &fakeTVShowLibrary{tvShow: &tvshowlibrary.TVShow{
+			Seasons: []tvshowlibrary.Season{{SeasonNumber: 1}},
+		}},
+		labels:        contentLabels,
+		clock:         &common.RealClock{},
+		uuidGenerator: &common.UUIDGenerator{},
+	}
+	contentID := common.ContentID{TVShow: &common.TVShowID{ID: 70523, SeasonNumber: 1}}
+
+	first, err := s.CreateVideoContent(context.Background(), CreateVideoContentParams{ContentID: contentID})
+	require.NoError(t, err)
+	second, err := s.CreateVideoContent(context.Background(), CreateVideoContentParams{ContentID: contentID, Version: "4K"})
+	require.NoError(t, err)
+
+	require.NotEqual(t, first.ID, second.ID)
+	require.Equal(t, "4K", second.Version)
+	require.Len(t, storage.items, 2)
+	// Лейбл один на сезон
+	require.Len(t, contentLabels.items, 1)
+
+	// Версия уже существует
+	_, err = s.CreateVideoContent(context.Background(), CreateVideoContentParams{ContentID: contentID, Version: "4K"})
+	require.ErrorIs(t, err, ucerr.AlreadyExists)
+}
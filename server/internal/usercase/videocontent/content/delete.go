@@ -4,22 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/samber/lo"
+
 	"github.com/kkiling/media-delivery/internal/common"
 	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeletestate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelete"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 )
 
 func (s *Service) validateDeleteVideoContentFilesParams(ctx context.Context, params DeleteVideoContentFilesParams) error {
 	if err := params.ContentID.Validate(); err != nil {
 		return err
 	}
-	// Фильмы пока не доступны
-	if params.ContentID.MovieID != nil {
-		return fmt.Errorf("movieID is not support: %w", ucerr.InvalidArgument)
-	}
-
 	return nil
 }
 
@@ -27,6 +25,10 @@ func (s *Service) CreateDeleteState(ctx context.Context, params DeleteVideoConte
 	if err := s.validateDeleteVideoContentFilesParams(ctx, params); err != nil {
 		return nil, fmt.Errorf("validateDeleteVideoContentFilesParams: %w", err)
 	}
+	// Удаление фильмов идет через CreateMovieDeleteState
+	if params.ContentID.TVShow == nil {
+		return nil, fmt.Errorf("tvShow is required: %w", ucerr.InvalidArgument)
+	}
 
 	content, err := s.getVideoContent(ctx, params.ContentID)
 	if err != nil {
@@ -39,26 +41,43 @@ func (s *Service) CreateDeleteState(ctx context.Context, params DeleteVideoConte
 		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
 	}
 
-	stateID := getLastState(content, runners.TVShowDelivery)
-	if stateID == nil {
-		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	// Раздача и файлы сезона могли поменяться обновлением после доставки
+	season, err := s.getDeliveredSeason(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("getDeliveredSeason: %w", err)
 	}
 
-	// Достаем инфу о стейте доставки, что бы вытащить от туда нужную инфу
-	deliveryState, err := s.tvShowDeliveryState.GetStateByID(ctx, *stateID)
+	// Раздачу-пак не удаляем, пока ее используют другие сезоны
+	keepTorrent, err := s.torrentUsedByOtherContents(ctx, content, season.Torrent.MagnetLink.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("torrentUsedByOtherContents: %w", err)
+	}
+	keepLabel, err := s.otherVersionsHaveFiles(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("otherVersionsHaveFiles: %w", err)
+	}
+	// Каталог сезона общий для всех версий, пока в нем есть другие версии удаляются только файлы этой
+	keepCatalog, err := s.otherVersionsUseCatalog(ctx, content)
 	if err != nil {
-		return nil, fmt.Errorf("tvShowDeliveryState.GetStateByID: %w", err)
+		return nil, fmt.Errorf("otherVersionsUseCatalog: %w", err)
 	}
 
 	options := tvshowdeletestate.CreateOptions{
-		Index:       len(content.States),
-		TVShowID:    *params.ContentID.TVShow,
-		MagnetHash:  deliveryState.Data.Torrent.MagnetLink.Hash,
-		TorrentPath: deliveryState.Data.TorrentFilesData.ContentFullPath,
+		Index:          len(content.States),
+		VideoContentID: content.ID,
+		TVShowID:       *params.ContentID.TVShow,
+		MagnetHash:     season.Torrent.MagnetLink.Hash,
+		TorrentPath:    season.TorrentFilesData.ContentFullPath,
 		TVShowCatalogPath: tvshowdelete.TVShowCatalogPath{
-			TVShowPath: deliveryState.Data.EpisodesData.TVShowCatalogPath.TVShowPath,
-			SeasonPath: deliveryState.Data.EpisodesData.TVShowCatalogPath.SeasonPath,
+			TVShowPath: season.TVShowCatalogPath.TVShowPath,
+			SeasonPath: season.TVShowCatalogPath.SeasonPath,
 		},
+		KeepTorrent: keepTorrent,
+		KeepLabel:   keepLabel,
+		KeepCatalog: keepCatalog,
+		EpisodeFiles: lo.Map(season.DeliveredMatches, func(item tvshowdelivery.ContentMatch, _ int) string {
+			return fileNameWithoutExt(item.Episode.RelativePath)
+		}),
 	}
 
 	var result *tvshowdeletestate.State
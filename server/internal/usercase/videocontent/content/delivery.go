@@ -8,17 +8,16 @@ import (
 	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 )
 
 func (s *Service) validateDeliveryVideoContentParams(ctx context.Context, params DeliveryVideoContentParams) error {
 	if err := params.ContentID.Validate(); err != nil {
 		return err
 	}
-	// Фильмы пока не доступны
-	if params.ContentID.MovieID != nil {
-		return fmt.Errorf("movieID is not support: %w", ucerr.InvalidArgument)
+	if params.Restore && params.PackSource != nil {
+		return fmt.Errorf("restore and packSource cannot be used together: %w", ucerr.InvalidArgument)
 	}
-
 	return nil
 }
 
@@ -27,6 +26,10 @@ func (s *Service) CreateDeliveryState(ctx context.Context, params DeliveryVideoC
 	if err := s.validateDeliveryVideoContentParams(ctx, params); err != nil {
 		return nil, fmt.Errorf("validateDeliveryVideoContentParams: %w", err)
 	}
+	// Доставка фильмов идет через CreateMovieDeliveryState
+	if params.ContentID.TVShow == nil {
+		return nil, fmt.Errorf("tvShow is required: %w", ucerr.InvalidArgument)
+	}
 	// Достаем videoContent
 	content, err := s.getVideoContent(ctx, params.ContentID)
 	if err != nil {
@@ -37,13 +40,35 @@ func (s *Service) CreateDeliveryState(ctx context.Context, params DeliveryVideoC
 	switch content.DeliveryStatus {
 	case DeliveryStatusNew:
 	case DeliveryStatusDeleted:
+	case DeliveryStatusCanceled:
 	default:
 		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
 	}
 
 	options := tvshowdeliverystate.CreateOptions{
-		TVShowID: *params.ContentID.TVShow,
-		Index:    len(content.States),
+		TVShowID:       *params.ContentID.TVShow,
+		Index:          len(content.States),
+		VideoContentID: content.ID,
+		Version:        content.Version,
+	}
+	if params.Restore {
+		options.Restore, err = s.getRestoreData(ctx, content)
+		if err != nil {
+			return nil, fmt.Errorf("getRestoreData: %w", err)
+		}
+	}
+	if params.PackSource != nil {
+		options.Pack, err = s.getPackData(ctx, content, *params.PackSource)
+		if err != nil {
+			return nil, fmt.Errorf("getPackData: %w", err)
+		}
+	}
+	// В стейт сохраняется копия профиля, чтобы его изменение не влияло на уже запущенную доставку
+	if params.QualityProfileID != nil {
+		options.QualityProfile, err = s.qualityProfiles.GetQualityProfile(ctx, *params.QualityProfileID)
+		if err != nil {
+			return nil, fmt.Errorf("qualityProfiles.GetQualityProfile: %w", err)
+		}
 	}
 	var result *tvshowdeliverystate.State
 
@@ -73,6 +98,32 @@ func (s *Service) CreateDeliveryState(ctx context.Context, params DeliveryVideoC
 	return result, nil
 }
 
+// getRestoreData данные для восстановления удаленного сезона: раздача и подтвержденный метч файлов
+func (s *Service) getRestoreData(ctx context.Context, content VideoContent) (*tvshowdeliverystate.RestoreData, error) {
+	// Восстанавливать можно только сезон, файлы которого удалены
+	if content.DeliveryStatus != DeliveryStatusDeleted {
+		return nil, fmt.Errorf("video content files are not deleted: %w", ucerr.InvalidArgument)
+	}
+
+	season, err := s.getDeliveredSeason(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("getDeliveredSeason: %w", err)
+	}
+
+	result := &tvshowdeliverystate.RestoreData{
+		DeliveryStateID: season.DeliveryStateID,
+		Torrent:         season.Torrent,
+		ContentMatches: &tvshowdelivery.ContentMatches{
+			Matches: season.DeliveredMatches,
+			Options: season.ContentMatchesOptions,
+		},
+	}
+	if season.TorrentFilesData != nil {
+		result.TorrentFiles = season.TorrentFilesData.Files
+	}
+	return result, nil
+}
+
 func (s *Service) GetDeliveryData(ctx context.Context, contentID common.ContentID) (*tvshowdeliverystate.State, error) {
 	if err := contentID.Validate(); err != nil {
 		return nil, err
@@ -114,10 +165,28 @@ func (s *Service) ChoseTorrentOptions(ctx context.Context,
 		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
 	}
 
+	runner := deliveryRunner{s.tvShowDeliveryState}
+	prevState, err := runner.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("runner.GetStateByID: %w", err)
+	}
+	// Остальные шаги принимают только опции повтора
+	if prevState.step != string(tvshowdeliverystate.WaitingUserChoseTorrent) {
+		return nil, fmt.Errorf("state is not waiting chose torrent: %w", ucerr.InvalidArgument)
+	}
+
 	newState, executeErr, err := s.tvShowDeliveryState.Complete(ctx, *stateID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("tvShowDeliveryState.Complete: %w", err)
 	}
+	if newState != nil {
+		s.publishStateEvent(ctx, content.ContentID, *stateID, runners.TVShowDelivery, prevState, state{
+			status:    newState.Status,
+			step:      string(newState.Step),
+			err:       newState.Error,
+			updatedAt: newState.UpdatedAt,
+		}, executeErr)
+	}
 	if executeErr != nil {
 		s.logger.Errorf("tvShowDeliveryState.Complete: %v", executeErr)
 	}
@@ -142,10 +211,28 @@ func (s *Service) ChoseFileMatchesOptions(ctx context.Context,
 		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
 	}
 
+	runner := deliveryRunner{s.tvShowDeliveryState}
+	prevState, err := runner.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("runner.GetStateByID: %w", err)
+	}
+	// Остальные шаги принимают только опции повтора
+	if prevState.step != string(tvshowdeliverystate.WaitingChoseFileMatches) {
+		return nil, fmt.Errorf("state is not waiting chose file matches: %w", ucerr.InvalidArgument)
+	}
+
 	newState, executeErr, err := s.tvShowDeliveryState.Complete(ctx, *stateID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("tvShowDeliveryState.Complete: %w", err)
 	}
+	if newState != nil {
+		s.publishStateEvent(ctx, content.ContentID, *stateID, runners.TVShowDelivery, prevState, state{
+			status:    newState.Status,
+			step:      string(newState.Step),
+			err:       newState.Error,
+			updatedAt: newState.UpdatedAt,
+		}, executeErr)
+	}
 
 	if executeErr != nil {
 		s.logger.Errorf("tvShowDeliveryState.Complete: %v", executeErr)
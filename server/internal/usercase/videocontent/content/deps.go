@@ -8,24 +8,50 @@ import (
 
 	"github.com/kkiling/media-delivery/internal/common"
 	"github.com/kkiling/media-delivery/internal/usercase/labels"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
 	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeletestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowcancelstate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeletestate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowupdatestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
 )
 
 type Storage interface {
 	SaveVideoContent(ctx context.Context, videoContent *VideoContent) error
 	GetVideoContents(ctx context.Context, contentID common.ContentID) ([]VideoContent, error)
+	GetTVShowVideoContents(ctx context.Context, tvShowID uint64) ([]VideoContent, error)
 	UpdateVideoContent(ctx context.Context, id uuid.UUID, videoContent *UpdateVideoContent) error
 	GetVideoContentsByDeliveryStatus(ctx context.Context, statusIn []DeliveryStatus, limit int) ([]VideoContent, error)
+	GetStateReminder(ctx context.Context, stateID uuid.UUID, step string) (*StateReminder, error)
+	SaveStateReminder(ctx context.Context, reminder *StateReminder) error
+	GetSeasonCheck(ctx context.Context, videoContentID uuid.UUID) (*SeasonCheck, error)
+	SaveSeasonCheck(ctx context.Context, check *SeasonCheck) error
 }
 
 type TVShowLibrary interface {
 	GetTVShowInfo(ctx context.Context, params tvshowlibrary.GetTVShowParams) (*tvshowlibrary.GetTVShowResult, error)
 	GetSeasonInfo(ctx context.Context, params tvshowlibrary.GetSeasonInfoParams) (*tvshowlibrary.GetSeasonInfoResult, error)
+	RefreshSeasonEpisodes(ctx context.Context, params tvshowlibrary.RefreshSeasonEpisodesParams) (*tvshowlibrary.GetSeasonEpisodesResult, error)
 	AddTVShowInLibrary(ctx context.Context, params tvshowlibrary.AddTVShowInLibraryParams) error
 }
 
+// TVShowDelivery нужен для проверки выхода новых эпизодов доставленных сезонов и проверки метча файлов при повторе шага
+type TVShowDelivery interface {
+	GetMagnetLink(ctx context.Context, params tvshowdelivery.GetMagnetLinkParams) (*tvshowdelivery.MagnetLink, error)
+	CountDeliveredEpisodes(ctx context.Context, contentMatches []tvshowdelivery.ContentMatch) (int, error)
+	ValidateContentMatch(oldContentMatch *tvshowdelivery.ContentMatches, newContentMatch *tvshowdelivery.ContentMatches) error
+}
+
+type MovieLibrary interface {
+	GetMovieInfo(ctx context.Context, params movielibrary.GetMovieParams) (*movielibrary.GetMovieResult, error)
+	AddMovieInLibrary(ctx context.Context, params movielibrary.AddMovieInLibraryParams) error
+}
+
 type TVShowDeliveryState interface {
 	GetStateByID(ctx context.Context, stateID uuid.UUID) (*tvshowdeliverystate.State, error)
 	Create(ctx context.Context, opt tvshowdeliverystate.CreateOptions) (*tvshowdeliverystate.State, error)
@@ -38,6 +64,42 @@ type TVShowDeleteState interface {
 	Complete(ctx context.Context, stateID uuid.UUID, options ...any) (st *tvshowdeletestate.State, executeErr error, err error)
 }
 
+type TVShowCancelState interface {
+	GetStateByID(ctx context.Context, stateID uuid.UUID) (*tvshowcancelstate.State, error)
+	Create(ctx context.Context, opt tvshowcancelstate.CreateOptions) (*tvshowcancelstate.State, error)
+	Complete(ctx context.Context, stateID uuid.UUID, options ...any) (st *tvshowcancelstate.State, executeErr error, err error)
+}
+
+type TVShowUpdateState interface {
+	GetStateByID(ctx context.Context, stateID uuid.UUID) (*tvshowupdatestate.State, error)
+	Create(ctx context.Context, opt tvshowupdatestate.CreateOptions) (*tvshowupdatestate.State, error)
+	Complete(ctx context.Context, stateID uuid.UUID, options ...any) (st *tvshowupdatestate.State, executeErr error, err error)
+}
+
+type MovieDeliveryState interface {
+	GetStateByID(ctx context.Context, stateID uuid.UUID) (*moviedeliverystate.State, error)
+	Create(ctx context.Context, opt moviedeliverystate.CreateOptions) (*moviedeliverystate.State, error)
+	Complete(ctx context.Context, stateID uuid.UUID, options ...any) (st *moviedeliverystate.State, executeErr error, err error)
+}
+
+type MovieDeleteState interface {
+	GetStateByID(ctx context.Context, stateID uuid.UUID) (*moviedeletestate.State, error)
+	Create(ctx context.Context, opt moviedeletestate.CreateOptions) (*moviedeletestate.State, error)
+	Complete(ctx context.Context, stateID uuid.UUID, options ...any) (st *moviedeletestate.State, executeErr error, err error)
+}
+
+type QualityProfiles interface {
+	GetQualityProfile(ctx context.Context, id uuid.UUID) (*qualityprofile.QualityProfile, error)
+}
+
+type Webhooks interface {
+	Publish(ctx context.Context, event webhooks.Event) error
+}
+
+type Notifier interface {
+	Send(ctx context.Context, text string) error
+}
+
 type Labels interface {
 	AddLabel(ctx context.Context, label labels.Label) error
 }
@@ -26,6 +26,10 @@ const (
 	DeliveryStatusDeleting DeliveryStatus = iota
 	// DeliveryStatusDeleted - Файлы удалены
 	DeliveryStatusDeleted DeliveryStatus = iota
+	// DeliveryStatusCanceling - Доставка отменяется, откатываются ее изменения
+	DeliveryStatusCanceling DeliveryStatus = iota
+	// DeliveryStatusCanceled - Доставка отменена, можно запускать новую
+	DeliveryStatusCanceled DeliveryStatus = iota
 )
 
 type TorrentInfo struct {
@@ -49,6 +53,9 @@ type VideoContent struct {
 	DeliveryStatus DeliveryStatus
 	// Стейты привязанные к текущему контенту
 	States []State
+	// Version версия видеоконтента (например 4K или озвучка), добавляется суффиксом к каталогам и файлам
+	// Пустая у основного видеоконтента фильма/сезона
+	Version string
 }
 
 // State Таблица выпусков связанных с TVShowContent
@@ -64,3 +71,43 @@ type UpdateVideoContent struct {
 	// Стейты привязанные к текущему контенту
 	States []State
 }
+
+// StateReminder отметка о напоминании пользователю про стейт, ожидающий его действий
+type StateReminder struct {
+	StateID uuid.UUID
+	// Step шаг на котором стоял стейт
+	Step string
+	// RemindedAt когда было отправлено напоминание
+	RemindedAt time.Time
+}
+
+type SeasonCheckResult string
+
+const (
+	// SeasonCheckUpToDate все вышедшие эпизоды сезона доставлены
+	SeasonCheckUpToDate SeasonCheckResult = "up_to_date"
+	// SeasonCheckWaitingTorrent эпизоды вышли, но раздача на трекере еще не обновлена
+	SeasonCheckWaitingTorrent SeasonCheckResult = "waiting_torrent"
+	// SeasonCheckUpdateStarted раздача обновлена, запущено обновление сезона
+	SeasonCheckUpdateStarted SeasonCheckResult = "update_started"
+	// SeasonCheckError проверку не удалось выполнить
+	SeasonCheckError SeasonCheckResult = "error"
+)
+
+// SeasonCheck результат последней проверки выхода новых эпизодов доставленного сезона
+type SeasonCheck struct {
+	VideoContentID uuid.UUID
+	// CheckedAt когда была проверка
+	CheckedAt time.Time
+	Result    SeasonCheckResult
+	// EpisodesCount количество эпизодов в сезоне по данным TMDB
+	EpisodesCount int
+	// AiredEpisodesCount количество уже вышедших эпизодов
+	AiredEpisodesCount int
+	// DeliveredEpisodesCount количество эпизодов, файлы которых есть на медиасервере
+	DeliveredEpisodesCount int
+	// NextEpisodeAirDate дата выхода следующего эпизода, если известна
+	NextEpisodeAirDate *time.Time
+	// Error ошибка проверки
+	Error *string
+}
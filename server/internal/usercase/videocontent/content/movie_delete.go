@@ -0,0 +1,121 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelete"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeletestate"
+)
+
+// CreateMovieDeleteState создание удаления файлов фильма
+func (s *Service) CreateMovieDeleteState(ctx context.Context, params DeleteVideoContentFilesParams) (*moviedeletestate.State, error) {
+	if err := s.validateDeleteVideoContentFilesParams(ctx, params); err != nil {
+		return nil, fmt.Errorf("validateDeleteVideoContentFilesParams: %w", err)
+	}
+	if params.ContentID.MovieID == nil {
+		return nil, fmt.Errorf("movieID is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, params.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	switch content.DeliveryStatus {
+	case DeliveryStatusDelivered:
+	default:
+		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
+	}
+
+	stateID := getLastState(content, runners.MovieDelivery)
+	if stateID == nil {
+		return nil, fmt.Errorf("MovieDelivery: %w", ucerr.NotFound)
+	}
+
+	// Достаем инфу о стейте доставки, что бы вытащить от туда нужную инфу
+	deliveryState, err := s.movieDeliveryState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("movieDeliveryState.GetStateByID: %w", err)
+	}
+
+	data := deliveryState.Data
+	if data.Torrent == nil || data.Torrent.MagnetLink == nil || data.TorrentFilesData == nil || data.MovieData == nil {
+		return nil, fmt.Errorf("delivery state is incomplete: %w", ucerr.InvalidArgument)
+	}
+
+	keepLabel, err := s.otherVersionsHaveFiles(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("otherVersionsHaveFiles: %w", err)
+	}
+	// Каталог фильма общий для всех версий, пока в нем есть другие версии удаляется только файл этой
+	keepCatalog, err := s.otherVersionsUseCatalog(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("otherVersionsUseCatalog: %w", err)
+	}
+
+	options := moviedeletestate.CreateOptions{
+		Index:          len(content.States),
+		VideoContentID: content.ID,
+		MovieID:        *params.ContentID.MovieID,
+		MagnetHash:     data.Torrent.MagnetLink.Hash,
+		TorrentPath:    data.TorrentFilesData.ContentFullPath,
+		MovieCatalogPath: moviedelete.MovieCatalogPath{
+			MoviePath: data.MovieData.MovieCatalogPath.MoviePath,
+			FileName:  data.MovieData.MovieCatalogPath.FileName,
+		},
+		KeepLabel:   keepLabel,
+		KeepCatalog: keepCatalog,
+	}
+
+	var result *moviedeletestate.State
+	//  TODO: одна транзакция
+	{
+		result, err = s.movieDeleteState.Create(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("movieDeleteState.Create: %w", err)
+		}
+
+		// Обновить модель стейта videoContent
+		updateVideoContent := UpdateVideoContent{
+			DeliveryStatus: DeliveryStatusDeleting,
+			States: append(content.States, State{
+				StateID:   result.ID,
+				CreatedAt: result.CreatedAt,
+				Type:      runners.MovieDelete,
+			}),
+		}
+
+		if err = s.storage.UpdateVideoContent(ctx, content.ID, &updateVideoContent); err != nil {
+			return nil, fmt.Errorf("storage.UpdateVideoContent: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetMovieDeleteData(ctx context.Context, contentID common.ContentID) (*moviedeletestate.State, error) {
+	if err := contentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.getVideoContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	stateID := getLastState(content, runners.MovieDelete)
+	if stateID == nil {
+		return nil, fmt.Errorf("MovieDelete: %w", ucerr.NotFound)
+	}
+
+	result, err := s.movieDeleteState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("s.GetStateByID: %w", err)
+	}
+
+	return result, nil
+}
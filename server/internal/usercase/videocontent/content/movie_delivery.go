@@ -0,0 +1,134 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeliverystate"
+)
+
+// CreateMovieDeliveryState создание доставки файлов фильма
+func (s *Service) CreateMovieDeliveryState(ctx context.Context, params DeliveryVideoContentParams) (*moviedeliverystate.State, error) {
+	if err := s.validateDeliveryVideoContentParams(ctx, params); err != nil {
+		return nil, fmt.Errorf("validateDeliveryVideoContentParams: %w", err)
+	}
+	if params.ContentID.MovieID == nil {
+		return nil, fmt.Errorf("movieID is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, params.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	// Проверяем что он находится в правильном статусе
+	switch content.DeliveryStatus {
+	case DeliveryStatusNew:
+	case DeliveryStatusDeleted:
+	default:
+		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
+	}
+
+	options := moviedeliverystate.CreateOptions{
+		MovieID:        *params.ContentID.MovieID,
+		Index:          len(content.States),
+		VideoContentID: content.ID,
+		Version:        content.Version,
+	}
+	var result *moviedeliverystate.State
+
+	// TODO: одна транзакция
+	{
+		result, err = s.movieDeliveryState.Create(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("movieDeliveryState.Create: %w", err)
+		}
+
+		updateVideoContent := UpdateVideoContent{
+			DeliveryStatus: DeliveryStatusInProgress,
+			States: append(content.States, State{
+				StateID:   result.ID,
+				CreatedAt: result.CreatedAt,
+				Type:      runners.MovieDelivery,
+			}),
+		}
+
+		if err = s.storage.UpdateVideoContent(ctx, content.ID, &updateVideoContent); err != nil {
+			return nil, fmt.Errorf("storage.UpdateVideoContent: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetMovieDeliveryData(ctx context.Context, contentID common.ContentID) (*moviedeliverystate.State, error) {
+	if err := contentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.getVideoContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	stateID := getLastState(content, runners.MovieDelivery)
+	if stateID == nil {
+		return nil, fmt.Errorf("MovieDelivery: %w", ucerr.NotFound)
+	}
+
+	result, err := s.movieDeliveryState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("s.GetStateByID: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *Service) ChoseMovieTorrentOptions(ctx context.Context,
+	contentID common.ContentID,
+	opts moviedeliverystate.ChoseTorrentOptions,
+) (*moviedeliverystate.State, error) {
+	if err := contentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.getVideoContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	stateID := getLastState(content, runners.MovieDelivery)
+	if stateID == nil {
+		return nil, fmt.Errorf("MovieDelivery: %w", ucerr.NotFound)
+	}
+
+	runner := movieDeliveryRunner{s.movieDeliveryState}
+	prevState, err := runner.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("runner.GetStateByID: %w", err)
+	}
+	// Остальные шаги принимают только опции повтора
+	if prevState.step != string(moviedeliverystate.WaitingUserChoseTorrent) {
+		return nil, fmt.Errorf("state is not waiting chose torrent: %w", ucerr.InvalidArgument)
+	}
+
+	newState, executeErr, err := s.movieDeliveryState.Complete(ctx, *stateID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("movieDeliveryState.Complete: %w", err)
+	}
+	if newState != nil {
+		s.publishStateEvent(ctx, content.ContentID, *stateID, runners.MovieDelivery, prevState, state{
+			status:    newState.Status,
+			step:      string(newState.Step),
+			err:       newState.Error,
+			updatedAt: newState.UpdatedAt,
+		}, executeErr)
+	}
+	if executeErr != nil {
+		s.logger.Errorf("movieDeliveryState.Complete: %v", executeErr)
+	}
+	return newState, nil
+}
@@ -0,0 +1,97 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+// getSeasonTorrent раздача, которую сейчас использует сезон сериала
+// nil если раздача еще не выбрана или файлы сезона удалены / доставка отменена
+func (s *Service) getSeasonTorrent(ctx context.Context, content VideoContent) (*tvshowdelivery.Torrent, error) {
+	switch content.DeliveryStatus {
+	case DeliveryStatusDelivered, DeliveryStatusUpdating:
+		// Раздача могла поменяться обновлением после доставки
+		season, err := s.getDeliveredSeason(ctx, content)
+		if err != nil {
+			return nil, fmt.Errorf("getDeliveredSeason: %w", err)
+		}
+		return &season.Torrent, nil
+	case DeliveryStatusInProgress, DeliveryStatusFailed:
+		stateID := getLastState(content, runners.TVShowDelivery)
+		if stateID == nil {
+			return nil, nil
+		}
+		deliveryState, err := s.tvShowDeliveryState.GetStateByID(ctx, *stateID)
+		if err != nil {
+			return nil, fmt.Errorf("tvShowDeliveryState.GetStateByID: %w", err)
+		}
+		if deliveryState == nil || deliveryState.Data.Torrent == nil || deliveryState.Data.Torrent.MagnetLink == nil {
+			return nil, nil
+		}
+		return deliveryState.Data.Torrent, nil
+	default:
+		return nil, nil
+	}
+}
+
+// torrentUsedByOtherContents раздача (пак) используется видеоконтентом других сезонов сериала
+func (s *Service) torrentUsedByOtherContents(ctx context.Context, content VideoContent, hash string) (bool, error) {
+	contents, err := s.storage.GetTVShowVideoContents(ctx, content.ContentID.TVShow.ID)
+	if err != nil {
+		return false, fmt.Errorf("storage.GetTVShowVideoContents: %w", err)
+	}
+
+	for _, item := range contents {
+		if item.ID == content.ID {
+			continue
+		}
+		torrent, err := s.getSeasonTorrent(ctx, item)
+		if err != nil {
+			return false, fmt.Errorf("getSeasonTorrent: %w", err)
+		}
+		if torrent != nil && torrent.MagnetLink != nil && torrent.MagnetLink.Hash == hash {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getPackData раздача-пак, выбранная доставкой другого сезона того же сериала
+func (s *Service) getPackData(ctx context.Context, content VideoContent, source common.ContentID) (*tvshowdeliverystate.PackData, error) {
+	if err := source.Validate(); err != nil {
+		return nil, err
+	}
+	if source.TVShow == nil || source.TVShow.ID != content.ContentID.TVShow.ID {
+		return nil, fmt.Errorf("pack source must be a season of the same tvShow: %w", ucerr.InvalidArgument)
+	}
+
+	sourceContent, err := s.getVideoContent(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+	if sourceContent.ID == content.ID {
+		return nil, fmt.Errorf("pack source must be another video content: %w", ucerr.InvalidArgument)
+	}
+
+	torrent, err := s.getSeasonTorrent(ctx, sourceContent)
+	if err != nil {
+		return nil, fmt.Errorf("getSeasonTorrent: %w", err)
+	}
+	// Доставка сезона-источника еще не выбрала раздачу
+	if torrent == nil || torrent.MagnetLink == nil {
+		return nil, fmt.Errorf("pack source torrent is not selected: %w", ucerr.InvalidArgument)
+	}
+
+	return &tvshowdeliverystate.PackData{
+		SourceVideoContentID: sourceContent.ID,
+		SourceSeasonNumber:   source.TVShow.SeasonNumber,
+		Torrent:              *torrent,
+	}, nil
+}
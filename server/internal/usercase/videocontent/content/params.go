@@ -1,17 +1,51 @@
 package content
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 )
 
 type CreateVideoContentParams struct {
 	ContentID common.ContentID
+	// Version версия видеоконтента, обязательна если у фильма/сезона уже есть видеоконтент
+	Version string
 }
 
 type DeliveryVideoContentParams struct {
 	ContentID common.ContentID
+	// QualityProfileID профиль качества, по которому отбираются раздачи (необязательный)
+	QualityProfileID *uuid.UUID
+	// Restore восстановление удаленного сезона по данным предыдущей доставки без участия пользователя
+	Restore bool
+	// PackSource сезон того же сериала, доставка которого уже выбрала раздачу с несколькими сезонами (необязательный)
+	// Раздача скачивается один раз, из нее берутся только файлы доставляемого сезона
+	PackSource *common.ContentID
 }
 
 type DeleteVideoContentFilesParams struct {
 	ContentID common.ContentID
 }
+
+type CancelDeliveryParams struct {
+	ContentID common.ContentID
+}
+
+type UpdateDeliveryParams struct {
+	ContentID common.ContentID
+}
+
+type RetryDeliveryStepParams struct {
+	ContentID common.ContentID
+	// Href новая раздача (необязательный)
+	Href *string
+	// ContentMatches новый метч файлов (необязательный)
+	ContentMatches *tvshowdelivery.ContentMatches
+}
+
+type RetryMovieDeliveryStepParams struct {
+	ContentID common.ContentID
+	// Href новая раздача (необязательный)
+	Href *string
+}
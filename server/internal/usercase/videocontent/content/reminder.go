@@ -0,0 +1,151 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
+)
+
+// waitingUserStepNames что ждет от пользователя шаг
+// Названия шагов доставки фильма совпадают с шагами доставки сериала
+var waitingUserStepNames = map[string]string{
+	string(tvshowdeliverystate.WaitingUserChoseTorrent): "выбор раздачи",
+	string(tvshowdeliverystate.WaitingChoseFileMatches): "подтверждение соответствия файлов",
+}
+
+// contentLink ссылка на страницу контента в веб интерфейсе
+func contentLink(webURL string, contentID common.ContentID) string {
+	if webURL == "" {
+		return ""
+	}
+	webURL = strings.TrimRight(webURL, "/")
+	switch {
+	case contentID.TVShow != nil:
+		return fmt.Sprintf("%s/library/tvshows/%d/%d", webURL, contentID.TVShow.ID, contentID.TVShow.SeasonNumber)
+	case contentID.MovieID != nil:
+		return fmt.Sprintf("%s/library/movies/%d", webURL, *contentID.MovieID)
+	}
+	return ""
+}
+
+// needReminder стейт стоит на шаге ожидания пользователя дольше timeout
+// и о текущем заходе на этот шаг еще не напоминали
+func needReminder(timeout time.Duration, now time.Time, runnerType runners.Type, st state, reminder *StateReminder) bool {
+	if timeout <= 0 || st.status != statemachine.InProgressStatus {
+		return false
+	}
+	if !lo.Contains(waitingUserSteps[runnerType], st.step) {
+		return false
+	}
+	if now.Sub(st.updatedAt) < timeout {
+		return false
+	}
+	// Напоминание отправлено уже после того как стейт пришел на шаг
+	if reminder != nil && reminder.RemindedAt.After(st.updatedAt) {
+		return false
+	}
+	return true
+}
+
+func reminderText(contentName string, st state, waiting time.Duration, link string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Доставка ждет действий пользователя уже %s\n", waiting.Truncate(time.Minute)))
+	sb.WriteString(fmt.Sprintf("%s\n", contentName))
+	sb.WriteString(fmt.Sprintf("Нужно: %s", lo.ValueOr(waitingUserStepNames, st.step, st.step)))
+	if link != "" {
+		sb.WriteString(fmt.Sprintf("\n%s", link))
+	}
+	return sb.String()
+}
+
+// contentName название контента для уведомления, если не удалось получить - используется id
+func (s *Service) contentName(ctx context.Context, contentID common.ContentID) string {
+	switch {
+	case contentID.TVShow != nil:
+		name := fmt.Sprintf("Сериал %d, сезон %d", contentID.TVShow.ID, contentID.TVShow.SeasonNumber)
+		info, err := s.tvShowLibrary.GetTVShowInfo(ctx, tvshowlibrary.GetTVShowParams{TVShowID: contentID.TVShow.ID})
+		if err != nil || info == nil || info.Result == nil {
+			return name
+		}
+		return fmt.Sprintf("%s, сезон %d", info.Result.Name, contentID.TVShow.SeasonNumber)
+	case contentID.MovieID != nil:
+		name := fmt.Sprintf("Фильм %d", *contentID.MovieID)
+		info, err := s.movieLibrary.GetMovieInfo(ctx, movielibrary.GetMovieParams{MovieID: *contentID.MovieID})
+		if err != nil || info == nil || info.Result == nil {
+			return name
+		}
+		return info.Result.Title
+	}
+	return ""
+}
+
+// remindWaitingUser напоминает о стейте, который слишком долго ждет действий пользователя
+// Напоминание отправляется один раз на каждый заход стейта на шаг ожидания
+func (s *Service) remindWaitingUser(ctx context.Context,
+	contentID common.ContentID,
+	stateID uuid.UUID,
+	runnerType runners.Type,
+	st state,
+) error {
+	now := s.clock.Now()
+	if !needReminder(s.config.WaitingUserInputReminder, now, runnerType, st, nil) {
+		return nil
+	}
+
+	reminder, err := s.storage.GetStateReminder(ctx, stateID, st.step)
+	if err != nil && !errors.Is(err, storagebase.ErrNotFound) {
+		return fmt.Errorf("storage.GetStateReminder: %w", err)
+	}
+	if !needReminder(s.config.WaitingUserInputReminder, now, runnerType, st, reminder) {
+		return nil
+	}
+
+	// Сначала фиксируем напоминание, что бы недоступный канал уведомлений не приводил к спаму
+	if err = s.storage.SaveStateReminder(ctx, &StateReminder{
+		StateID:    stateID,
+		Step:       st.step,
+		RemindedAt: now,
+	}); err != nil {
+		return fmt.Errorf("storage.SaveStateReminder: %w", err)
+	}
+
+	link := contentLink(s.config.WebURL, contentID)
+	text := reminderText(s.contentName(ctx, contentID), st, now.Sub(st.updatedAt), link)
+	s.logger.Infof("reminder: %s", strings.ReplaceAll(text, "\n", "; "))
+
+	event := webhooks.Event{
+		Type:      webhooks.EventUserInputReminder,
+		StateID:   stateID,
+		StateType: string(runnerType),
+		MovieID:   contentID.MovieID,
+		Step:      st.step,
+		Status:    stateStatusName(st.status),
+		Link:      link,
+	}
+	if contentID.TVShow != nil {
+		event.TVShowID = lo.ToPtr(contentID.TVShow.ID)
+		event.SeasonNumber = lo.ToPtr(contentID.TVShow.SeasonNumber)
+	}
+	if err = s.webhooks.Publish(ctx, event); err != nil {
+		s.logger.Warnf("webhooks.Publish: %v", err)
+	}
+	if err = s.notifier.Send(ctx, text); err != nil {
+		s.logger.Warnf("notifier.Send: %v", err)
+	}
+
+	return nil
+}
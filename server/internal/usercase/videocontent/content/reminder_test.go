@@ -0,0 +1,108 @@
+package content
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+func TestContentLink(t *testing.T) {
+	tvShowID := common.ContentID{TVShow: &common.TVShowID{ID: 1399, SeasonNumber: 2}}
+	movieID := common.ContentID{MovieID: lo.ToPtr(uint64(603))}
+
+	require.Equal(t, "", contentLink("", tvShowID))
+	require.Equal(t, "http://media.local/library/tvshows/1399/2", contentLink("http://media.local/", tvShowID))
+	require.Equal(t, "http://media.local/library/movies/603", contentLink("http://media.local", movieID))
+}
+
+func TestNeedReminder(t *testing.T) {
+	now := time.Date(2025, 11, 23, 12, 0, 0, 0, time.UTC)
+	timeout := time.Hour
+	waiting := state{
+		status:    statemachine.InProgressStatus,
+		step:      "waiting_user_chose_torrent",
+		updatedAt: now.Add(-2 * time.Hour),
+	}
+
+	tests := []struct {
+		name       string
+		timeout    time.Duration
+		runnerType runners.Type
+		state      state
+		reminder   *StateReminder
+		want       bool
+	}{
+		{
+			name:       "waiting too long",
+			timeout:    timeout,
+			runnerType: runners.TVShowDelivery,
+			state:      waiting,
+			want:       true,
+		},
+		{
+			name:       "disabled",
+			runnerType: runners.TVShowDelivery,
+			state:      waiting,
+		},
+		{
+			name:       "not waiting step",
+			timeout:    timeout,
+			runnerType: runners.TVShowDelivery,
+			state: state{
+				status:    statemachine.InProgressStatus,
+				step:      "waiting_torrent_download_complete",
+				updatedAt: waiting.updatedAt,
+			},
+		},
+		{
+			name:       "delete runner",
+			timeout:    timeout,
+			runnerType: runners.TVShowDelete,
+			state:      waiting,
+		},
+		{
+			name:       "timeout not reached",
+			timeout:    3 * time.Hour,
+			runnerType: runners.TVShowDelivery,
+			state:      waiting,
+		},
+		{
+			name:       "failed state",
+			timeout:    timeout,
+			runnerType: runners.MovieDelivery,
+			state: state{
+				status:    statemachine.FailedStatus,
+				step:      waiting.step,
+				updatedAt: waiting.updatedAt,
+			},
+		},
+		{
+			name:       "already reminded",
+			timeout:    timeout,
+			runnerType: runners.TVShowDelivery,
+			state:      waiting,
+			reminder:   &StateReminder{Step: waiting.step, RemindedAt: now.Add(-time.Hour)},
+		},
+		{
+			name:       "reminded about previous visit of step",
+			timeout:    timeout,
+			runnerType: runners.TVShowDelivery,
+			state:      waiting,
+			reminder:   &StateReminder{Step: waiting.step, RemindedAt: now.Add(-3 * time.Hour)},
+			want:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := needReminder(tt.timeout, now, tt.runnerType, tt.state, tt.reminder)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
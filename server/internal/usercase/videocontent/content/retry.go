@@ -0,0 +1,164 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/statemachine"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+)
+
+// RetryDeliveryStep повтор шага доставки сезона сериала, завершившегося ошибкой
+// Повтор фиксируется в истории стейта, после чего шаг сразу выполняется заново
+func (s *Service) RetryDeliveryStep(ctx context.Context, params RetryDeliveryStepParams) (*tvshowdeliverystate.State, error) {
+	if err := params.ContentID.Validate(); err != nil {
+		return nil, err
+	}
+	// Повтор шагов доставки фильма - RetryMovieDeliveryStep
+	if params.ContentID.TVShow == nil {
+		return nil, fmt.Errorf("tvShow is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, params.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+	// Стейт в терминальном статусе уже не добить, повторить можно только доставку в процессе
+	if content.DeliveryStatus != DeliveryStatusInProgress {
+		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
+	}
+
+	stateID := getLastState(content, runners.TVShowDelivery)
+	if stateID == nil {
+		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	}
+
+	deliveryState, err := s.tvShowDeliveryState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("tvShowDeliveryState.GetStateByID: %w", err)
+	}
+	if deliveryState == nil {
+		return nil, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	}
+
+	opts := tvshowdeliverystate.RetryStepOptions{
+		RetriedAt:      s.clock.Now(),
+		Href:           params.Href,
+		ContentMatches: params.ContentMatches,
+	}
+	if err = tvshowdeliverystate.ValidateRetryStep(deliveryState, opts); err != nil {
+		return nil, fmt.Errorf("ValidateRetryStep: %w", err)
+	}
+	if params.ContentMatches != nil {
+		// Проверяем валидацию что все дорожки на месте
+		if err = s.tvShowDelivery.ValidateContentMatch(deliveryState.Data.ContentMatches, params.ContentMatches); err != nil {
+			return nil, fmt.Errorf("ValidateContentMatch: %v: %w", err, ucerr.InvalidArgument)
+		}
+	}
+
+	prevState := state{
+		status:    deliveryState.Status,
+		step:      string(deliveryState.Step),
+		err:       deliveryState.Error,
+		updatedAt: deliveryState.UpdatedAt,
+	}
+	// Фиксируем повтор и новые данные
+	newState, executeErr, err := s.tvShowDeliveryState.Complete(ctx, *stateID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("tvShowDeliveryState.Complete: %w", err)
+	}
+	// Если новые данные не прошли проверку, повторять шаг нет смысла
+	if executeErr == nil && newState.Status == statemachine.InProgressStatus {
+		// Выполняем шаг заново уже без опций
+		newState, executeErr, err = s.tvShowDeliveryState.Complete(ctx, *stateID)
+		if err != nil {
+			return nil, fmt.Errorf("tvShowDeliveryState.Complete: %w", err)
+		}
+	}
+
+	s.publishStateEvent(ctx, content.ContentID, *stateID, runners.TVShowDelivery, prevState, state{
+		status:    newState.Status,
+		step:      string(newState.Step),
+		err:       newState.Error,
+		updatedAt: newState.UpdatedAt,
+	}, executeErr)
+	if executeErr != nil {
+		s.logger.Errorf("tvShowDeliveryState.Complete: %v", executeErr)
+	}
+	return newState, nil
+}
+
+// RetryMovieDeliveryStep повтор шага доставки фильма, завершившегося ошибкой
+// Повтор фиксируется в истории стейта, после чего шаг сразу выполняется заново
+func (s *Service) RetryMovieDeliveryStep(ctx context.Context, params RetryMovieDeliveryStepParams) (*moviedeliverystate.State, error) {
+	if err := params.ContentID.Validate(); err != nil {
+		return nil, err
+	}
+	if params.ContentID.MovieID == nil {
+		return nil, fmt.Errorf("movieID is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, params.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+	// Стейт в терминальном статусе уже не добить, повторить можно только доставку в процессе
+	if content.DeliveryStatus != DeliveryStatusInProgress {
+		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
+	}
+
+	stateID := getLastState(content, runners.MovieDelivery)
+	if stateID == nil {
+		return nil, fmt.Errorf("MovieDelivery: %w", ucerr.NotFound)
+	}
+
+	deliveryState, err := s.movieDeliveryState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("movieDeliveryState.GetStateByID: %w", err)
+	}
+	if deliveryState == nil {
+		return nil, fmt.Errorf("MovieDelivery: %w", ucerr.NotFound)
+	}
+
+	opts := moviedeliverystate.RetryStepOptions{
+		RetriedAt: s.clock.Now(),
+		Href:      params.Href,
+	}
+	if err = moviedeliverystate.ValidateRetryStep(deliveryState, opts); err != nil {
+		return nil, fmt.Errorf("ValidateRetryStep: %w", err)
+	}
+
+	prevState := state{
+		status:    deliveryState.Status,
+		step:      string(deliveryState.Step),
+		err:       deliveryState.Error,
+		updatedAt: deliveryState.UpdatedAt,
+	}
+	// Фиксируем повтор и новые данные
+	newState, executeErr, err := s.movieDeliveryState.Complete(ctx, *stateID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("movieDeliveryState.Complete: %w", err)
+	}
+	if executeErr == nil && newState.Status == statemachine.InProgressStatus {
+		// Выполняем шаг заново уже без опций
+		newState, executeErr, err = s.movieDeliveryState.Complete(ctx, *stateID)
+		if err != nil {
+			return nil, fmt.Errorf("movieDeliveryState.Complete: %w", err)
+		}
+	}
+
+	s.publishStateEvent(ctx, content.ContentID, *stateID, runners.MovieDelivery, prevState, state{
+		status:    newState.Status,
+		step:      string(newState.Step),
+		err:       newState.Error,
+		updatedAt: newState.UpdatedAt,
+	}, executeErr)
+	if executeErr != nil {
+		s.logger.Errorf("movieDeliveryState.Complete: %v", executeErr)
+	}
+	return newState, nil
+}
@@ -0,0 +1,95 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+type fakeTVShowDeliveryState struct {
+	TVShowDeliveryState
+	states    map[uuid.UUID]*tvshowdeliverystate.State
+	completed int
+}
+
+func (f *fakeTVShowDeliveryState) GetStateByID(_ context.Context, stateID uuid.UUID) (*tvshowdeliverystate.State, error) {
+	return f.states[stateID], nil
+}
+
+func (f *fakeTVShowDeliveryState) Complete(_ context.Context, stateID uuid.UUID, _ ...any) (*tvshowdeliverystate.State, error, error) {
+	f.completed++
+	return f.states[stateID], nil, nil
+}
+
+type fakeTVShowDelivery struct {
+	TVShowDelivery
+	validateErr error
+}
+
+func (f *fakeTVShowDelivery) ValidateContentMatch(_, _ *tvshowdelivery.ContentMatches) error {
+	return f.validateErr
+}
+
+func TestRetryDeliveryStepContentMatches(t *testing.T) {
+	contentID := common.ContentID{TVShow: &common.TVShowID{ID: 70523, SeasonNumber: 1}}
+	stateID := uuid.New()
+	newService := func(deliveryState *fakeTVShowDeliveryState, validateErr error) *Service {
+		return &Service{
+			storage: &fakeVideoContentStorage{items: []VideoContent{{
+				ID:             uuid.New(),
+				ContentID:      contentID,
+				DeliveryStatus: DeliveryStatusInProgress,
+				States: []State{{
+					StateID:   stateID,
+					CreatedAt: time.Now(),
+					Type:      runners.TVShowDelivery,
+				}},
+			}}},
+			tvShowDelivery:      &fakeTVShowDelivery{validateErr: validateErr},
+			tvShowDeliveryState: deliveryState,
+			clock:               &common.RealClock{},
+		}
+	}
+	newDeliveryState := func() *fakeTVShowDeliveryState {
+		return &fakeTVShowDeliveryState{states: map[uuid.UUID]*tvshowdeliverystate.State{
+			stateID: {
+				Step:  tvshowdeliverystate.CreateVideoContentCatalogs,
+				Error: lo.ToPtr("mkdir: permission denied"),
+			},
+		}}
+	}
+	params := RetryDeliveryStepParams{
+		ContentID:      contentID,
+		ContentMatches: &tvshowdelivery.ContentMatches{},
+	}
+
+	t.Run("invalid content matches are rejected before retry", func(t *testing.T) {
+		deliveryState := newDeliveryState()
+		s := newService(deliveryState, errors.New("episode 1 has no video"))
+
+		_, err := s.RetryDeliveryStep(context.Background(), params)
+		require.ErrorIs(t, err, ucerr.InvalidArgument)
+		// Стейт не тронут - ошибка шага и история повторов остаются прежними
+		require.Equal(t, 0, deliveryState.completed)
+	})
+
+	t.Run("valid content matches", func(t *testing.T) {
+		deliveryState := newDeliveryState()
+		s := newService(deliveryState, nil)
+
+		_, err := s.RetryDeliveryStep(context.Background(), params)
+		require.NoError(t, err)
+		require.Equal(t, 1, deliveryState.completed)
+	})
+}
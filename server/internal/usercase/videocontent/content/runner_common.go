@@ -3,16 +3,22 @@ package content
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kkiling/statemachine"
 
+	"github.com/kkiling/media-delivery/internal/common"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
 )
 
 type state struct {
 	status statemachine.Status
 	step   string
+	// err ошибка последнего выполнения шага
+	err *string
+	// updatedAt когда стейт перешел на текущий шаг или статус
+	updatedAt time.Time
 }
 
 type runnerCommon interface {
@@ -21,6 +27,8 @@ type runnerCommon interface {
 	TargetDeliveryStatus() DeliveryStatus
 	ToDeliveryStatus(status statemachine.Status) DeliveryStatus
 	RunnerType() runners.Type
+	// SupportContent раннер обслуживает данный тип контента (фильм или сезон сериала)
+	SupportContent(contentID common.ContentID) bool
 }
 
 type deliveryRunner struct {
@@ -31,6 +39,10 @@ func (d deliveryRunner) RunnerType() runners.Type {
 	return runners.TVShowDelivery
 }
 
+func (d deliveryRunner) SupportContent(contentID common.ContentID) bool {
+	return contentID.TVShow != nil
+}
+
 func (d deliveryRunner) TargetDeliveryStatus() DeliveryStatus {
 	return DeliveryStatusInProgress
 }
@@ -51,8 +63,10 @@ func (d deliveryRunner) Complete(ctx context.Context, stateID uuid.UUID) (st sta
 
 	if res != nil {
 		st = state{
-			status: res.Status,
-			step:   string(res.Step),
+			status:    res.Status,
+			step:      string(res.Step),
+			err:       res.Error,
+			updatedAt: res.UpdatedAt,
 		}
 	}
 	return st, err1, err2
@@ -67,8 +81,10 @@ func (d deliveryRunner) GetStateByID(ctx context.Context, stateID uuid.UUID) (st
 		return state{}, fmt.Errorf("failed to complete state")
 	}
 	return state{
-		status: res.Status,
-		step:   string(res.Step),
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
 	}, nil
 }
 
@@ -80,6 +96,10 @@ func (d deleteRunner) RunnerType() runners.Type {
 	return runners.TVShowDelete
 }
 
+func (d deleteRunner) SupportContent(contentID common.ContentID) bool {
+	return contentID.TVShow != nil
+}
+
 func (d deleteRunner) TargetDeliveryStatus() DeliveryStatus {
 	return DeliveryStatusDeleting
 }
@@ -101,8 +121,10 @@ func (d deleteRunner) Complete(ctx context.Context, stateID uuid.UUID) (st state
 		return state{}, nil, fmt.Errorf("failed to complete state")
 	}
 	return state{
-		status: res.Status,
-		step:   string(res.Step),
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
 	}, err1, err2
 }
 
@@ -115,7 +137,235 @@ func (d deleteRunner) GetStateByID(ctx context.Context, stateID uuid.UUID) (stat
 		return state{}, fmt.Errorf("failed to complete state")
 	}
 	return state{
-		status: res.Status,
-		step:   string(res.Step),
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, nil
+}
+
+type cancelRunner struct {
+	runner TVShowCancelState
+}
+
+func (d cancelRunner) RunnerType() runners.Type {
+	return runners.TVShowCancel
+}
+
+func (d cancelRunner) SupportContent(contentID common.ContentID) bool {
+	return contentID.TVShow != nil
+}
+
+func (d cancelRunner) TargetDeliveryStatus() DeliveryStatus {
+	return DeliveryStatusCanceling
+}
+
+func (d cancelRunner) ToDeliveryStatus(status statemachine.Status) DeliveryStatus {
+	switch status {
+	case statemachine.CompletedStatus:
+		return DeliveryStatusCanceled
+	case statemachine.FailedStatus:
+		return DeliveryStatusFailed
+	default:
+		return DeliveryStatusCanceling
+	}
+}
+
+func (d cancelRunner) Complete(ctx context.Context, stateID uuid.UUID) (st state, executeErr error, err error) {
+	res, err1, err2 := d.runner.Complete(ctx, stateID)
+	if res == nil {
+		return state{}, nil, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, err1, err2
+}
+
+func (d cancelRunner) GetStateByID(ctx context.Context, stateID uuid.UUID) (state, error) {
+	res, err := d.runner.GetStateByID(ctx, stateID)
+	if err != nil {
+		return state{}, err
+	}
+	if res == nil {
+		return state{}, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, nil
+}
+
+type updateRunner struct {
+	runner TVShowUpdateState
+}
+
+func (d updateRunner) RunnerType() runners.Type {
+	return runners.TVShowUpdate
+}
+
+func (d updateRunner) SupportContent(contentID common.ContentID) bool {
+	return contentID.TVShow != nil
+}
+
+func (d updateRunner) TargetDeliveryStatus() DeliveryStatus {
+	return DeliveryStatusUpdating
+}
+
+func (d updateRunner) ToDeliveryStatus(status statemachine.Status) DeliveryStatus {
+	switch status {
+	case statemachine.CompletedStatus:
+		return DeliveryStatusDelivered
+	case statemachine.FailedStatus:
+		// Неудачное обновление не трогает уже доставленные файлы сезона,
+		// ошибка остается в стейте обновления, а сезон можно удалить или обновить снова
+		return DeliveryStatusDelivered
+	default:
+		return DeliveryStatusUpdating
+	}
+}
+
+func (d updateRunner) Complete(ctx context.Context, stateID uuid.UUID) (st state, executeErr error, err error) {
+	res, err1, err2 := d.runner.Complete(ctx, stateID)
+	if res == nil {
+		return state{}, nil, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, err1, err2
+}
+
+func (d updateRunner) GetStateByID(ctx context.Context, stateID uuid.UUID) (state, error) {
+	res, err := d.runner.GetStateByID(ctx, stateID)
+	if err != nil {
+		return state{}, err
+	}
+	if res == nil {
+		return state{}, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, nil
+}
+
+type movieDeliveryRunner struct {
+	runner MovieDeliveryState
+}
+
+func (d movieDeliveryRunner) RunnerType() runners.Type {
+	return runners.MovieDelivery
+}
+
+func (d movieDeliveryRunner) SupportContent(contentID common.ContentID) bool {
+	return contentID.MovieID != nil
+}
+
+func (d movieDeliveryRunner) TargetDeliveryStatus() DeliveryStatus {
+	return DeliveryStatusInProgress
+}
+
+func (d movieDeliveryRunner) ToDeliveryStatus(status statemachine.Status) DeliveryStatus {
+	switch status {
+	case statemachine.CompletedStatus:
+		return DeliveryStatusDelivered
+	case statemachine.FailedStatus:
+		return DeliveryStatusFailed
+	default:
+		return DeliveryStatusInProgress
+	}
+}
+
+func (d movieDeliveryRunner) Complete(ctx context.Context, stateID uuid.UUID) (st state, executeErr error, err error) {
+	res, err1, err2 := d.runner.Complete(ctx, stateID)
+	if res != nil {
+		st = state{
+			status:    res.Status,
+			step:      string(res.Step),
+			err:       res.Error,
+			updatedAt: res.UpdatedAt,
+		}
+	}
+	return st, err1, err2
+}
+
+func (d movieDeliveryRunner) GetStateByID(ctx context.Context, stateID uuid.UUID) (state, error) {
+	res, err := d.runner.GetStateByID(ctx, stateID)
+	if err != nil {
+		return state{}, err
+	}
+	if res == nil {
+		return state{}, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, nil
+}
+
+type movieDeleteRunner struct {
+	runner MovieDeleteState
+}
+
+func (d movieDeleteRunner) RunnerType() runners.Type {
+	return runners.MovieDelete
+}
+
+func (d movieDeleteRunner) SupportContent(contentID common.ContentID) bool {
+	return contentID.MovieID != nil
+}
+
+func (d movieDeleteRunner) TargetDeliveryStatus() DeliveryStatus {
+	return DeliveryStatusDeleting
+}
+
+func (d movieDeleteRunner) ToDeliveryStatus(status statemachine.Status) DeliveryStatus {
+	switch status {
+	case statemachine.CompletedStatus:
+		return DeliveryStatusDeleted
+	case statemachine.FailedStatus:
+		return DeliveryStatusFailed
+	default:
+		return DeliveryStatusDeleting
+	}
+}
+
+func (d movieDeleteRunner) Complete(ctx context.Context, stateID uuid.UUID) (st state, executeErr error, err error) {
+	res, err1, err2 := d.runner.Complete(ctx, stateID)
+	if res == nil {
+		return state{}, nil, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
+	}, err1, err2
+}
+
+func (d movieDeleteRunner) GetStateByID(ctx context.Context, stateID uuid.UUID) (state, error) {
+	res, err := d.runner.GetStateByID(ctx, stateID)
+	if err != nil {
+		return state{}, err
+	}
+	if res == nil {
+		return state{}, fmt.Errorf("failed to complete state")
+	}
+	return state{
+		status:    res.Status,
+		step:      string(res.Step),
+		err:       res.Error,
+		updatedAt: res.UpdatedAt,
 	}, nil
 }
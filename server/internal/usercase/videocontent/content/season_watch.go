@@ -0,0 +1,184 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+// watchSeasonsLimit сколько доставленного контента просматривать за одну проверку
+const watchSeasonsLimit = 1000
+
+// countSeasonEpisodes количество эпизодов сезона, сколько из них уже вышло и когда выйдет следующий
+// Эпизоды без даты выхода считаются не вышедшими
+func countSeasonEpisodes(episodes []tvshowlibrary.Episode, now time.Time) (total, aired int, nextAirDate *time.Time) {
+	for _, episode := range episodes {
+		if episode.AirDate.IsZero() {
+			continue
+		}
+		if !episode.AirDate.After(now) {
+			aired++
+			continue
+		}
+		if nextAirDate == nil || episode.AirDate.Before(*nextAirDate) {
+			nextAirDate = lo.ToPtr(episode.AirDate)
+		}
+	}
+	return len(episodes), aired, nextAirDate
+}
+
+// needCheckSeason пора ли снова проверять сезон
+func needCheckSeason(check *SeasonCheck, interval time.Duration, now time.Time) bool {
+	if check == nil {
+		return true
+	}
+	if check.Result == SeasonCheckUpToDate && check.NextEpisodeAirDate != nil {
+		// Все вышедшие эпизоды доставлены, следующая проверка после выхода нового эпизода
+		return !now.Before(*check.NextEpisodeAirDate)
+	}
+	// Если дата выхода следующего эпизода неизвестна, проверяем с обычным интервалом:
+	// themoviedb может добавить в сезон новые эпизоды или объявить их даты
+	return now.Sub(check.CheckedAt) >= interval
+}
+
+// checkSeasonEpisodes сравнивает вышедшие эпизоды с доставленными
+// и при обновлении раздачи на трекере запускает обновление сезона
+func (s *Service) checkSeasonEpisodes(ctx context.Context, content VideoContent, check *SeasonCheck) (SeasonCheckResult, error) {
+	season, err := s.getDeliveredSeason(ctx, content)
+	if err != nil {
+		return "", fmt.Errorf("getDeliveredSeason: %w", err)
+	}
+
+	// Эпизоды в библиотеке сохранены на момент добавления сериала,
+	// у онгоинга список эпизодов и даты выхода нужно брать актуальные
+	episodes, err := s.tvShowLibrary.RefreshSeasonEpisodes(ctx, tvshowlibrary.RefreshSeasonEpisodesParams{
+		TVShowID:     content.ContentID.TVShow.ID,
+		SeasonNumber: content.ContentID.TVShow.SeasonNumber,
+	})
+	if err != nil {
+		return "", fmt.Errorf("tvShowLibrary.RefreshSeasonEpisodes: %w", err)
+	}
+	check.EpisodesCount, check.AiredEpisodesCount, check.NextEpisodeAirDate = countSeasonEpisodes(episodes.Items, check.CheckedAt)
+
+	check.DeliveredEpisodesCount, err = s.tvShowDelivery.CountDeliveredEpisodes(ctx, season.DeliveredMatches)
+	if err != nil {
+		return "", fmt.Errorf("tvShowDelivery.CountDeliveredEpisodes: %w", err)
+	}
+	if check.DeliveredEpisodesCount >= check.AiredEpisodesCount {
+		return SeasonCheckUpToDate, nil
+	}
+
+	// Новые эпизоды вышли, на трекере раздача онгоинга перезаливается с новой магнет ссылкой
+	magnetLink, err := s.tvShowDelivery.GetMagnetLink(ctx, tvshowdelivery.GetMagnetLinkParams{
+		Href: season.Torrent.Href,
+	})
+	if err != nil {
+		return "", fmt.Errorf("tvShowDelivery.GetMagnetLink: %w", err)
+	}
+	if magnetLink.Hash == season.Torrent.MagnetLink.Hash {
+		return SeasonCheckWaitingTorrent, nil
+	}
+
+	if _, err = s.CreateUpdateState(ctx, UpdateDeliveryParams{ContentID: content.ContentID}); err != nil {
+		return "", fmt.Errorf("CreateUpdateState: %w", err)
+	}
+	return SeasonCheckUpdateStarted, nil
+}
+
+func (s *Service) checkSeason(ctx context.Context, content VideoContent) *SeasonCheck {
+	check := &SeasonCheck{
+		VideoContentID: content.ID,
+		CheckedAt:      s.clock.Now(),
+	}
+
+	result, err := s.checkSeasonEpisodes(ctx, content, check)
+	if err != nil {
+		check.Result = SeasonCheckError
+		check.Error = lo.ToPtr(err.Error())
+		return check
+	}
+	check.Result = result
+	return check
+}
+
+// watchOngoingSeasons проверка выхода новых эпизодов доставленных сезонов
+func (s *Service) watchOngoingSeasons(ctx context.Context) error {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("panic recovered",
+				zap.Any("panic_value", r),
+				zap.Stack("stack"),
+			)
+		}
+	}()
+
+	contents, err := s.storage.GetVideoContentsByDeliveryStatus(ctx, []DeliveryStatus{DeliveryStatusDelivered}, watchSeasonsLimit)
+	if err != nil {
+		return fmt.Errorf("storage.GetVideoContentsByDeliveryStatus: %w", err)
+	}
+
+	now := s.clock.Now()
+	for _, content := range contents {
+		if content.ContentID.TVShow == nil {
+			continue
+		}
+
+		prevCheck, err := s.storage.GetSeasonCheck(ctx, content.ID)
+		if err != nil && !errors.Is(err, storagebase.ErrNotFound) {
+			return fmt.Errorf("storage.GetSeasonCheck: %w", err)
+		}
+		if !needCheckSeason(prevCheck, s.config.SeasonWatchInterval, now) {
+			continue
+		}
+
+		check := s.checkSeason(ctx, content)
+		if check.Error != nil {
+			s.logger.Warnf("check season %s: %s", content.ID, *check.Error)
+		} else {
+			s.logger.Debugf("check season %s: %s, %d of %d episodes delivered",
+				content.ID, check.Result, check.DeliveredEpisodesCount, check.EpisodesCount)
+		}
+
+		if err = s.storage.SaveSeasonCheck(ctx, check); err != nil {
+			return fmt.Errorf("storage.SaveSeasonCheck: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSeasonCheck результат последней проверки выхода новых эпизодов сезона
+func (s *Service) GetSeasonCheck(ctx context.Context, contentID common.ContentID) (*SeasonCheck, error) {
+	if err := contentID.Validate(); err != nil {
+		return nil, err
+	}
+	if contentID.TVShow == nil {
+		return nil, fmt.Errorf("tvShow is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	result, err := s.storage.GetSeasonCheck(ctx, content.ID)
+	switch {
+	case err == nil:
+	case errors.Is(err, storagebase.ErrNotFound):
+		return nil, ucerr.NotFound
+	default:
+		return nil, fmt.Errorf("storage.GetSeasonCheck: %w", err)
+	}
+
+	return result, nil
+}
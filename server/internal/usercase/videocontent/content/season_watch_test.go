@@ -0,0 +1,65 @@
+package content
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+)
+
+func TestCountSeasonEpisodes(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	episodes := []tvshowlibrary.Episode{
+		{EpisodeNumber: 1, AirDate: now.Add(-14 * day)},
+		{EpisodeNumber: 2, AirDate: now.Add(-7 * day)},
+		{EpisodeNumber: 3, AirDate: now},
+		{EpisodeNumber: 5, AirDate: now.Add(14 * day)},
+		{EpisodeNumber: 4, AirDate: now.Add(7 * day)},
+		// Дата выхода еще не объявлена
+		{EpisodeNumber: 6},
+	}
+
+	total, aired, nextAirDate := countSeasonEpisodes(episodes, now)
+	require.Equal(t, 6, total)
+	require.Equal(t, 3, aired)
+	require.Equal(t, lo.ToPtr(now.Add(7*day)), nextAirDate)
+
+	total, aired, nextAirDate = countSeasonEpisodes(episodes[:3], now)
+	require.Equal(t, 3, total)
+	require.Equal(t, 3, aired)
+	require.Nil(t, nextAirDate)
+}
+
+func TestNeedCheckSeason(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	interval := 6 * time.Hour
+
+	require.True(t, needCheckSeason(nil, interval, now))
+
+	t.Run("waiting torrent", func(t *testing.T) {
+		check := &SeasonCheck{Result: SeasonCheckWaitingTorrent, CheckedAt: now.Add(-time.Hour)}
+		require.False(t, needCheckSeason(check, interval, now))
+		check.CheckedAt = now.Add(-interval)
+		require.True(t, needCheckSeason(check, interval, now))
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		check := &SeasonCheck{
+			Result:             SeasonCheckUpToDate,
+			CheckedAt:          now.Add(-24 * time.Hour),
+			NextEpisodeAirDate: lo.ToPtr(now.Add(time.Hour)),
+		}
+		require.False(t, needCheckSeason(check, interval, now))
+		check.NextEpisodeAirDate = lo.ToPtr(now.Add(-time.Hour))
+		require.True(t, needCheckSeason(check, interval, now))
+		// Дата следующего эпизода неизвестна - проверяем с обычным интервалом
+		check.NextEpisodeAirDate = nil
+		require.True(t, needCheckSeason(check, interval, now))
+		check.CheckedAt = now.Add(-time.Hour)
+		require.False(t, needCheckSeason(check, interval, now))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/kkiling/goplatform/log"
 	"github.com/kkiling/goplatform/storagebase"
@@ -12,31 +13,72 @@ import (
 	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 )
 
+type Config struct {
+	// WaitingUserInputReminder через сколько напомнить о доставке, ожидающей действий пользователя
+	// 0 - напоминания выключены
+	WaitingUserInputReminder time.Duration
+	// WebURL адрес веб интерфейса, используется для ссылок в напоминаниях
+	WebURL string
+	// SeasonWatchInterval как часто проверять выход новых эпизодов доставленных сезонов
+	// 0 - проверки выключены
+	SeasonWatchInterval time.Duration
+}
+
 type Service struct {
+	config              Config
 	logger              log.Logger
 	storage             Storage
 	tvShowLibrary       TVShowLibrary
+	tvShowDelivery      TVShowDelivery
 	tvShowDeliveryState TVShowDeliveryState
 	tvShowDeleteState   TVShowDeleteState
+	tvShowCancelState   TVShowCancelState
+	tvShowUpdateState   TVShowUpdateState
+	movieLibrary        MovieLibrary
+	movieDeliveryState  MovieDeliveryState
+	movieDeleteState    MovieDeleteState
 	labels              Labels
+	qualityProfiles     QualityProfiles
+	webhooks            Webhooks
+	notifier            Notifier
 	clock               Clock
 	uuidGenerator       UUIDGenerator
 }
 
 func NewService(
 	logger log.Logger,
+	config Config,
 	storage Storage,
 	tvShowLibrary TVShowLibrary,
+	tvShowDelivery TVShowDelivery,
 	tvShowDeliveryState TVShowDeliveryState,
 	tvShowDeleteState TVShowDeleteState,
+	tvShowCancelState TVShowCancelState,
+	tvShowUpdateState TVShowUpdateState,
+	movieLibrary MovieLibrary,
+	movieDeliveryState MovieDeliveryState,
+	movieDeleteState MovieDeleteState,
 	labels Labels,
+	qualityProfiles QualityProfiles,
+	webhooks Webhooks,
+	notifier Notifier,
 ) *Service {
 	return &Service{
+		config:              config,
 		storage:             storage,
 		tvShowLibrary:       tvShowLibrary,
+		tvShowDelivery:      tvShowDelivery,
 		tvShowDeliveryState: tvShowDeliveryState,
 		tvShowDeleteState:   tvShowDeleteState,
+		tvShowCancelState:   tvShowCancelState,
+		tvShowUpdateState:   tvShowUpdateState,
+		movieLibrary:        movieLibrary,
+		movieDeliveryState:  movieDeliveryState,
+		movieDeleteState:    movieDeleteState,
 		labels:              labels,
+		qualityProfiles:     qualityProfiles,
+		webhooks:            webhooks,
+		notifier:            notifier,
 		clock:               &common.RealClock{},
 		uuidGenerator:       &common.UUIDGenerator{},
 		logger:              logger.Named("content"),
@@ -65,5 +107,5 @@ func (s *Service) GetVideoContent(ctx context.Context, contentID common.ContentI
 	default:
 		return nil, fmt.Errorf("storage.GetVideoContent: %w", err)
 	}
-	return result, nil
+	return filterVideoContents(result, contentID), nil
 }
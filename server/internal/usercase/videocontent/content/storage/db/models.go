@@ -67,6 +67,38 @@ type MkvMergeLog struct {
 	Content   string
 }
 
+type Movie struct {
+	ID            int64
+	Title         string
+	OriginalTitle string
+	Overview      string
+	PosterID      *string
+	ReleaseDate   time.Time
+	VoteAverage   float64
+	VoteCount     int
+	Popularity    float64
+	BackdropID    *string
+	Genres        []string
+	ImdbID        string
+	OriginCountry []string
+	Runtime       int
+	Status        string
+	Tagline       string
+}
+
+type QualityProfile struct {
+	ID                uuid.UUID
+	Name              string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	MinResolution     int
+	MaxResolution     int
+	PreferredCodecs   []string
+	ForbiddenCodecs   []string
+	RequiredAudio     []string
+	MaxSizePerEpisode int64
+}
+
 type Season struct {
 	TvShowID     int64
 	SeasonNumber int
@@ -78,6 +110,17 @@ type Season struct {
 	VoteAverage  float32
 }
 
+type SeasonCheck struct {
+	VideoContentID         uuid.UUID
+	CheckedAt              time.Time
+	Result                 string
+	EpisodesCount          int
+	AiredEpisodesCount     int
+	DeliveredEpisodesCount int
+	NextEpisodeAirDate     pgtype.Timestamptz
+	Error                  *string
+}
+
 type State struct {
 	ID             uuid.UUID
 	IdempotencyKey string
@@ -92,6 +135,12 @@ type State struct {
 	MetaData       []byte
 }
 
+type StateReminder struct {
+	StateID    uuid.UUID
+	Step       string
+	RemindedAt time.Time
+}
+
 type StepExecuteInfo struct {
 	ID                 int
 	StateID            uuid.UUID
@@ -131,4 +180,18 @@ type VideoContent struct {
 	SeasonNumber   *int32
 	DeliveryStatus int
 	States         []byte
+	Version        string
+}
+
+type WebhookOutbox struct {
+	ID            uuid.UUID
+	WebhookName   string
+	EventType     string
+	Payload       []byte
+	CreatedAt     time.Time
+	Attempts      int
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+	FailedAt      *time.Time
+	LastError     *string
 }
@@ -10,10 +10,49 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const getSeasonCheck = `-- name: GetSeasonCheck :one
+SELECT video_content_id, checked_at, result, episodes_count, aired_episodes_count, delivered_episodes_count, next_episode_air_date, error FROM season_checks
+WHERE video_content_id=$1
+`
+
+func (q *Queries) GetSeasonCheck(ctx context.Context, videoContentID uuid.UUID) (SeasonCheck, error) {
+	row := q.db.QueryRow(ctx, getSeasonCheck, videoContentID)
+	var i SeasonCheck
+	err := row.Scan(
+		&i.VideoContentID,
+		&i.CheckedAt,
+		&i.Result,
+		&i.EpisodesCount,
+		&i.AiredEpisodesCount,
+		&i.DeliveredEpisodesCount,
+		&i.NextEpisodeAirDate,
+		&i.Error,
+	)
+	return i, err
+}
+
+const getStateReminder = `-- name: GetStateReminder :one
+SELECT state_id, step, reminded_at FROM state_reminders
+WHERE state_id=$1 AND step=$2
+`
+
+type GetStateReminderParams struct {
+	StateID uuid.UUID
+	Step    string
+}
+
+func (q *Queries) GetStateReminder(ctx context.Context, arg GetStateReminderParams) (StateReminder, error) {
+	row := q.db.QueryRow(ctx, getStateReminder, arg.StateID, arg.Step)
+	var i StateReminder
+	err := row.Scan(&i.StateID, &i.Step, &i.RemindedAt)
+	return i, err
+}
+
 const getVideoContentTVShow = `-- name: GetVideoContentTVShow :many
-SELECT id, created_at, delivery_status, states FROM video_content
+SELECT id, created_at, delivery_status, states, version FROM video_content
 WHERE tvshow_id=$1 AND season_number=$2
 `
 
@@ -27,6 +66,7 @@ type GetVideoContentTVShowRow struct {
 	CreatedAt      time.Time
 	DeliveryStatus int
 	States         []byte
+	Version        string
 }
 
 func (q *Queries) GetVideoContentTVShow(ctx context.Context, arg GetVideoContentTVShowParams) ([]GetVideoContentTVShowRow, error) {
@@ -43,6 +83,7 @@ func (q *Queries) GetVideoContentTVShow(ctx context.Context, arg GetVideoContent
 			&i.CreatedAt,
 			&i.DeliveryStatus,
 			&i.States,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -55,7 +96,7 @@ func (q *Queries) GetVideoContentTVShow(ctx context.Context, arg GetVideoContent
 }
 
 const getVideoContentsByDeliveryStatus = `-- name: GetVideoContentsByDeliveryStatus :many
-SELECT id, created_at, movie_id, tvshow_id, season_number, delivery_status, states FROM video_content
+SELECT id, created_at, movie_id, tvshow_id, season_number, delivery_status, states, version FROM video_content
 WHERE delivery_status=ANY($1::int[]) ORDER BY created_at DESC limit $2
 `
 
@@ -81,6 +122,7 @@ func (q *Queries) GetVideoContentsByDeliveryStatus(ctx context.Context, arg GetV
 			&i.SeasonNumber,
 			&i.DeliveryStatus,
 			&i.States,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -93,7 +135,7 @@ func (q *Queries) GetVideoContentsByDeliveryStatus(ctx context.Context, arg GetV
 }
 
 const getVideoContentsMovieID = `-- name: GetVideoContentsMovieID :many
-SELECT id, created_at,delivery_status, states FROM video_content
+SELECT id, created_at,delivery_status, states, version FROM video_content
 WHERE movie_id=$1
 `
 
@@ -102,6 +144,7 @@ type GetVideoContentsMovieIDRow struct {
 	CreatedAt      time.Time
 	DeliveryStatus int
 	States         []byte
+	Version        string
 }
 
 func (q *Queries) GetVideoContentsMovieID(ctx context.Context, movieID *int64) ([]GetVideoContentsMovieIDRow, error) {
@@ -118,6 +161,7 @@ func (q *Queries) GetVideoContentsMovieID(ctx context.Context, movieID *int64) (
 			&i.CreatedAt,
 			&i.DeliveryStatus,
 			&i.States,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -129,9 +173,105 @@ func (q *Queries) GetVideoContentsMovieID(ctx context.Context, movieID *int64) (
 	return items, nil
 }
 
+const getVideoContentsTVShowID = `-- name: GetVideoContentsTVShowID :many
+SELECT id, created_at, season_number, delivery_status, states, version FROM video_content
+WHERE tvshow_id=$1
+`
+
+type GetVideoContentsTVShowIDRow struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	SeasonNumber   *int32
+	DeliveryStatus int
+	States         []byte
+	Version        string
+}
+
+func (q *Queries) GetVideoContentsTVShowID(ctx context.Context, tvshowID *int64) ([]GetVideoContentsTVShowIDRow, error) {
+	rows, err := q.db.Query(ctx, getVideoContentsTVShowID, tvshowID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVideoContentsTVShowIDRow
+	for rows.Next() {
+		var i GetVideoContentsTVShowIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.SeasonNumber,
+			&i.DeliveryStatus,
+			&i.States,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveSeasonCheck = `-- name: SaveSeasonCheck :exec
+INSERT INTO season_checks (video_content_id, checked_at, result, episodes_count,
+                           aired_episodes_count, delivered_episodes_count, next_episode_air_date, error)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (video_content_id) DO UPDATE SET checked_at=EXCLUDED.checked_at,
+                                             result=EXCLUDED.result,
+                                             episodes_count=EXCLUDED.episodes_count,
+                                             aired_episodes_count=EXCLUDED.aired_episodes_count,
+                                             delivered_episodes_count=EXCLUDED.delivered_episodes_count,
+                                             next_episode_air_date=EXCLUDED.next_episode_air_date,
+                                             error=EXCLUDED.error
+`
+
+type SaveSeasonCheckParams struct {
+	VideoContentID         uuid.UUID
+	CheckedAt              time.Time
+	Result                 string
+	EpisodesCount          int
+	AiredEpisodesCount     int
+	DeliveredEpisodesCount int
+	NextEpisodeAirDate     pgtype.Timestamptz
+	Error                  *string
+}
+
+func (q *Queries) SaveSeasonCheck(ctx context.Context, arg SaveSeasonCheckParams) error {
+	_, err := q.db.Exec(ctx, saveSeasonCheck,
+		arg.VideoContentID,
+		arg.CheckedAt,
+		arg.Result,
+		arg.EpisodesCount,
+		arg.AiredEpisodesCount,
+		arg.DeliveredEpisodesCount,
+		arg.NextEpisodeAirDate,
+		arg.Error,
+	)
+	return err
+}
+
+const saveStateReminder = `-- name: SaveStateReminder :exec
+INSERT INTO state_reminders (state_id, step, reminded_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (state_id, step) DO UPDATE SET reminded_at=EXCLUDED.reminded_at
+`
+
+type SaveStateReminderParams struct {
+	StateID    uuid.UUID
+	Step       string
+	RemindedAt time.Time
+}
+
+func (q *Queries) SaveStateReminder(ctx context.Context, arg SaveStateReminderParams) error {
+	_, err := q.db.Exec(ctx, saveStateReminder, arg.StateID, arg.Step, arg.RemindedAt)
+	return err
+}
+
 const saveVideoContent = `-- name: SaveVideoContent :exec
-INSERT INTO video_content (id, created_at, movie_id, tvshow_id, season_number, delivery_status, states)
-VALUES ($1, $2, $3, $4, $5, $6,$7)
+INSERT INTO video_content (id, created_at, movie_id, tvshow_id, season_number, delivery_status, states, version)
+VALUES ($1, $2, $3, $4, $5, $6,$7, $8)
 `
 
 type SaveVideoContentParams struct {
@@ -142,6 +282,7 @@ type SaveVideoContentParams struct {
 	SeasonNumber   *int32
 	DeliveryStatus int
 	States         []byte
+	Version        string
 }
 
 func (q *Queries) SaveVideoContent(ctx context.Context, arg SaveVideoContentParams) error {
@@ -153,6 +294,7 @@ func (q *Queries) SaveVideoContent(ctx context.Context, arg SaveVideoContentPara
 		arg.SeasonNumber,
 		arg.DeliveryStatus,
 		arg.States,
+		arg.Version,
 	)
 	return err
 }
@@ -0,0 +1,43 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content/storage/db"
+)
+
+func (s *Storage) GetStateReminder(ctx context.Context, stateID uuid.UUID, step string) (*content.StateReminder, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetStateReminder(ctx, db.GetStateReminderParams{
+		StateID: stateID,
+		Step:    step,
+	})
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	return &content.StateReminder{
+		StateID:    res.StateID,
+		Step:       res.Step,
+		RemindedAt: res.RemindedAt,
+	}, nil
+}
+
+func (s *Storage) SaveStateReminder(ctx context.Context, reminder *content.StateReminder) error {
+	queries := s.getQueries(ctx)
+
+	err := queries.SaveStateReminder(ctx, db.SaveStateReminderParams{
+		StateID:    reminder.StateID,
+		Step:       reminder.Step,
+		RemindedAt: reminder.RemindedAt,
+	})
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/kkiling/goplatform/storagebase/testutils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content"
+)
+
+func TestStorage_StateReminder(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	stateID := uuid.New()
+	_, err := testStorage.GetStateReminder(ctx, stateID, "waiting_user_chose_torrent")
+	require.ErrorIs(t, err, storagebase.ErrNotFound)
+
+	reminder := &content.StateReminder{
+		StateID:    stateID,
+		Step:       "waiting_user_chose_torrent",
+		RemindedAt: time.Now().Add(-time.Hour),
+	}
+	require.NoError(t, testStorage.SaveStateReminder(ctx, reminder))
+
+	saved, err := testStorage.GetStateReminder(ctx, stateID, reminder.Step)
+	require.NoError(t, err)
+	require.Equal(t, reminder.StateID, saved.StateID)
+	require.Equal(t, reminder.Step, saved.Step)
+	require.WithinDuration(t, reminder.RemindedAt, saved.RemindedAt, time.Second)
+
+	// Повторное напоминание о том же шаге обновляет время
+	reminder.RemindedAt = time.Now()
+	require.NoError(t, testStorage.SaveStateReminder(ctx, reminder))
+	saved, err = testStorage.GetStateReminder(ctx, stateID, reminder.Step)
+	require.NoError(t, err)
+	require.WithinDuration(t, reminder.RemindedAt, saved.RemindedAt, time.Second)
+
+	_, err = testStorage.GetStateReminder(ctx, stateID, "waiting_chose_file_matches")
+	require.ErrorIs(t, err, storagebase.ErrNotFound)
+}
@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content/storage/db"
+)
+
+func (s *Storage) GetSeasonCheck(ctx context.Context, videoContentID uuid.UUID) (*content.SeasonCheck, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetSeasonCheck(ctx, videoContentID)
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	return &content.SeasonCheck{
+		VideoContentID:         res.VideoContentID,
+		CheckedAt:              res.CheckedAt,
+		Result:                 content.SeasonCheckResult(res.Result),
+		EpisodesCount:          res.EpisodesCount,
+		AiredEpisodesCount:     res.AiredEpisodesCount,
+		DeliveredEpisodesCount: res.DeliveredEpisodesCount,
+		NextEpisodeAirDate: func() *time.Time {
+			if res.NextEpisodeAirDate.Valid {
+				return &res.NextEpisodeAirDate.Time
+			}
+			return nil
+		}(),
+		Error: res.Error,
+	}, nil
+}
+
+func (s *Storage) SaveSeasonCheck(ctx context.Context, check *content.SeasonCheck) error {
+	queries := s.getQueries(ctx)
+
+	var nextEpisodeAirDate pgtype.Timestamptz
+	if check.NextEpisodeAirDate != nil {
+		nextEpisodeAirDate = pgtype.Timestamptz{
+			Time:  *check.NextEpisodeAirDate,
+			Valid: true,
+		}
+	}
+
+	err := queries.SaveSeasonCheck(ctx, db.SaveSeasonCheckParams{
+		VideoContentID:         check.VideoContentID,
+		CheckedAt:              check.CheckedAt,
+		Result:                 string(check.Result),
+		EpisodesCount:          check.EpisodesCount,
+		AiredEpisodesCount:     check.AiredEpisodesCount,
+		DeliveredEpisodesCount: check.DeliveredEpisodesCount,
+		NextEpisodeAirDate:     nextEpisodeAirDate,
+		Error:                  check.Error,
+	})
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/kkiling/goplatform/storagebase/testutils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content"
+)
+
+func TestStorage_SeasonCheck(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	videoContentID := uuid.New()
+	_, err := testStorage.GetSeasonCheck(ctx, videoContentID)
+	require.ErrorIs(t, err, storagebase.ErrNotFound)
+
+	check := &content.SeasonCheck{
+		VideoContentID:         videoContentID,
+		CheckedAt:              time.Now().Add(-time.Hour),
+		Result:                 content.SeasonCheckWaitingTorrent,
+		EpisodesCount:          10,
+		AiredEpisodesCount:     4,
+		DeliveredEpisodesCount: 3,
+		NextEpisodeAirDate:     lo.ToPtr(time.Now().Add(72 * time.Hour)),
+	}
+	require.NoError(t, testStorage.SaveSeasonCheck(ctx, check))
+
+	saved, err := testStorage.GetSeasonCheck(ctx, videoContentID)
+	require.NoError(t, err)
+	require.Equal(t, check.Result, saved.Result)
+	require.Equal(t, check.EpisodesCount, saved.EpisodesCount)
+	require.Equal(t, check.AiredEpisodesCount, saved.AiredEpisodesCount)
+	require.Equal(t, check.DeliveredEpisodesCount, saved.DeliveredEpisodesCount)
+	require.WithinDuration(t, check.CheckedAt, saved.CheckedAt, time.Second)
+	require.NotNil(t, saved.NextEpisodeAirDate)
+	require.WithinDuration(t, *check.NextEpisodeAirDate, *saved.NextEpisodeAirDate, time.Second)
+	require.Nil(t, saved.Error)
+
+	// Повторная проверка перезаписывает результат
+	check.CheckedAt = time.Now()
+	check.Result = content.SeasonCheckError
+	check.NextEpisodeAirDate = nil
+	check.Error = lo.ToPtr("torrentSite.GetMagnetLink: service unavailable")
+	require.NoError(t, testStorage.SaveSeasonCheck(ctx, check))
+
+	saved, err = testStorage.GetSeasonCheck(ctx, videoContentID)
+	require.NoError(t, err)
+	require.Equal(t, content.SeasonCheckError, saved.Result)
+	require.Nil(t, saved.NextEpisodeAirDate)
+	require.Equal(t, check.Error, saved.Error)
+}
@@ -13,6 +13,12 @@ import (
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content/storage/db"
 )
 
+// withVideoContentID ContentID видеоконтента с заполненным ID записи
+func withVideoContentID(contentID common.ContentID, id uuid.UUID) common.ContentID {
+	contentID.VideoContentID = lo.ToPtr(id)
+	return contentID
+}
+
 func (s *Storage) SaveVideoContent(ctx context.Context, videoContent *content.VideoContent) error {
 	queries := s.getQueries(ctx)
 
@@ -21,6 +27,7 @@ func (s *Storage) SaveVideoContent(ctx context.Context, videoContent *content.Vi
 		CreatedAt:      videoContent.CreatedAt,
 		DeliveryStatus: int(videoContent.DeliveryStatus),
 		States:         nil,
+		Version:        videoContent.Version,
 	}
 
 	if videoContent.ContentID.MovieID != nil {
@@ -64,10 +71,11 @@ func (s *Storage) GetVideoContents(ctx context.Context, contentID common.Content
 			}
 			results = append(results, content.VideoContent{
 				ID:             item.ID,
-				ContentID:      contentID,
+				ContentID:      withVideoContentID(contentID, item.ID),
 				CreatedAt:      item.CreatedAt,
 				DeliveryStatus: content.DeliveryStatus(item.DeliveryStatus),
 				States:         state,
+				Version:        item.Version,
 			})
 		}
 
@@ -89,10 +97,11 @@ func (s *Storage) GetVideoContents(ctx context.Context, contentID common.Content
 			}
 			results = append(results, content.VideoContent{
 				ID:             item.ID,
-				ContentID:      contentID,
+				ContentID:      withVideoContentID(contentID, item.ID),
 				CreatedAt:      item.CreatedAt,
 				DeliveryStatus: content.DeliveryStatus(item.DeliveryStatus),
 				States:         state,
+				Version:        item.Version,
 			})
 		}
 		return results, nil
@@ -101,6 +110,43 @@ func (s *Storage) GetVideoContents(ctx context.Context, contentID common.Content
 	return nil, fmt.Errorf("contentID is not valid")
 }
 
+// GetTVShowVideoContents видеоконтент всех сезонов сериала
+func (s *Storage) GetTVShowVideoContents(ctx context.Context, tvShowID uint64) ([]content.VideoContent, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetVideoContentsTVShowID(ctx, lo.ToPtr(int64(tvShowID)))
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	results := make([]content.VideoContent, 0, len(res))
+	for _, item := range res {
+		var state []content.State
+		if err = json.Unmarshal(item.States, &state); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal States: %w", err)
+		}
+		if item.SeasonNumber == nil {
+			return nil, s.base.HandleError(fmt.Errorf("invalid contentID"))
+		}
+		contentID := common.ContentID{
+			TVShow: &common.TVShowID{
+				ID:           tvShowID,
+				SeasonNumber: uint8(*item.SeasonNumber),
+			},
+		}
+		results = append(results, content.VideoContent{
+			ID:             item.ID,
+			ContentID:      withVideoContentID(contentID, item.ID),
+			CreatedAt:      item.CreatedAt,
+			DeliveryStatus: content.DeliveryStatus(item.DeliveryStatus),
+			States:         state,
+			Version:        item.Version,
+		})
+	}
+
+	return results, nil
+}
+
 func (s *Storage) UpdateVideoContent(ctx context.Context, id uuid.UUID, videoContent *content.UpdateVideoContent) error {
 	queries := s.getQueries(ctx)
 
@@ -156,10 +202,11 @@ func (s *Storage) GetVideoContentsByDeliveryStatus(ctx context.Context, statusIn
 
 		results = append(results, content.VideoContent{
 			ID:             item.ID,
-			ContentID:      contentID,
+			ContentID:      withVideoContentID(contentID, item.ID),
 			CreatedAt:      item.CreatedAt,
 			DeliveryStatus: content.DeliveryStatus(item.DeliveryStatus),
 			States:         state,
+			Version:        item.Version,
 		})
 	}
 
@@ -45,6 +45,7 @@ func equalVideoContent(t *testing.T, vc1, vc2 *content.VideoContent) {
 	require.WithinDuration(t, vc1.CreatedAt, vc2.CreatedAt, time.Second)
 	require.Equal(t, vc1.DeliveryStatus, vc2.DeliveryStatus)
 	require.Equal(t, vc1.States, vc2.States)
+	require.Equal(t, vc1.Version, vc2.Version)
 }
 
 func equalVideoContents(t *testing.T, contents1, contents2 []content.VideoContent) {
@@ -166,6 +167,37 @@ func TestStorage_SaveVideoContent(t *testing.T) {
 		require.ErrorIs(t, storagebase.ErrAlreadyExists, err)
 	})
 
+	t.Run("save video content - version already exists", func(t *testing.T) {
+		t.Parallel()
+
+		contentIDs := []common.ContentID{
+			{MovieID: lo.ToPtr(randID())},
+			{TVShow: &common.TVShowID{ID: randID(), SeasonNumber: 1}},
+		}
+		for _, contentID := range contentIDs {
+			for _, version := range []string{"", "4K"} {
+				err := testStorage.SaveVideoContent(ctx, &content.VideoContent{
+					ID:             uuid.New(),
+					ContentID:      contentID,
+					CreatedAt:      time.Now(),
+					DeliveryStatus: content.DeliveryStatusNew,
+					Version:        version,
+				})
+				require.NoError(t, err)
+			}
+
+			// Та же версия того же фильма / сезона
+			err := testStorage.SaveVideoContent(ctx, &content.VideoContent{
+				ID:             uuid.New(),
+				ContentID:      contentID,
+				CreatedAt:      time.Now(),
+				DeliveryStatus: content.DeliveryStatusNew,
+				Version:        "4K",
+			})
+			require.ErrorIs(t, err, storagebase.ErrAlreadyExists)
+		}
+	})
+
 	t.Run("save multiple video contents for same content ID", func(t *testing.T) {
 		t.Parallel()
 
@@ -190,6 +222,7 @@ func TestStorage_SaveVideoContent(t *testing.T) {
 				States: []content.State{
 					{StateID: uuid.New(), Type: runners.TVShowDelivery},
 				},
+				Version: "4K",
 			},
 		}
 
@@ -210,6 +243,10 @@ func TestStorage_SaveVideoContent(t *testing.T) {
 		}
 
 		equalVideoContents(t, expectedContents, savedContents)
+		// ContentID каждого видеоконтента указывает на его запись
+		for _, vc := range savedContents {
+			require.Equal(t, &vc.ID, vc.ContentID.VideoContentID)
+		}
 	})
 
 	t.Run("save video content - empty state", func(t *testing.T) {
@@ -448,6 +485,7 @@ func TestStorage_GetVideoContents(t *testing.T) {
 					{StateID: uuid.New(), Type: runners.TVShowDelivery},
 					{StateID: uuid.New(), Type: runners.TVShowDelivery},
 				},
+				Version: "4K",
 			},
 		}
 
@@ -499,6 +537,7 @@ func TestStorage_GetVideoContents(t *testing.T) {
 				CreatedAt:      time.Now().Add(2 * time.Hour),
 				DeliveryStatus: content.DeliveryStatusDelivered,
 				States:         []content.State{},
+				Version:        "4K",
 			},
 		}
 
@@ -670,3 +709,55 @@ func TestStorage_GetVideoContentsByDeliveryStatus(t *testing.T) {
 		require.Equal(t, len(targetContents)-1, remainingTargets)
 	})
 }
+
+func TestStorage_GetTVShowVideoContents(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	tvShowID := randID()
+	otherMovieID := randID()
+	videoContents := []content.VideoContent{
+		{
+			ID:             uuid.New(),
+			ContentID:      common.ContentID{TVShow: &common.TVShowID{ID: tvShowID, SeasonNumber: 1}},
+			CreatedAt:      time.Now(),
+			DeliveryStatus: content.DeliveryStatusDelivered,
+			States:         []content.State{{StateID: uuid.New(), Type: runners.TVShowDelivery}},
+		},
+		{
+			ID:             uuid.New(),
+			ContentID:      common.ContentID{TVShow: &common.TVShowID{ID: tvShowID, SeasonNumber: 2}},
+			CreatedAt:      time.Now(),
+			DeliveryStatus: content.DeliveryStatusInProgress,
+			States:         []content.State{{StateID: uuid.New(), Type: runners.TVShowDelivery}},
+			Version:        "4K",
+		},
+	}
+	others := []content.VideoContent{
+		{
+			ID:             uuid.New(),
+			ContentID:      common.ContentID{TVShow: &common.TVShowID{ID: tvShowID + 1, SeasonNumber: 1}},
+			CreatedAt:      time.Now(),
+			DeliveryStatus: content.DeliveryStatusDelivered,
+		},
+		{
+			ID:             uuid.New(),
+			ContentID:      common.ContentID{MovieID: &otherMovieID},
+			CreatedAt:      time.Now(),
+			DeliveryStatus: content.DeliveryStatusDelivered,
+		},
+	}
+	for _, vc := range append(videoContents, others...) {
+		require.NoError(t, testStorage.SaveVideoContent(ctx, &vc))
+	}
+
+	contents, err := testStorage.GetTVShowVideoContents(ctx, tvShowID)
+	require.NoError(t, err)
+	equalVideoContents(t, videoContents, contents)
+
+	contents, err = testStorage.GetTVShowVideoContents(ctx, randID())
+	require.NoError(t, err)
+	require.Empty(t, contents)
+}
@@ -0,0 +1,176 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowupdatestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+// deliveredSeasonData доставленный сезон с учетом завершенных обновлений раздачи
+// updateStates обновления после доставки в порядке запуска, могут быть пустыми
+func deliveredSeasonData(deliveryState *tvshowdeliverystate.State, updateStates []*tvshowupdatestate.State) (tvshowupdatestate.TVShowUpdateData, error) {
+	data := deliveryState.Data
+	if data.Torrent == nil || data.Torrent.MagnetLink == nil || data.EpisodesData == nil || data.ContentMatches == nil {
+		return tvshowupdatestate.TVShowUpdateData{}, fmt.Errorf("delivery state is not completed: %w", ucerr.InvalidArgument)
+	}
+
+	result := tvshowupdatestate.TVShowUpdateData{
+		DeliveryStateID:       deliveryState.ID,
+		Torrent:               *data.Torrent,
+		TVShowCatalogPath:     data.EpisodesData.TVShowCatalogPath,
+		DeliveredMatches:      data.ContentMatches.Matches,
+		ContentMatchesOptions: data.ContentMatches.Options,
+		TorrentFilesData:      data.TorrentFilesData,
+	}
+
+	// Обновления без новой раздачи или завершившиеся ошибкой сезон не меняют,
+	// но и не отменяют более ранние обновления, которые уже заменили раздачу
+	for _, updateState := range updateStates {
+		if updateState.Status != statemachine.CompletedStatus || !updateState.Data.TorrentChanged() {
+			continue
+		}
+		update := updateState.Data
+		result.Torrent.MagnetLink = update.NewMagnetLink
+		result.TorrentFilesData = update.TorrentFilesData
+		result.DeliveredMatches = update.DeliveredMatches
+		if update.ContentMatches != nil {
+			result.DeliveredMatches = lo.Flatten([][]tvshowdelivery.ContentMatch{update.DeliveredMatches, update.ContentMatches.Matches})
+		}
+	}
+	return result, nil
+}
+
+// getDeliveredSeason доставленный сезон сериала с учетом обновлений раздачи
+func (s *Service) getDeliveredSeason(ctx context.Context, content VideoContent) (tvshowupdatestate.TVShowUpdateData, error) {
+	deliveryStateID := getLastState(content, runners.TVShowDelivery)
+	if deliveryStateID == nil {
+		return tvshowupdatestate.TVShowUpdateData{}, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	}
+	deliveryState, err := s.tvShowDeliveryState.GetStateByID(ctx, *deliveryStateID)
+	if err != nil {
+		return tvshowupdatestate.TVShowUpdateData{}, fmt.Errorf("tvShowDeliveryState.GetStateByID: %w", err)
+	}
+	if deliveryState == nil {
+		return tvshowupdatestate.TVShowUpdateData{}, fmt.Errorf("TVShowDelivery: %w", ucerr.NotFound)
+	}
+
+	states := lo.Filter(content.States, func(item State, _ int) bool {
+		return item.Type == runners.TVShowUpdate
+	})
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].CreatedAt.Before(states[j].CreatedAt)
+	})
+
+	updateStates := make([]*tvshowupdatestate.State, 0, len(states))
+	for _, state := range states {
+		updateState, err := s.tvShowUpdateState.GetStateByID(ctx, state.StateID)
+		if err != nil {
+			return tvshowupdatestate.TVShowUpdateData{}, fmt.Errorf("tvShowUpdateState.GetStateByID: %w", err)
+		}
+		// Обновления, запущенные до последней доставки, относятся к уже удаленным файлам
+		if updateState == nil || updateState.CreatedAt.Before(deliveryState.CreatedAt) {
+			continue
+		}
+		updateStates = append(updateStates, updateState)
+	}
+
+	return deliveredSeasonData(deliveryState, updateStates)
+}
+
+// CreateUpdateState запуск обновления раздачи доставленного сезона сериала
+// Если раздача перезалита с новыми эпизодами, они докачиваются и добавляются в каталог сезона
+func (s *Service) CreateUpdateState(ctx context.Context, params UpdateDeliveryParams) (*tvshowupdatestate.State, error) {
+	if err := params.ContentID.Validate(); err != nil {
+		return nil, err
+	}
+	// Обновление раздач фильмов не поддерживается
+	if params.ContentID.TVShow == nil {
+		return nil, fmt.Errorf("tvShow is required: %w", ucerr.InvalidArgument)
+	}
+
+	content, err := s.getVideoContent(ctx, params.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	switch content.DeliveryStatus {
+	case DeliveryStatusDelivered:
+	default:
+		return nil, fmt.Errorf("video content is in invalid status: %w", ucerr.InvalidArgument)
+	}
+
+	season, err := s.getDeliveredSeason(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("getDeliveredSeason: %w", err)
+	}
+	// Раздачу-пак не удаляем из торрент клиента, пока ее используют другие сезоны
+	season.KeepOldTorrent, err = s.torrentUsedByOtherContents(ctx, content, season.Torrent.MagnetLink.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("torrentUsedByOtherContents: %w", err)
+	}
+
+	options := tvshowupdatestate.CreateOptions{
+		Index:          len(content.States),
+		VideoContentID: content.ID,
+		Version:        content.Version,
+		TVShowID:       *params.ContentID.TVShow,
+		Data:           season,
+	}
+
+	var result *tvshowupdatestate.State
+	//  TODO: одна транзакция
+	{
+		result, err = s.tvShowUpdateState.Create(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("tvShowUpdateState.Create: %w", err)
+		}
+
+		updateVideoContent := UpdateVideoContent{
+			DeliveryStatus: DeliveryStatusUpdating,
+			States: append(content.States, State{
+				StateID:   result.ID,
+				CreatedAt: result.CreatedAt,
+				Type:      runners.TVShowUpdate,
+			}),
+		}
+
+		if err = s.storage.UpdateVideoContent(ctx, content.ID, &updateVideoContent); err != nil {
+			return nil, fmt.Errorf("storage.UpdateVideoContent: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetUpdateData(ctx context.Context, contentID common.ContentID) (*tvshowupdatestate.State, error) {
+	if err := contentID.Validate(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.getVideoContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("getVideoContent: %w", err)
+	}
+
+	stateID := getLastState(content, runners.TVShowUpdate)
+	if stateID == nil {
+		return nil, fmt.Errorf("TVShowUpdate: %w", ucerr.NotFound)
+	}
+
+	result, err := s.tvShowUpdateState.GetStateByID(ctx, *stateID)
+	if err != nil {
+		return nil, fmt.Errorf("s.GetStateByID: %w", err)
+	}
+
+	return result, nil
+}
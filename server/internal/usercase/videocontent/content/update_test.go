@@ -0,0 +1,224 @@
+package content
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/statemachine"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowupdatestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+func TestDeliveredSeasonData(t *testing.T) {
+	stateID := uuid.New()
+	episode := func(number int) tvshowdelivery.ContentMatch {
+		return tvshowdelivery.ContentMatch{
+			Episode: tvshowdelivery.EpisodeInfo{SeasonNumber: 1, EpisodeNumber: number},
+		}
+	}
+	catalogPath := tvshowdelivery.TVShowCatalogPath{
+		TVShowPath: "/nfs/tvshows/Пингвин (2024)",
+		SeasonPath: "Season 1",
+	}
+	deliveryState := &tvshowdeliverystate.State{
+		ID:        stateID,
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		Data: tvshowdeliverystate.TVShowDeliveryData{
+			Torrent: &tvshowdelivery.Torrent{
+				Href:       "https://rutracker.org/forum/viewtopic.php?t=1",
+				MagnetLink: &tvshowdelivery.MagnetLink{Hash: "old"},
+			},
+			TorrentFilesData: &tvshowdelivery.TorrentFilesData{ContentFullPath: "/nfs/downloads/Penguin.S01"},
+			EpisodesData:     &tvshowdelivery.EpisodesData{TVShowCatalogPath: catalogPath},
+			ContentMatches: &tvshowdelivery.ContentMatches{
+				Matches: []tvshowdelivery.ContentMatch{episode(1), episode(2)},
+			},
+		},
+	}
+
+	t.Run("delivery is not completed", func(t *testing.T) {
+		_, err := deliveredSeasonData(&tvshowdeliverystate.State{}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("season was not updated", func(t *testing.T) {
+		got, err := deliveredSeasonData(deliveryState, nil)
+		require.NoError(t, err)
+		require.Equal(t, stateID, got.DeliveryStateID)
+		require.Equal(t, "old", got.Torrent.MagnetLink.Hash)
+		require.Equal(t, catalogPath, got.TVShowCatalogPath)
+		require.Len(t, got.DeliveredMatches, 2)
+	})
+
+	t.Run("update without new torrent", func(t *testing.T) {
+		got, err := deliveredSeasonData(deliveryState, []*tvshowupdatestate.State{{
+			Status: statemachine.CompletedStatus,
+			Data: tvshowupdatestate.TVShowUpdateData{
+				Torrent:       *deliveryState.Data.Torrent,
+				NewMagnetLink: &tvshowdelivery.MagnetLink{Hash: "old"},
+			},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, "old", got.Torrent.MagnetLink.Hash)
+	})
+
+	t.Run("update in progress", func(t *testing.T) {
+		got, err := deliveredSeasonData(deliveryState, []*tvshowupdatestate.State{{
+			Status: statemachine.InProgressStatus,
+			Data: tvshowupdatestate.TVShowUpdateData{
+				Torrent:       *deliveryState.Data.Torrent,
+				NewMagnetLink: &tvshowdelivery.MagnetLink{Hash: "new"},
+			},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, "old", got.Torrent.MagnetLink.Hash)
+	})
+
+	t.Run("update with new episodes", func(t *testing.T) {
+		delivered := []tvshowdelivery.ContentMatch{episode(1), episode(2)}
+		got, err := deliveredSeasonData(deliveryState, []*tvshowupdatestate.State{{
+			Status: statemachine.CompletedStatus,
+			Data: tvshowupdatestate.TVShowUpdateData{
+				Torrent:          *deliveryState.Data.Torrent,
+				NewMagnetLink:    &tvshowdelivery.MagnetLink{Hash: "new"},
+				TorrentFilesData: &tvshowdelivery.TorrentFilesData{ContentFullPath: "/nfs/downloads/Penguin.S01.v2"},
+				DeliveredMatches: delivered,
+				ContentMatches: &tvshowdelivery.ContentMatches{
+					Matches: []tvshowdelivery.ContentMatch{episode(3)},
+				},
+			},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, "new", got.Torrent.MagnetLink.Hash)
+		require.Equal(t, "/nfs/downloads/Penguin.S01.v2", got.TorrentFilesData.ContentFullPath)
+		require.Equal(t, []tvshowdelivery.ContentMatch{episode(1), episode(2), episode(3)}, got.DeliveredMatches)
+		// Данные обновления не должны меняться
+		require.Len(t, delivered, 2)
+		// Данные доставки не должны меняться
+		require.Equal(t, "old", deliveryState.Data.Torrent.MagnetLink.Hash)
+	})
+
+	t.Run("update without new torrent after update with new episodes", func(t *testing.T) {
+		changed := &tvshowupdatestate.State{
+			Status: statemachine.CompletedStatus,
+			Data: tvshowupdatestate.TVShowUpdateData{
+				Torrent:          *deliveryState.Data.Torrent,
+				NewMagnetLink:    &tvshowdelivery.MagnetLink{Hash: "new"},
+				TorrentFilesData: &tvshowdelivery.TorrentFilesData{ContentFullPath: "/nfs/downloads/Penguin.S01.v2"},
+				DeliveredMatches: []tvshowdelivery.ContentMatch{episode(1), episode(2)},
+				ContentMatches: &tvshowdelivery.ContentMatches{
+					Matches: []tvshowdelivery.ContentMatch{episode(3)},
+				},
+			},
+		}
+		// Ручное обновление, не нашедшее новой раздачи
+		noop := &tvshowupdatestate.State{
+			Status: statemachine.CompletedStatus,
+			Data: tvshowupdatestate.TVShowUpdateData{
+				Torrent:       tvshowdelivery.Torrent{MagnetLink: &tvshowdelivery.MagnetLink{Hash: "new"}},
+				NewMagnetLink: &tvshowdelivery.MagnetLink{Hash: "new"},
+			},
+		}
+		failed := &tvshowupdatestate.State{
+			Status: statemachine.FailedStatus,
+			Data: tvshowupdatestate.TVShowUpdateData{
+				Torrent:       tvshowdelivery.Torrent{MagnetLink: &tvshowdelivery.MagnetLink{Hash: "new"}},
+				NewMagnetLink: &tvshowdelivery.MagnetLink{Hash: "newer"},
+			},
+		}
+
+		// Следующее обновление строится от сезона, уже обновленного первым обновлением
+		got, err := deliveredSeasonData(deliveryState, []*tvshowupdatestate.State{changed, noop, failed})
+		require.NoError(t, err)
+		require.Equal(t, "new", got.Torrent.MagnetLink.Hash)
+		require.Equal(t, "/nfs/downloads/Penguin.S01.v2", got.TorrentFilesData.ContentFullPath)
+		require.Equal(t, []tvshowdelivery.ContentMatch{episode(1), episode(2), episode(3)}, got.DeliveredMatches)
+	})
+}
+
+func TestUpdateRunnerToDeliveryStatus(t *testing.T) {
+	runner := updateRunner{}
+	require.Equal(t, DeliveryStatusDelivered, runner.ToDeliveryStatus(statemachine.CompletedStatus))
+	// Сезон остается доставленным, CancelDelivery для него недоступен
+	require.Equal(t, DeliveryStatusDelivered, runner.ToDeliveryStatus(statemachine.FailedStatus))
+	require.Equal(t, DeliveryStatusUpdating, runner.ToDeliveryStatus(statemachine.InProgressStatus))
+}
+
+type fakeTVShowUpdateState struct {
+	TVShowUpdateState
+	created []tvshowupdatestate.CreateOptions
+}
+
+func (f *fakeTVShowUpdateState) Create(_ context.Context, opt tvshowupdatestate.CreateOptions) (*tvshowupdatestate.State, error) {
+	f.created = append(f.created, opt)
+	return &tvshowupdatestate.State{ID: uuid.New(), Data: opt.Data}, nil
+}
+
+func TestCreateUpdateStateKeepOldTorrent(t *testing.T) {
+	season := func(number uint8) common.ContentID {
+		return common.ContentID{TVShow: &common.TVShowID{ID: 70523, SeasonNumber: number}}
+	}
+	deliveredState := func(hash string) *tvshowdeliverystate.State {
+		return &tvshowdeliverystate.State{
+			ID:     uuid.New(),
+			Status: statemachine.CompletedStatus,
+			Data: tvshowdeliverystate.TVShowDeliveryData{
+				Torrent: &tvshowdelivery.Torrent{
+					Href:       "https://rutracker.org/forum/viewtopic.php?t=1",
+					MagnetLink: &tvshowdelivery.MagnetLink{Hash: hash},
+				},
+				TorrentFilesData: &tvshowdelivery.TorrentFilesData{},
+				EpisodesData:     &tvshowdelivery.EpisodesData{},
+				ContentMatches:   &tvshowdelivery.ContentMatches{},
+			},
+		}
+	}
+	newService := func(secondSeasonHash string) (*Service, *fakeTVShowUpdateState) {
+		first, second := deliveredState("pack"), deliveredState(secondSeasonHash)
+		updateState := &fakeTVShowUpdateState{}
+		return &Service{
+			storage: &fakeVideoContentStorage{items: []VideoContent{
+				{
+					ID:             uuid.New(),
+					ContentID:      season(1),
+					DeliveryStatus: DeliveryStatusDelivered,
+					States:         []State{{StateID: first.ID, Type: runners.TVShowDelivery}},
+				},
+				{
+					ID:             uuid.New(),
+					ContentID:      season(2),
+					DeliveryStatus: DeliveryStatusDelivered,
+					States:         []State{{StateID: second.ID, Type: runners.TVShowDelivery}},
+				},
+			}},
+			tvShowDeliveryState: &fakeTVShowDeliveryState{states: map[uuid.UUID]*tvshowdeliverystate.State{
+				first.ID:  first,
+				second.ID: second,
+			}},
+			tvShowUpdateState: updateState,
+		}, updateState
+	}
+
+	t.Run("pack is used by other season", func(t *testing.T) {
+		s, updateState := newService("pack")
+		_, err := s.CreateUpdateState(context.Background(), UpdateDeliveryParams{ContentID: season(1)})
+		require.NoError(t, err)
+		require.Len(t, updateState.created, 1)
+		require.True(t, updateState.created[0].Data.KeepOldTorrent)
+	})
+
+	t.Run("torrent is used only by this season", func(t *testing.T) {
+		s, updateState := newService("other")
+		_, err := s.CreateUpdateState(context.Background(), UpdateDeliveryParams{ContentID: season(1)})
+		require.NoError(t, err)
+		require.Len(t, updateState.created, 1)
+		require.False(t, updateState.created[0].Data.KeepOldTorrent)
+	})
+}
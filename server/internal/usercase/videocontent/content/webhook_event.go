@@ -0,0 +1,167 @@
+package content
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowupdatestate"
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
+)
+
+// waitingUserSteps шаги, на которых стейт ждет действий пользователя
+var waitingUserSteps = map[runners.Type][]string{
+	runners.TVShowDelivery: {
+		string(tvshowdeliverystate.WaitingUserChoseTorrent),
+		string(tvshowdeliverystate.WaitingChoseFileMatches),
+	},
+	runners.MovieDelivery: {
+		string(moviedeliverystate.WaitingUserChoseTorrent),
+	},
+}
+
+// downloadedSteps шаги, которые выполняются уже после скачивания раздачи
+var downloadedSteps = map[runners.Type][]string{
+	runners.TVShowDelivery: {
+		string(tvshowdeliverystate.ProbeMediaFiles),
+		string(tvshowdeliverystate.CreateVideoContentCatalogs),
+		string(tvshowdeliverystate.DeterminingNeedConvertFiles),
+		string(tvshowdeliverystate.StartMergeVideoFiles),
+		string(tvshowdeliverystate.WaitingMergeVideoFiles),
+		string(tvshowdeliverystate.CreateHardLinkCopy),
+		string(tvshowdeliverystate.GetCatalogsSize),
+		string(tvshowdeliverystate.SetMediaMetaData),
+		string(tvshowdeliverystate.AddLabel),
+		string(tvshowdeliverystate.SendDeliveryNotification),
+	},
+	runners.MovieDelivery: {
+		string(moviedeliverystate.CreateVideoContentCatalogs),
+		string(moviedeliverystate.DeterminingNeedConvertFiles),
+		string(moviedeliverystate.StartMergeVideoFiles),
+		string(moviedeliverystate.WaitingMergeVideoFiles),
+		string(moviedeliverystate.CreateHardLinkCopy),
+		string(moviedeliverystate.GetCatalogsSize),
+		string(moviedeliverystate.SetMediaMetaData),
+		string(moviedeliverystate.AddLabel),
+	},
+	runners.TVShowUpdate: {
+		string(tvshowupdatestate.ProbeMediaFiles),
+		string(tvshowupdatestate.DeterminingNeedConvertFiles),
+		string(tvshowupdatestate.StartMergeVideoFiles),
+		string(tvshowupdatestate.WaitingMergeVideoFiles),
+		string(tvshowupdatestate.CreateHardLinkCopy),
+		string(tvshowupdatestate.SetMediaMetaData),
+	},
+}
+
+// mergeSteps шаги сшивания видеофайлов
+var mergeSteps = map[runners.Type][]string{
+	runners.TVShowDelivery: {
+		string(tvshowdeliverystate.StartMergeVideoFiles),
+		string(tvshowdeliverystate.WaitingMergeVideoFiles),
+	},
+	runners.MovieDelivery: {
+		string(moviedeliverystate.StartMergeVideoFiles),
+		string(moviedeliverystate.WaitingMergeVideoFiles),
+	},
+	runners.TVShowUpdate: {
+		string(tvshowupdatestate.StartMergeVideoFiles),
+		string(tvshowupdatestate.WaitingMergeVideoFiles),
+	},
+}
+
+func isDeleteRunner(runnerType runners.Type) bool {
+	return runnerType == runners.TVShowDelete || runnerType == runners.MovieDelete
+}
+
+// stateEventType тип события вебхука при переходе стейта из prev в next
+// false - стейт не изменился и событие отправлять не нужно
+func stateEventType(runnerType runners.Type, prev, next state, executeErr error) (webhooks.EventType, bool) {
+	if executeErr != nil {
+		// Шаг с ошибкой повторяется при каждом добивании, сообщаем только о первой ошибке
+		if prev.err != nil && prev.step == next.step {
+			return "", false
+		}
+		if lo.Contains(mergeSteps[runnerType], next.step) {
+			return webhooks.EventMergeFailed, true
+		}
+		return webhooks.EventStepError, true
+	}
+	if prev.status == next.status && prev.step == next.step {
+		return "", false
+	}
+
+	switch {
+	case next.status == statemachine.CompletedStatus:
+		if isDeleteRunner(runnerType) {
+			return webhooks.EventDeleted, true
+		}
+		if runnerType == runners.TVShowCancel {
+			return webhooks.EventCanceled, true
+		}
+		return webhooks.EventDelivered, true
+	case next.status == statemachine.FailedStatus:
+		return webhooks.EventFailed, true
+	case lo.Contains(waitingUserSteps[runnerType], next.step):
+		return webhooks.EventWaitingUserInput, true
+	case lo.Contains(downloadedSteps[runnerType], next.step) && !lo.Contains(downloadedSteps[runnerType], prev.step):
+		return webhooks.EventDownloadComplete, true
+	}
+	return webhooks.EventStepChanged, true
+}
+
+func stateStatusName(status statemachine.Status) string {
+	switch status {
+	case statemachine.NewStatus:
+		return "new"
+	case statemachine.InProgressStatus:
+		return "in_progress"
+	case statemachine.CompletedStatus:
+		return "completed"
+	case statemachine.FailedStatus:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// publishStateEvent ставит в очередь событие изменения стейта
+// Ошибка публикации только логируется, что бы не мешать добиванию стейтов
+func (s *Service) publishStateEvent(ctx context.Context,
+	contentID common.ContentID,
+	stateID uuid.UUID,
+	runnerType runners.Type,
+	prev, next state,
+	executeErr error,
+) {
+	eventType, ok := stateEventType(runnerType, prev, next, executeErr)
+	if !ok {
+		return
+	}
+
+	event := webhooks.Event{
+		Type:         eventType,
+		StateID:      stateID,
+		StateType:    string(runnerType),
+		MovieID:      contentID.MovieID,
+		PreviousStep: prev.step,
+		Step:         next.step,
+		Status:       stateStatusName(next.status),
+	}
+	if contentID.TVShow != nil {
+		event.TVShowID = lo.ToPtr(contentID.TVShow.ID)
+		event.SeasonNumber = lo.ToPtr(contentID.TVShow.SeasonNumber)
+	}
+	if executeErr != nil {
+		event.Error = lo.ToPtr(executeErr.Error())
+	}
+
+	if err := s.webhooks.Publish(ctx, event); err != nil {
+		s.logger.Warnf("webhooks.Publish: %v", err)
+	}
+}
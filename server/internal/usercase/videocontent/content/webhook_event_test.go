@@ -0,0 +1,124 @@
+package content
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
+)
+
+func TestStateEventType(t *testing.T) {
+	inProgress := func(step string) state {
+		return state{status: statemachine.InProgressStatus, step: step}
+	}
+
+	tests := []struct {
+		name       string
+		runnerType runners.Type
+		prev       state
+		next       state
+		executeErr error
+		want       webhooks.EventType
+		wantOk     bool
+	}{
+		{
+			name:       "no changes",
+			runnerType: runners.TVShowDelivery,
+			prev:       inProgress("waiting_torrent_download_complete"),
+			next:       inProgress("waiting_torrent_download_complete"),
+		},
+		{
+			name:       "step changed",
+			runnerType: runners.TVShowDelivery,
+			prev:       inProgress("generate_search_query"),
+			next:       inProgress("get_magnet_link"),
+			want:       webhooks.EventStepChanged,
+			wantOk:     true,
+		},
+		{
+			name:       "waiting user",
+			runnerType: runners.TVShowDelivery,
+			prev:       inProgress("generate_search_query"),
+			next:       inProgress("waiting_user_chose_torrent"),
+			want:       webhooks.EventWaitingUserInput,
+			wantOk:     true,
+		},
+		{
+			name:       "download complete",
+			runnerType: runners.MovieDelivery,
+			prev:       inProgress("waiting_torrent_download_complete"),
+			next:       inProgress("waiting_merge_video_files"),
+			want:       webhooks.EventDownloadComplete,
+			wantOk:     true,
+		},
+		{
+			name:       "step after download",
+			runnerType: runners.TVShowDelivery,
+			prev:       inProgress("waiting_merge_video_files"),
+			next:       inProgress("set_media_meta_data"),
+			want:       webhooks.EventStepChanged,
+			wantOk:     true,
+		},
+		{
+			name:       "merge failed",
+			runnerType: runners.TVShowDelivery,
+			prev:       inProgress("waiting_merge_video_files"),
+			next:       state{status: statemachine.InProgressStatus, step: "waiting_merge_video_files", err: lo.ToPtr("merge videos contains errors")},
+			executeErr: errors.New("merge videos contains errors"),
+			want:       webhooks.EventMergeFailed,
+			wantOk:     true,
+		},
+		{
+			name:       "repeated error",
+			runnerType: runners.TVShowDelivery,
+			prev:       state{status: statemachine.InProgressStatus, step: "waiting_merge_video_files", err: lo.ToPtr("merge videos contains errors")},
+			next:       state{status: statemachine.InProgressStatus, step: "waiting_merge_video_files", err: lo.ToPtr("merge videos contains errors")},
+			executeErr: errors.New("merge videos contains errors"),
+		},
+		{
+			name:       "delivered",
+			runnerType: runners.TVShowDelivery,
+			prev:       inProgress("send_delivery_notification"),
+			next:       state{status: statemachine.CompletedStatus, step: "send_delivery_notification"},
+			want:       webhooks.EventDelivered,
+			wantOk:     true,
+		},
+		{
+			name:       "deleted",
+			runnerType: runners.MovieDelete,
+			prev:       inProgress("delete_label"),
+			next:       state{status: statemachine.CompletedStatus, step: "delete_label"},
+			want:       webhooks.EventDeleted,
+			wantOk:     true,
+		},
+		{
+			name:       "canceled",
+			runnerType: runners.TVShowCancel,
+			prev:       inProgress("delete_label"),
+			next:       state{status: statemachine.CompletedStatus},
+			want:       webhooks.EventCanceled,
+			wantOk:     true,
+		},
+		{
+			name:       "failed",
+			runnerType: runners.TVShowDelete,
+			prev:       inProgress("delete_torrent_files"),
+			next:       state{status: statemachine.FailedStatus},
+			want:       webhooks.EventFailed,
+			wantOk:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := stateEventType(tt.runnerType, tt.prev, tt.next, tt.executeErr)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
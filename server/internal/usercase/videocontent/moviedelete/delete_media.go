@@ -0,0 +1,75 @@
+package moviedelete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+// DeleteMovieFromMediaServer проверка что медиасервер больше не видит фильм
+func (s *Service) DeleteMovieFromMediaServer(ctx context.Context, moviePath MovieCatalogPath) error {
+	path, err := filepath.Rel(s.config.BasePath, moviePath.MoviePath)
+	if err != nil {
+		return fmt.Errorf("failed to get relative path: %w", err)
+	}
+
+	// Каталог фильма удален, поэтому сканируем родительский каталог
+	if err = s.embyApi.ScanPath(filepath.Dir("/" + path)); err != nil {
+		return fmt.Errorf("failed to scan path: %w", err)
+	}
+
+	info, err := s.embyApi.GetCatalogInfo("/" + path)
+	if err != nil {
+		if errors.Is(err, apierr.ContentNotFound) {
+			return nil
+		}
+		return fmt.Errorf("embyApi.GetCatalogInfo: %w", err)
+	}
+
+	if info == nil {
+		return nil
+	}
+
+	return fmt.Errorf("movie is not deleted")
+}
+
+// DeleteMovieFiles удаление каталога фильма с медиасервера
+func (s *Service) DeleteMovieFiles(ctx context.Context, moviePath MovieCatalogPath) error {
+	// Проверяем, существует ли путь
+	if _, err := os.Stat(moviePath.MoviePath); os.IsNotExist(err) {
+		return fmt.Errorf("not found path")
+	}
+
+	// Удаляем папку фильма со всем содержимым
+	err := os.RemoveAll(moviePath.MoviePath)
+	if err != nil {
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteMovieVersionFiles удаление файлов одной версии фильма
+// Каталог фильма общий с другими версиями и остается на месте
+func (s *Service) DeleteMovieVersionFiles(ctx context.Context, moviePath MovieCatalogPath) error {
+	entries, err := os.ReadDir(moviePath.MoviePath)
+	if err != nil {
+		return fmt.Errorf("failed to read folder: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.Name() != moviePath.FileName && !strings.HasPrefix(entry.Name(), moviePath.FileName+".") {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(moviePath.MoviePath, entry.Name())); err != nil {
+			return fmt.Errorf("failed to delete file: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package moviedelete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/labels"
+)
+
+func (s *Service) DeleteLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error {
+	err := s.labels.DeleteLabel(ctx, contentID, labels.HasVideoContentFiles)
+	if err != nil {
+		return fmt.Errorf("labels.DeleteLabel: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package moviedelete
+
+// MovieCatalogPath путь каталога и файла фильма на медиа сервере
+type MovieCatalogPath struct {
+	// Путь до каталога фильма
+	MoviePath string
+	// Имя файла фильма без расширения (относительно каталога фильма)
+	FileName string
+}
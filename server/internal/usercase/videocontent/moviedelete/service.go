@@ -0,0 +1,28 @@
+package moviedelete
+
+type Config struct {
+	// BasePath Базовый путь от которого расположены все файлы торрента или медиа сервера
+	// Например скачанные фильмы лежат по пути BasePath + MovieTorrentSavePath
+	BasePath string // "/nfs"
+}
+
+type Service struct {
+	config        Config
+	torrentClient TorrentClient
+	embyApi       EmbyApi
+	labels        Labels
+}
+
+func NewService(
+	config Config,
+	torrentClient TorrentClient,
+	embyApi EmbyApi,
+	labelsService Labels,
+) *Service {
+	return &Service{
+		config:        config,
+		torrentClient: torrentClient,
+		embyApi:       embyApi,
+		labels:        labelsService,
+	}
+}
@@ -0,0 +1,31 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+type AddTorrentParams struct {
+	MovieID uint64
+	Magnet  string
+}
+
+// AddTorrentToTorrentClient добавление торрент раздачи в торрент клиент
+func (s *Service) AddTorrentToTorrentClient(_ context.Context, params AddTorrentParams) error {
+	err := s.torrentClient.AddTorrent(qbittorrent.TorrentAddOptions{
+		Magnet:   params.Magnet,
+		SavePath: s.config.MovieTorrentSavePath,
+		Category: "movie",
+		Tags: []string{
+			fmt.Sprintf("movieID:%d", params.MovieID),
+		},
+		Paused: false,
+	})
+	if err != nil {
+		return fmt.Errorf("torrentClient.AddTorrent: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+type CreateHardLinkCopyParams struct {
+	MovieMatch *MovieMatch
+}
+
+// CreateHardLinkCopyToMediaServer шаг копирования файла фильма на медиа сервер
+func (s *Service) CreateHardLinkCopyToMediaServer(_ context.Context, params CreateHardLinkCopyParams) error {
+	from := params.MovieMatch.Video.File.FullPath
+	to := params.MovieMatch.MovieFile.FullPath
+	if from == "" || to == "" {
+		return fmt.Errorf("movie file path is empty")
+	}
+	// Создание hardlink ссылки (to) на файл from
+	if err := os.Link(from, to); err != nil {
+		return fmt.Errorf("failed to create hard link from %s to %s: %w", from, to, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/samber/lo"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+)
+
+type CreateContentCatalogsParams struct {
+	MovieCatalogPath MovieCatalogPath
+}
+
+func (s *Service) createDirectories(moviePath string) error {
+	// Проверяем, что catalog действительно является подкаталогом base
+	relPath, err := filepath.Rel(s.config.BasePath, moviePath)
+	if err != nil {
+		return fmt.Errorf("catalog is not a subdirectory of base: %v", err)
+	}
+
+	// Разбиваем относительный путь на компоненты
+	parts := strings.Split(relPath, string(filepath.Separator))
+
+	// Постепенно создаём каталоги
+	currentPath := s.config.BasePath
+	for _, part := range parts {
+		currentPath = filepath.Join(currentPath, part)
+		if _, err = os.Stat(currentPath); os.IsNotExist(err) {
+			if mkdirErr := syscall.Mkdir(currentPath, 0775); mkdirErr != nil {
+				return fmt.Errorf("syscall.Mkdir: %w", mkdirErr)
+			}
+		} else if err != nil {
+			return fmt.Errorf("error checking directory %s: %v", currentPath, err)
+		}
+	}
+
+	return nil
+}
+
+// hasFile в каталоге есть файл с указанным именем (без учета расширения)
+func hasFile(dirPath string, name string) (bool, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return false, err
+	}
+	return lo.ContainsBy(entries, func(entry os.DirEntry) bool {
+		return entry.Name() == name || strings.HasPrefix(entry.Name(), name+".")
+	}), nil
+}
+
+// CreateContentCatalogs формирование каталога куда будет сохранен фильм
+func (s *Service) CreateContentCatalogs(_ context.Context, params CreateContentCatalogsParams) error {
+	moviePath := params.MovieCatalogPath.MoviePath
+
+	if createErr := s.createDirectories(moviePath); createErr != nil {
+		return fmt.Errorf("createDirectories: %w", createErr)
+	}
+
+	// Каталог фильма общий для всех версий и может быть не пустым
+	// Если в нем уже есть файл этой версии, то выдаем ошибку, что бы пользователь сам устранил ошибку
+	if ok, err := hasFile(moviePath, params.MovieCatalogPath.FileName); err != nil {
+		return fmt.Errorf("hasFile: %w", err)
+	} else if ok {
+		return fmt.Errorf("movie file already exists: %w", ucerr.AlreadyExists)
+	}
+
+	return nil
+}
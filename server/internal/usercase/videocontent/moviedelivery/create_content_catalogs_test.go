@@ -0,0 +1,39 @@
+package moviedelivery
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+)
+
+func TestCreateContentCatalogs(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	s := &Service{config: Config{BasePath: base}}
+	moviePath := filepath.Join(base, "movies", "Начало (2010)")
+
+	// Каталог фильма создается
+	err := s.CreateContentCatalogs(ctx, CreateContentCatalogsParams{
+		MovieCatalogPath: MovieCatalogPath{MoviePath: moviePath, FileName: "Начало (2010)"},
+	})
+	require.NoError(t, err)
+	require.DirExists(t, moviePath)
+	require.NoError(t, os.WriteFile(filepath.Join(moviePath, "Начало (2010).mkv"), []byte("x"), 0o644))
+
+	// Другая версия в общем каталоге
+	err = s.CreateContentCatalogs(ctx, CreateContentCatalogsParams{
+		MovieCatalogPath: MovieCatalogPath{MoviePath: moviePath, FileName: "Начало (2010) - 4K"},
+	})
+	require.NoError(t, err)
+
+	// Файл этой версии уже есть
+	err = s.CreateContentCatalogs(ctx, CreateContentCatalogsParams{
+		MovieCatalogPath: MovieCatalogPath{MoviePath: moviePath, FileName: "Начало (2010)"},
+	})
+	require.ErrorIs(t, err, ucerr.AlreadyExists)
+}
@@ -0,0 +1,51 @@
+package moviedelivery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+	"github.com/kkiling/media-delivery/internal/adapter/matchtvshow"
+	"github.com/kkiling/media-delivery/internal/adapter/mkvmerge"
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+	"github.com/kkiling/media-delivery/internal/adapter/trackers"
+	"github.com/kkiling/media-delivery/internal/usercase/labels"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+)
+
+type MovieLibrary interface {
+	GetMovieInfo(ctx context.Context, params movielibrary.GetMovieParams) (*movielibrary.GetMovieResult, error)
+}
+
+type TorrentSite interface {
+	SearchTorrents(query string) (*trackers.TorrentResponse, error)
+	GetMagnetLink(torrentUrl string) (*trackers.MagnetInfo, error)
+}
+
+type TorrentClient interface {
+	AddTorrent(opts qbittorrent.TorrentAddOptions) error
+	GetTorrentInfo(hash string) (*qbittorrent.TorrentInfo, error)
+	GetTorrentFiles(hash string) ([]qbittorrent.TorrentFile, error)
+	ResumeTorrent(hash string) error
+}
+
+type PrepareMovie interface {
+	MatchMovieFiles(torrentFiles []string) (*matchtvshow.MovieFiles, error)
+}
+
+type MkvMergePipeline interface {
+	AddToMerge(ctx context.Context, idempotencyKey string, params mkvmerge.MergeParams) (*mkvmerge.MergeResult, error)
+	GetMergeResult(ctx context.Context, id uuid.UUID) (*mkvmerge.MergeResult, error)
+}
+
+type EmbyApi interface {
+	ScanPath(path string) error
+	ResetMetadata(embyID uint64) error
+	RemoteSearchApply(embyID, theMovieDBID uint64) error
+	GetCatalogInfo(path string) (*emby.CatalogInfo, error)
+}
+
+type Labels interface {
+	AddLabel(ctx context.Context, label labels.Label) error
+}
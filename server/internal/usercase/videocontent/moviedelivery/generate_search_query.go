@@ -0,0 +1,42 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+)
+
+type GenerateSearchQueryParams struct {
+	MovieID uint64
+}
+
+// GenerateSearchQuery формируем поисковый запрос к торент трекеру на основе названия и года выхода фильма
+func (s *Service) GenerateSearchQuery(ctx context.Context, params GenerateSearchQueryParams) (*SearchQuery, error) {
+	movieInfo, err := s.movieLibrary.GetMovieInfo(ctx, movielibrary.GetMovieParams{
+		MovieID: params.MovieID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("movieLibrary.GetMovieInfo: %w", err)
+	}
+	if movieInfo == nil || movieInfo.Result == nil {
+		return nil, fmt.Errorf("movieInfo not found: %w", ucerr.NotFound)
+	}
+
+	movie := movieInfo.Result
+	year := movie.ReleaseDate.Year()
+	searchQuery := fmt.Sprintf("%s %d", movie.Title, year)
+
+	optionalQuery := []string{searchQuery}
+	if movie.OriginalTitle != "" && movie.OriginalTitle != movie.Title {
+		optionalQuery = append(optionalQuery, fmt.Sprintf("%s %d", movie.OriginalTitle, year))
+	}
+
+	return &SearchQuery{
+		Query:         searchQuery,
+		OptionalQuery: lo.Uniq(optionalQuery),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package moviedelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSearchQuery(t *testing.T) {
+	s := &Service{
+		movieLibrary: fakeMovieLibrary{
+			27205: newTestMovie(27205, "Начало", "Inception", 2010),
+			238:   newTestMovie(238, "The Godfather", "The Godfather", 1972),
+		},
+	}
+	ctx := context.Background()
+
+	res, err := s.GenerateSearchQuery(ctx, GenerateSearchQueryParams{MovieID: 27205})
+	require.NoError(t, err)
+	require.Equal(t, "Начало 2010", res.Query)
+	require.Equal(t, []string{"Начало 2010", "Inception 2010"}, res.OptionalQuery)
+
+	// Оригинальное название совпадает с локализованным
+	res, err = s.GenerateSearchQuery(ctx, GenerateSearchQueryParams{MovieID: 238})
+	require.NoError(t, err)
+	require.Equal(t, []string{"The Godfather 1972"}, res.OptionalQuery)
+}
@@ -0,0 +1,27 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	getFolderSize "github.com/markthree/go-get-folder-size/src"
+)
+
+// GetCatalogSize получение размера каталога (или файла) на диске в байтах
+func (s *Service) GetCatalogSize(_ context.Context, catalogPath string) (uint64, error) {
+	info, err := os.Stat(catalogPath)
+	if err != nil {
+		return 0, fmt.Errorf("os.Stat: %w", err)
+	}
+	// Раздача фильма может состоять из одного файла
+	if !info.IsDir() {
+		return uint64(info.Size()), nil
+	}
+
+	size, err := getFolderSize.Invoke(catalogPath)
+	if err != nil {
+		return 0, fmt.Errorf("could not get size: %w", err)
+	}
+	return uint64(size), nil
+}
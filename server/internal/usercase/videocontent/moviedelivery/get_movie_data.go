@@ -0,0 +1,46 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+)
+
+type GetMovieDataParams struct {
+	MovieID uint64
+	// Version версия видеоконтента (суффикс файла)
+	Version string
+}
+
+// GetMovieData получение информации о фильме и формирование имени каталога и файла
+func (s *Service) GetMovieData(ctx context.Context, params GetMovieDataParams) (*MovieData, error) {
+	movieInfo, err := s.movieLibrary.GetMovieInfo(ctx, movielibrary.GetMovieParams{
+		MovieID: params.MovieID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("movieLibrary.GetMovieInfo: %w", err)
+	}
+	if movieInfo == nil || movieInfo.Result == nil {
+		return nil, fmt.Errorf("movieInfo not found: %w", ucerr.NotFound)
+	}
+
+	// Название фильма из themoviedb может содержать символы, недопустимые в имени каталога
+	// Каталог фильма общий для всех версий, медиасервер (Emby) группирует версии по именам файлов
+	/*
+		Movie Name (2010)/
+		  Movie Name (2010).mkv
+		  Movie Name (2010) - 4K.mkv
+	*/
+	movieName := fmt.Sprintf("%s (%d)", common.PathName(movieInfo.Result.Title), movieInfo.Result.ReleaseDate.Year())
+
+	return &MovieData{
+		MovieCatalogPath: MovieCatalogPath{
+			MoviePath: filepath.Join(s.config.BasePath, s.config.MovieMediaSavePath, movieName),
+			FileName:  common.WithVersion(movieName, params.Version),
+		},
+	}, nil
+}
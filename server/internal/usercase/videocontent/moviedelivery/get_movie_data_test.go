@@ -0,0 +1,64 @@
+package moviedelivery
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/movielibrary"
+)
+
+type fakeMovieLibrary map[uint64]*movielibrary.Movie
+
+func (f fakeMovieLibrary) GetMovieInfo(_ context.Context, params movielibrary.GetMovieParams) (*movielibrary.GetMovieResult, error) {
+	movie, ok := f[params.MovieID]
+	if !ok {
+		return nil, ucerr.NotFound
+	}
+	return &movielibrary.GetMovieResult{Result: movie}, nil
+}
+
+func newTestMovie(id uint64, title, originalTitle string, year int) *movielibrary.Movie {
+	return &movielibrary.Movie{
+		MovieShort: movielibrary.MovieShort{
+			ID:            id,
+			Title:         title,
+			OriginalTitle: originalTitle,
+			ReleaseDate:   time.Date(year, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestGetMovieData(t *testing.T) {
+	s := &Service{
+		config: Config{BasePath: "/nfs", MovieMediaSavePath: "movies"},
+		movieLibrary: fakeMovieLibrary{
+			27205: newTestMovie(27205, "Начало", "Inception", 2010),
+			954:   newTestMovie(954, "Миссия: невыполнима", "Mission: Impossible", 1996),
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("version in file name only", func(t *testing.T) {
+		res, err := s.GetMovieData(ctx, GetMovieDataParams{MovieID: 27205, Version: "4K"})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join("/nfs", "movies", "Начало (2010)"), res.MovieCatalogPath.MoviePath)
+		require.Equal(t, "Начало (2010) - 4K", res.MovieCatalogPath.FileName)
+	})
+
+	t.Run("title with invalid path characters", func(t *testing.T) {
+		res, err := s.GetMovieData(ctx, GetMovieDataParams{MovieID: 954})
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join("/nfs", "movies", "Миссия невыполнима (1996)"), res.MovieCatalogPath.MoviePath)
+		require.Equal(t, "Миссия невыполнима (1996)", res.MovieCatalogPath.FileName)
+	})
+
+	t.Run("movie not found", func(t *testing.T) {
+		_, err := s.GetMovieData(ctx, GetMovieDataParams{MovieID: 1})
+		require.ErrorIs(t, err, ucerr.NotFound)
+	})
+}
@@ -0,0 +1,26 @@
+package moviedelivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/labels"
+)
+
+func (s *Service) AddLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error {
+	err := s.labels.AddLabel(ctx, labels.Label{
+		ContentID: contentID,
+		TypeLabel: labels.HasVideoContentFiles,
+		CreatedAt: time.Now(),
+	})
+	// Лейбл общий для всех версий фильма / сезона, его могла добавить доставка другой версии
+	if err != nil && !errors.Is(err, ucerr.AlreadyExists) {
+		return fmt.Errorf("labels.AddLabel: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/adapter/mkvmerge"
+)
+
+type MergeVideoParams struct {
+	IdempotencyKey string
+	MovieMatch     *MovieMatch
+}
+
+type MergeVideoStatus struct {
+	Progress   float32 // 0 до 1
+	IsComplete bool
+	Errors     []string
+}
+
+func mapMkvMergeParams(match *MovieMatch) mkvmerge.MergeParams {
+	options := match.Options
+	return mkvmerge.MergeParams{
+		VideoInputFile:  match.Video.File.FullPath,
+		VideoOutputFile: match.MovieFile.FullPath,
+		AudioTracks: lo.Map(match.AudioTracks, func(item Track, _ int) mkvmerge.Track {
+			return mkvmerge.Track{
+				Path:     item.File.FullPath,
+				Language: item.Language,
+				Name:     lo.FromPtrOr(item.Name, "unknown"),
+				Default:  lo.FromPtr(item.Name) == lo.FromPtr(options.DefaultAudioTrackName),
+			}
+		}),
+		SubtitleTracks: lo.Map(match.Subtitles, func(item Track, _ int) mkvmerge.Track {
+			return mkvmerge.Track{
+				Path:     item.File.FullPath,
+				Language: item.Language,
+				Name:     lo.FromPtrOr(item.Name, "unknown"),
+				Default:  lo.FromPtr(item.Name) == lo.FromPtr(options.DefaultSubtitleTrack),
+			}
+		}),
+		KeepOriginalAudio:     options.KeepOriginalAudio,
+		KeepOriginalSubtitles: options.KeepOriginalSubtitles,
+	}
+}
+
+// StartMergeVideo запуск сшивания видеофайла фильма с внешними дорожками
+func (s *Service) StartMergeVideo(ctx context.Context, params MergeVideoParams) (uuid.UUID, error) {
+	idempotencyKey := fmt.Sprintf("%s-movie", params.IdempotencyKey)
+	mergeResult, err := s.mkvMerge.AddToMerge(ctx, idempotencyKey, mapMkvMergeParams(params.MovieMatch))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("mkvMerge.AddToMerge: %w", err)
+	}
+	return mergeResult.ID, nil
+}
+
+// GetMergeVideoStatus получение статуса сшивания видеофайла фильма
+func (s *Service) GetMergeVideoStatus(ctx context.Context, mergeID uuid.UUID) (*MergeVideoStatus, error) {
+	result, err := s.mkvMerge.GetMergeResult(ctx, mergeID)
+	if err != nil {
+		return nil, fmt.Errorf("mkvMerge.GetMergeResult: %w", err)
+	}
+
+	var status MergeVideoStatus
+	switch result.Status {
+	case mkvmerge.ErrorStatus, mkvmerge.CompleteStatus, mkvmerge.CanceledStatus:
+		status.IsComplete = true
+		status.Progress = 1
+		if result.Error != nil {
+			status.Errors = append(status.Errors, *result.Error)
+		}
+		if result.Status == mkvmerge.CanceledStatus {
+			status.Errors = append(status.Errors, "merge canceled")
+		}
+	default:
+		status.Progress = lo.FromPtr(result.Progress)
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,209 @@
+package moviedelivery
+
+import (
+	"path/filepath"
+
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+)
+
+// SearchQuery поисковый запрос для поиска раздачи
+type SearchQuery struct {
+	// Поисковый запрос с которым ищем раздачи на торрент сайте
+	Query string
+	// Предложенные вариации поискового запроса
+	OptionalQuery []string
+}
+
+// TorrentSearch результат поиска торрент раздачи
+type TorrentSearch struct {
+	// Source трекер на котором найдена раздача
+	Source string
+	// Наименование раздачи
+	Title string
+	// Категория
+	Category string
+	// Ссылка на раздачу
+	Href string
+	// Size Размер раздачи (байты)
+	SizeBytes uint64
+	// Размер виде строки (32Gb)
+	SizePretty string
+	// Seeds Информация о сидах
+	Seeds uint32
+	// Leeches Информация о личах
+	Leeches uint32
+	// Downloads количество скачиваний
+	Downloads uint32
+	// AddedDate Дата добавления
+	AddedDate string
+	// Score оценка раздачи (0 - 100)
+	Score float64
+	// ScoreReasons из чего сложилась оценка
+	ScoreReasons []string
+}
+
+type MagnetLink struct {
+	// Магнет ссылка
+	Magnet string
+	// Хеш раздачи
+	Hash string
+}
+
+// Torrent Данные раздачи
+type Torrent struct {
+	// Ссылка на раздачу
+	Href string
+	//
+	MagnetLink *MagnetLink
+}
+
+type FileInfo struct {
+	// Относительный путь до файла торрента (относительно каталога скачивания)
+	RelativePath string
+	// Полный путь до файла в системе
+	FullPath string
+	// Размер файла (байты)
+	Size uint64
+}
+
+type TorrentFilesData struct {
+	// ContentFullPath Полный путь до каталога скачивания
+	ContentFullPath string
+	// Files Файлы раздачи
+	Files []FileInfo
+}
+
+// MovieCatalogPath путь каталога и файла фильма на медиа сервере
+type MovieCatalogPath struct {
+	// Путь до каталога фильма
+	MoviePath string
+	// Имя файла фильма без расширения (относительно каталога фильма)
+	FileName string
+}
+
+func (m MovieCatalogPath) FullFilePath(ext string) string {
+	return filepath.Join(m.MoviePath, m.FileName+ext)
+}
+
+type MovieData struct {
+	// Путь каталога фильма на медиа сервере
+	MovieCatalogPath MovieCatalogPath
+}
+
+type TrackType string
+
+const (
+	TrackTypeVideo    TrackType = "video"
+	TrackTypeAudio    TrackType = "audio"
+	TrackTypeSubtitle TrackType = "subtitle"
+)
+
+type Track struct {
+	Type     TrackType
+	Name     *string
+	Language *string
+	File     FileInfo
+}
+
+type MovieMatchOptions struct {
+	// Оставлять оригинальные аудиодорожки (если они есть)
+	KeepOriginalAudio bool
+	// Оставлять оригинальные субтитры (если они есть)
+	KeepOriginalSubtitles bool
+	// Дефолтная аудиодорожка
+	DefaultAudioTrackName *string
+	// Дефолтные субтитры
+	DefaultSubtitleTrack *string
+}
+
+// MovieMatch основной видеофайл фильма и внешние дорожки к нему
+type MovieMatch struct {
+	// Файл фильма который будет лежать на медиасервере
+	MovieFile FileInfo
+	// Основной видеофайл раздачи
+	Video Track
+	// Внешние аудиодорожки
+	AudioTracks []Track
+	// Внешние субтитры
+	Subtitles []Track
+	// Видеофайлы раздачи которые не являются основным (трейлеры, доп материалы и тд)
+	Unallocated []Track
+	Options     MovieMatchOptions
+}
+
+type TorrentState string
+
+const (
+	TorrentStateError       TorrentState = "error"       // Ошибка
+	TorrentStateUploading   TorrentState = "uploading"   // Раздача (сидирование)
+	TorrentStateDownloading TorrentState = "downloading" // Загрузка
+	TorrentStateStopped     TorrentState = "stopped"     // Приостановлен (все виды паузы)
+	TorrentStateQueued      TorrentState = "queued"      // В очереди
+	TorrentStateUnknown     TorrentState = "unknown"     // Неизвестный статус
+)
+
+func mapTorrentState(qbState qbittorrent.TorrentState) TorrentState {
+	switch qbState {
+	// Ошибки
+	case qbittorrent.TorrentStateError,
+		qbittorrent.TorrentStateMissingFiles:
+		return TorrentStateError
+
+	// Раздача (сидирование)
+	case qbittorrent.TorrentStateUploading,
+		qbittorrent.TorrentStatePausedUP,
+		qbittorrent.TorrentStateStalledUP,
+		qbittorrent.TorrentStateCheckingUP,
+		qbittorrent.TorrentStateQueuedUP,
+		qbittorrent.TorrentStateForcedUP:
+		return TorrentStateUploading
+
+	// Загрузка
+	case qbittorrent.TorrentStateDownloading,
+		qbittorrent.TorrentStateMetaDL,
+		qbittorrent.TorrentStateAllocating,
+		qbittorrent.TorrentStateForcedDL,
+		qbittorrent.TorrentStateMoving:
+		return TorrentStateDownloading
+
+	// Приостановлен
+	case qbittorrent.TorrentStatePausedDL,
+		qbittorrent.TorrentStateStoppedDL:
+		return TorrentStateStopped
+
+	// В очереди
+	case qbittorrent.TorrentStateQueuedDL,
+		qbittorrent.TorrentStateCheckingDL,
+		qbittorrent.TorrentStateStalledDL,
+		qbittorrent.TorrentStateCheckingResumeData:
+		return TorrentStateQueued
+
+	default:
+		return TorrentStateUnknown
+	}
+}
+
+type TorrentDownloadStatus struct {
+	State      TorrentState
+	Progress   float64
+	IsComplete bool
+}
+
+// MovieCatalog сводная информация о каталогах и размерах
+type MovieCatalog struct {
+	// Путь до раздачи фильма
+	TorrentPath string
+	// Размер файлов раздачи фильма (байты)
+	TorrentSize uint64
+	//
+	TorrentSizePretty string
+	// Путь до фильма на медиасервере
+	MediaServerPath MovieCatalogPath
+	// Размер файлов фильма на медиасервере (байты)
+	MediaServerSize       uint64
+	MediaServerSizePretty string
+	// Файлы скопированы с раздачи или созданы ссылочная связь
+	// True - файлы скопированы
+	// False - файлы созданы через линки
+	IsCopyFilesInMediaServer bool
+}
@@ -0,0 +1,84 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/adapter/matchtvshow"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+)
+
+type PrepareMovieMatchParams struct {
+	TorrentFiles     []FileInfo
+	MovieCatalogPath MovieCatalogPath
+}
+
+func mapTracks(tracks []matchtvshow.Track, files map[string]FileInfo, trackType TrackType) []Track {
+	result := make([]Track, 0, len(tracks))
+	for _, track := range tracks {
+		file, ok := files[track.File]
+		if !ok {
+			continue
+		}
+		result = append(result, Track{
+			Type: trackType,
+			Name: func() *string {
+				if track.Name == "" {
+					return nil
+				}
+				return lo.ToPtr(track.Name)
+			}(),
+			Language: track.Language,
+			File:     file,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].File.RelativePath < result[j].File.RelativePath
+	})
+	return result
+}
+
+// PrepareMovieMatch выбор основного видеофайла раздачи и внешних дорожек к нему
+func (s *Service) PrepareMovieMatch(_ context.Context, params PrepareMovieMatchParams) (*MovieMatch, error) {
+	files := lo.SliceToMap(params.TorrentFiles, func(item FileInfo) (string, FileInfo) {
+		return item.RelativePath, item
+	})
+
+	prepare, err := s.prepareMovie.MatchMovieFiles(lo.Keys(files))
+	if err != nil {
+		return nil, fmt.Errorf("prepareMovie.MatchMovieFiles: %w", err)
+	}
+
+	videos := mapTracks(prepare.Videos, files, TrackTypeVideo)
+	if len(videos) == 0 {
+		return nil, fmt.Errorf("video file not found: %w", ucerr.NotFound)
+	}
+
+	// Основной видеофайл - самый большой, остальные обычно трейлеры и доп материалы
+	sort.SliceStable(videos, func(i, j int) bool {
+		return videos[i].File.Size > videos[j].File.Size
+	})
+	video := videos[0]
+
+	ext := strings.ToLower(filepath.Ext(video.File.FullPath))
+	return &MovieMatch{
+		MovieFile: FileInfo{
+			RelativePath: params.MovieCatalogPath.FileName + ext,
+			FullPath:     params.MovieCatalogPath.FullFilePath(ext),
+		},
+		Video:       video,
+		AudioTracks: mapTracks(prepare.AudioTracks, files, TrackTypeAudio),
+		Subtitles:   mapTracks(prepare.Subtitles, files, TrackTypeSubtitle),
+		Unallocated: videos[1:],
+	}, nil
+}
+
+// NeedMergeMovie нужно ли сшивать видеофайл с внешними дорожками
+func (s *Service) NeedMergeMovie(match *MovieMatch) bool {
+	return len(match.AudioTracks) > 0 || len(match.Subtitles) > 0
+}
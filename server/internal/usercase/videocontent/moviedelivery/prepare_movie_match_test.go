@@ -0,0 +1,41 @@
+package moviedelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/matchtvshow"
+)
+
+func TestPrepareMovieMatch(t *testing.T) {
+	s := &Service{prepareMovie: matchtvshow.NewService()}
+	ctx := context.Background()
+	catalogPath := MovieCatalogPath{MoviePath: "/nfs/movies/Начало (2010)", FileName: "Начало (2010) - 4K"}
+
+	files := []FileInfo{
+		{RelativePath: "Inception.2010/Inception.2010.2160p.mkv", FullPath: "/nfs/downloads/Inception.2010/Inception.2010.2160p.mkv", Size: 50_000},
+		{RelativePath: "Inception.2010/Trailer.mkv", FullPath: "/nfs/downloads/Inception.2010/Trailer.mkv", Size: 100},
+		{RelativePath: "Inception.2010/Rus Sound/Inception.2010.mka", FullPath: "/nfs/downloads/Inception.2010/Rus Sound/Inception.2010.mka", Size: 1_000},
+	}
+
+	t.Run("largest video is the movie", func(t *testing.T) {
+		res, err := s.PrepareMovieMatch(ctx, PrepareMovieMatchParams{TorrentFiles: files, MovieCatalogPath: catalogPath})
+		require.NoError(t, err)
+		require.Equal(t, files[0], res.Video.File)
+		require.Equal(t, FileInfo{
+			RelativePath: "Начало (2010) - 4K.mkv",
+			FullPath:     "/nfs/movies/Начало (2010)/Начало (2010) - 4K.mkv",
+		}, res.MovieFile)
+		require.Len(t, res.Unallocated, 1)
+		require.Equal(t, files[1], res.Unallocated[0].File)
+		require.Len(t, res.AudioTracks, 1)
+		require.True(t, s.NeedMergeMovie(res))
+	})
+
+	t.Run("no video files", func(t *testing.T) {
+		_, err := s.PrepareMovieMatch(ctx, PrepareMovieMatchParams{TorrentFiles: files[2:], MovieCatalogPath: catalogPath})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,33 @@
+package moviedelivery
+
+import (
+	"sort"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/torrentrank"
+)
+
+// rankTorrent оценка раздачи по заголовку и статистике (0 - 100)
+func rankTorrent(t TorrentSearch) (float64, []string) {
+	rank := &torrentrank.Rank{}
+
+	rank.Resolution(t.Title)
+	rank.Source(t.Title)
+	rank.Codec(t.Title)
+	rank.VoiceOver(t.Title)
+	rank.Seeds(t.Seeds)
+
+	return rank.Result()
+}
+
+// rankTorrents проставляет оценки раздачам и сортирует их по убыванию оценки
+func rankTorrents(torrents []TorrentSearch) {
+	for i := range torrents {
+		torrents[i].Score, torrents[i].ScoreReasons = rankTorrent(torrents[i])
+	}
+	sort.SliceStable(torrents, func(i, j int) bool {
+		if torrents[i].Score != torrents[j].Score {
+			return torrents[i].Score > torrents[j].Score
+		}
+		return torrents[i].Downloads > torrents[j].Downloads
+	})
+}
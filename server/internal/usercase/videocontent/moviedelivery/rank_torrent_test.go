@@ -0,0 +1,26 @@
+package moviedelivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankTorrents(t *testing.T) {
+	torrents := []TorrentSearch{
+		{Href: "cam", Title: "Начало / Inception (2010) CAMRip", Seeds: 900, Downloads: 5000},
+		{Href: "best", Title: "Начало / Inception (2010) BDRip 1080p | Дубляж", Seeds: 50, Downloads: 10},
+		{Href: "dead", Title: "Начало / Inception (2010) BDRip 1080p | Дубляж", Seeds: 0, Downloads: 10},
+	}
+	rankTorrents(torrents)
+
+	require.Equal(t, "best", torrents[0].Href)
+	require.Equal(t, "dead", torrents[1].Href)
+	require.Equal(t, "cam", torrents[2].Href)
+	require.Equal(t, []string{
+		"разрешение 1080p (+25)",
+		"BluRay (+12)",
+		"многоголосая озвучка / дубляж (+5)",
+		"сидов 50 (+9)",
+	}, torrents[0].ScoreReasons)
+}
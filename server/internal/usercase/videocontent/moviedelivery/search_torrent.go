@@ -0,0 +1,38 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+)
+
+type SearchTorrentParams struct {
+	SearchQuery string
+}
+
+// SearchTorrent Делаем запрос к торрент сайту, получаем список раздач отсортированный по оценке
+func (s *Service) SearchTorrent(_ context.Context, params SearchTorrentParams) ([]TorrentSearch, error) {
+	searchResult, err := s.torrentSite.SearchTorrents(params.SearchQuery)
+	if err != nil {
+		return nil, fmt.Errorf("torrentSite.SearchTorrents: %w", err)
+	}
+
+	var result []TorrentSearch
+	for _, item := range searchResult.Results {
+		result = append(result, TorrentSearch{
+			Source:     item.Source,
+			Title:      item.Title,
+			Href:       item.Href,
+			SizeBytes:  item.SizeBytes,
+			SizePretty: item.SizePretty,
+			Seeds:      item.Seeds,
+			Leeches:    item.Leeches,
+			Downloads:  item.Downloads,
+			AddedDate:  item.AddedDate,
+			Category:   item.Category,
+		})
+	}
+
+	rankTorrents(result)
+
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package moviedelivery
+
+type Config struct {
+	// BasePath Базовый путь от которого расположены все файлы торрента или медиа сервера
+	// Например скачанные фильмы лежат по пути BasePath + MovieTorrentSavePath
+	BasePath string // "/nfs"
+	// MovieTorrentSavePath путь сохранения фильмов относительно торрент клиента
+	MovieTorrentSavePath string
+	// MovieMediaSavePath путь сохранения фильмов относительно медиа сервера
+	MovieMediaSavePath string
+}
+
+type Service struct {
+	config        Config
+	movieLibrary  MovieLibrary
+	torrentSite   TorrentSite
+	torrentClient TorrentClient
+	embyApi       EmbyApi
+	prepareMovie  PrepareMovie
+	mkvMerge      MkvMergePipeline
+	labels        Labels
+}
+
+func NewService(
+	config Config,
+	movieLibrary MovieLibrary,
+	torrentSite TorrentSite,
+	torrentClient TorrentClient,
+	embyApi EmbyApi,
+	prepareMovie PrepareMovie,
+	mkvMerge MkvMergePipeline,
+	labels Labels,
+) *Service {
+	return &Service{
+		config:        config,
+		movieLibrary:  movieLibrary,
+		torrentSite:   torrentSite,
+		torrentClient: torrentClient,
+		embyApi:       embyApi,
+		prepareMovie:  prepareMovie,
+		mkvMerge:      mkvMerge,
+		labels:        labels,
+	}
+}
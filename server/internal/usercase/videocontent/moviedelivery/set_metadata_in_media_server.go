@@ -0,0 +1,69 @@
+package moviedelivery
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/kkiling/media-delivery/internal/adapter/emby"
+)
+
+type SetMediaMetaDataParams struct {
+	MovieFilePath string
+	MovieID       uint64
+}
+
+// SetMediaMetaData установка методанных фильма в медиасервере
+func (s *Service) SetMediaMetaData(_ context.Context, params SetMediaMetaDataParams) error {
+	moviePath, err := filepath.Rel(s.config.BasePath, params.MovieFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to get relative path: %w", err)
+	}
+
+	// Сканируем только каталог доставленного фильма
+	if err = s.embyApi.ScanPath(filepath.Dir("/" + moviePath)); err != nil {
+		return fmt.Errorf("failed to scan path: %w", err)
+	}
+
+	// В emby элемент фильма привязан к видеофайлу, а не к каталогу
+	info, err := s.embyApi.GetCatalogInfo("/" + moviePath)
+	if err != nil {
+		return fmt.Errorf("embyApi.GetCatalogInfo: %w", err)
+	}
+
+	if info == nil {
+		return fmt.Errorf("catalogInfo: info is nil")
+	}
+	if info.TheMovieDbID == params.MovieID {
+		// Фильм уже правильно идентифицирован
+		return nil
+	}
+
+	if info.Type != emby.MovieTypeCatalog {
+		return fmt.Errorf("catalogInfo: type is not movie")
+	}
+
+	err = s.embyApi.ResetMetadata(info.ID)
+	if err != nil {
+		return fmt.Errorf("embyApi.ResetMetadata: %w", err)
+	}
+
+	err = s.embyApi.RemoteSearchApply(info.ID, params.MovieID)
+	if err != nil {
+		return fmt.Errorf("embyApi.RemoteSearchApply: %w", err)
+	}
+
+	// Запрашиваем еще раз инфу и сравниваем TheMovieDbId что бы удостоверитсья что мы установили метадату
+	info, err = s.embyApi.GetCatalogInfo("/" + moviePath)
+	if err != nil {
+		return fmt.Errorf("embyApi.GetCatalogInfo: %w", err)
+	}
+	if info == nil {
+		return fmt.Errorf("catalogInfo: info is nil")
+	}
+	if info.TheMovieDbID != params.MovieID {
+		return fmt.Errorf("TheMovieDbID does not match")
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package qualityprofile
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Storage interface {
+	SaveQualityProfile(ctx context.Context, profile *QualityProfile) error
+	UpdateQualityProfile(ctx context.Context, profile *QualityProfile) error
+	GetQualityProfile(ctx context.Context, id uuid.UUID) (*QualityProfile, error)
+	GetQualityProfiles(ctx context.Context) ([]QualityProfile, error)
+	DeleteQualityProfile(ctx context.Context, id uuid.UUID) error
+}
+
+// UUIDGenerator интерфейс для генерации UUID (реальный или мок)
+type UUIDGenerator interface {
+	New() uuid.UUID
+}
+
+// Clock интерфейс для работы со временем (реальный или мок)
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,43 @@
+package qualityprofile
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Разрешения видео, которые распознаются в заголовках раздач
+const (
+	ResolutionSD   = 480
+	Resolution720  = 720
+	Resolution1080 = 1080
+	Resolution2160 = 2160
+)
+
+// Кодеки видео, которые распознаются в заголовках раздач
+const (
+	CodecAVC  = "avc"
+	CodecHEVC = "hevc"
+	CodecAV1  = "av1"
+	CodecXviD = "xvid"
+)
+
+// QualityProfile профиль качества, по которому фильтруются найденные раздачи
+type QualityProfile struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// MinResolution минимальное разрешение (высота кадра), 0 - без ограничений
+	MinResolution int
+	// MaxResolution максимальное разрешение (высота кадра), 0 - без ограничений
+	MaxResolution int
+	// PreferredCodecs предпочитаемые кодеки, раздача с другим кодеком не отбрасывается, но помечается
+	PreferredCodecs []string
+	// ForbiddenCodecs запрещенные кодеки
+	ForbiddenCodecs []string
+	// RequiredAudio языки или названия озвучек, каждое из которых должно быть в заголовке раздачи
+	RequiredAudio []string
+	// MaxSizePerEpisode максимальный размер одной серии в байтах, 0 - без ограничений
+	MaxSizePerEpisode uint64
+}
@@ -0,0 +1,18 @@
+package qualityprofile
+
+import "github.com/google/uuid"
+
+type CreateQualityProfileParams struct {
+	Name              string
+	MinResolution     int
+	MaxResolution     int
+	PreferredCodecs   []string
+	ForbiddenCodecs   []string
+	RequiredAudio     []string
+	MaxSizePerEpisode uint64
+}
+
+type UpdateQualityProfileParams struct {
+	ID uuid.UUID
+	CreateQualityProfileParams
+}
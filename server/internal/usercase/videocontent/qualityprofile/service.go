@@ -0,0 +1,172 @@
+package qualityprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+)
+
+var knownCodecs = []string{CodecAVC, CodecHEVC, CodecAV1, CodecXviD}
+
+type Service struct {
+	storage       Storage
+	clock         Clock
+	uuidGenerator UUIDGenerator
+}
+
+func NewService(storage Storage) *Service {
+	return &Service{
+		storage:       storage,
+		clock:         &common.RealClock{},
+		uuidGenerator: &common.UUIDGenerator{},
+	}
+}
+
+// normalizeList приводит значения к нижнему регистру и убирает пустые и повторяющиеся
+func normalizeList(items []string) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item != "" && !lo.Contains(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func validateParams(params CreateQualityProfileParams) (CreateQualityProfileParams, error) {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return params, fmt.Errorf("name is required: %w", ucerr.InvalidArgument)
+	}
+	if params.MinResolution < 0 || params.MaxResolution < 0 {
+		return params, fmt.Errorf("resolution must be positive: %w", ucerr.InvalidArgument)
+	}
+	if params.MaxResolution != 0 && params.MinResolution > params.MaxResolution {
+		return params, fmt.Errorf("min resolution greater than max resolution: %w", ucerr.InvalidArgument)
+	}
+
+	params.PreferredCodecs = normalizeList(params.PreferredCodecs)
+	params.ForbiddenCodecs = normalizeList(params.ForbiddenCodecs)
+	params.RequiredAudio = normalizeList(params.RequiredAudio)
+
+	for _, codec := range append(params.PreferredCodecs, params.ForbiddenCodecs...) {
+		if !lo.Contains(knownCodecs, codec) {
+			return params, fmt.Errorf("unknown codec %s: %w", codec, ucerr.InvalidArgument)
+		}
+	}
+	if len(lo.Intersect(params.PreferredCodecs, params.ForbiddenCodecs)) > 0 {
+		return params, fmt.Errorf("codec is both preferred and forbidden: %w", ucerr.InvalidArgument)
+	}
+
+	return params, nil
+}
+
+// CreateQualityProfile создание профиля качества
+func (s *Service) CreateQualityProfile(ctx context.Context, params CreateQualityProfileParams) (*QualityProfile, error) {
+	params, err := validateParams(params)
+	if err != nil {
+		return nil, fmt.Errorf("validateParams: %w", err)
+	}
+
+	now := s.clock.Now()
+	profile := QualityProfile{
+		ID:                s.uuidGenerator.New(),
+		Name:              params.Name,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		MinResolution:     params.MinResolution,
+		MaxResolution:     params.MaxResolution,
+		PreferredCodecs:   params.PreferredCodecs,
+		ForbiddenCodecs:   params.ForbiddenCodecs,
+		RequiredAudio:     params.RequiredAudio,
+		MaxSizePerEpisode: params.MaxSizePerEpisode,
+	}
+
+	if err := s.storage.SaveQualityProfile(ctx, &profile); err != nil {
+		if errors.Is(err, storagebase.ErrAlreadyExists) {
+			return nil, fmt.Errorf("storage.SaveQualityProfile: %w", ucerr.AlreadyExists)
+		}
+		return nil, fmt.Errorf("storage.SaveQualityProfile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// UpdateQualityProfile изменение профиля качества
+func (s *Service) UpdateQualityProfile(ctx context.Context, params UpdateQualityProfileParams) (*QualityProfile, error) {
+	createParams, err := validateParams(params.CreateQualityProfileParams)
+	if err != nil {
+		return nil, fmt.Errorf("validateParams: %w", err)
+	}
+
+	profile, err := s.GetQualityProfile(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetQualityProfile: %w", err)
+	}
+
+	profile.Name = createParams.Name
+	profile.UpdatedAt = s.clock.Now()
+	profile.MinResolution = createParams.MinResolution
+	profile.MaxResolution = createParams.MaxResolution
+	profile.PreferredCodecs = createParams.PreferredCodecs
+	profile.ForbiddenCodecs = createParams.ForbiddenCodecs
+	profile.RequiredAudio = createParams.RequiredAudio
+	profile.MaxSizePerEpisode = createParams.MaxSizePerEpisode
+
+	if err := s.storage.UpdateQualityProfile(ctx, profile); err != nil {
+		switch {
+		case errors.Is(err, storagebase.ErrNotFound):
+			return nil, fmt.Errorf("storage.UpdateQualityProfile: %w", ucerr.NotFound)
+		case errors.Is(err, storagebase.ErrAlreadyExists):
+			return nil, fmt.Errorf("storage.UpdateQualityProfile: %w", ucerr.AlreadyExists)
+		}
+		return nil, fmt.Errorf("storage.UpdateQualityProfile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// GetQualityProfile получение профиля качества
+func (s *Service) GetQualityProfile(ctx context.Context, id uuid.UUID) (*QualityProfile, error) {
+	profile, err := s.storage.GetQualityProfile(ctx, id)
+	if err != nil {
+		if errors.Is(err, storagebase.ErrNotFound) {
+			return nil, fmt.Errorf("storage.GetQualityProfile: %w", ucerr.NotFound)
+		}
+		return nil, fmt.Errorf("storage.GetQualityProfile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// GetQualityProfiles список всех профилей качества
+func (s *Service) GetQualityProfiles(ctx context.Context) ([]QualityProfile, error) {
+	profiles, err := s.storage.GetQualityProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetQualityProfiles: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// DeleteQualityProfile удаление профиля качества
+// Стейты доставки хранят копию профиля, поэтому удаление на них не влияет
+func (s *Service) DeleteQualityProfile(ctx context.Context, id uuid.UUID) error {
+	if err := s.storage.DeleteQualityProfile(ctx, id); err != nil {
+		if errors.Is(err, storagebase.ErrNotFound) {
+			return fmt.Errorf("storage.DeleteQualityProfile: %w", ucerr.NotFound)
+		}
+		return fmt.Errorf("storage.DeleteQualityProfile: %w", err)
+	}
+
+	return nil
+}
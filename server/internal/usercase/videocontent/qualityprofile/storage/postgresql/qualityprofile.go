@@ -0,0 +1,103 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile/storage/db"
+)
+
+func mapQualityProfile(item db.QualityProfile) qualityprofile.QualityProfile {
+	return qualityprofile.QualityProfile{
+		ID:                item.ID,
+		Name:              item.Name,
+		CreatedAt:         item.CreatedAt,
+		UpdatedAt:         item.UpdatedAt,
+		MinResolution:     item.MinResolution,
+		MaxResolution:     item.MaxResolution,
+		PreferredCodecs:   item.PreferredCodecs,
+		ForbiddenCodecs:   item.ForbiddenCodecs,
+		RequiredAudio:     item.RequiredAudio,
+		MaxSizePerEpisode: uint64(item.MaxSizePerEpisode),
+	}
+}
+
+func (s *Storage) SaveQualityProfile(ctx context.Context, profile *qualityprofile.QualityProfile) error {
+	queries := s.getQueries(ctx)
+
+	err := queries.SaveQualityProfile(ctx, db.SaveQualityProfileParams{
+		ID:                profile.ID,
+		Name:              profile.Name,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+		MinResolution:     profile.MinResolution,
+		MaxResolution:     profile.MaxResolution,
+		PreferredCodecs:   lo.Ternary(profile.PreferredCodecs == nil, []string{}, profile.PreferredCodecs),
+		ForbiddenCodecs:   lo.Ternary(profile.ForbiddenCodecs == nil, []string{}, profile.ForbiddenCodecs),
+		RequiredAudio:     lo.Ternary(profile.RequiredAudio == nil, []string{}, profile.RequiredAudio),
+		MaxSizePerEpisode: int64(profile.MaxSizePerEpisode),
+	})
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) UpdateQualityProfile(ctx context.Context, profile *qualityprofile.QualityProfile) error {
+	queries := s.getQueries(ctx)
+
+	_, err := queries.UpdateQualityProfile(ctx, db.UpdateQualityProfileParams{
+		ID:                profile.ID,
+		Name:              profile.Name,
+		UpdatedAt:         profile.UpdatedAt,
+		MinResolution:     profile.MinResolution,
+		MaxResolution:     profile.MaxResolution,
+		PreferredCodecs:   lo.Ternary(profile.PreferredCodecs == nil, []string{}, profile.PreferredCodecs),
+		ForbiddenCodecs:   lo.Ternary(profile.ForbiddenCodecs == nil, []string{}, profile.ForbiddenCodecs),
+		RequiredAudio:     lo.Ternary(profile.RequiredAudio == nil, []string{}, profile.RequiredAudio),
+		MaxSizePerEpisode: int64(profile.MaxSizePerEpisode),
+	})
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetQualityProfile(ctx context.Context, id uuid.UUID) (*qualityprofile.QualityProfile, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetQualityProfile(ctx, id)
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	return lo.ToPtr(mapQualityProfile(res)), nil
+}
+
+func (s *Storage) GetQualityProfiles(ctx context.Context) ([]qualityprofile.QualityProfile, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetQualityProfiles(ctx)
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	return lo.Map(res, func(item db.QualityProfile, _ int) qualityprofile.QualityProfile {
+		return mapQualityProfile(item)
+	}), nil
+}
+
+func (s *Storage) DeleteQualityProfile(ctx context.Context, id uuid.UUID) error {
+	queries := s.getQueries(ctx)
+
+	if _, err := queries.DeleteQualityProfile(ctx, id); err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/kkiling/goplatform/storagebase/testutils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+)
+
+func newProfile(name string) *qualityprofile.QualityProfile {
+	now := time.Now()
+	return &qualityprofile.QualityProfile{
+		ID:                uuid.New(),
+		Name:              name + " " + uuid.NewString(),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		MinResolution:     qualityprofile.Resolution1080,
+		MaxResolution:     qualityprofile.Resolution2160,
+		PreferredCodecs:   []string{qualityprofile.CodecHEVC},
+		ForbiddenCodecs:   []string{qualityprofile.CodecXviD},
+		RequiredAudio:     []string{"lostfilm"},
+		MaxSizePerEpisode: 4 << 30,
+	}
+}
+
+func equalProfile(t *testing.T, p1, p2 *qualityprofile.QualityProfile) {
+	require.Equal(t, p1.ID, p2.ID)
+	require.Equal(t, p1.Name, p2.Name)
+	require.WithinDuration(t, p1.CreatedAt, p2.CreatedAt, time.Second)
+	require.WithinDuration(t, p1.UpdatedAt, p2.UpdatedAt, time.Second)
+	require.Equal(t, p1.MinResolution, p2.MinResolution)
+	require.Equal(t, p1.MaxResolution, p2.MaxResolution)
+	require.Equal(t, p1.PreferredCodecs, p2.PreferredCodecs)
+	require.Equal(t, p1.ForbiddenCodecs, p2.ForbiddenCodecs)
+	require.Equal(t, p1.RequiredAudio, p2.RequiredAudio)
+	require.Equal(t, p1.MaxSizePerEpisode, p2.MaxSizePerEpisode)
+}
+
+func TestStorage_QualityProfile(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	t.Run("save and get", func(t *testing.T) {
+		t.Parallel()
+
+		profile := newProfile("1080p")
+		err := testStorage.SaveQualityProfile(ctx, profile)
+		require.NoError(t, err)
+
+		saved, err := testStorage.GetQualityProfile(ctx, profile.ID)
+		require.NoError(t, err)
+		equalProfile(t, profile, saved)
+
+		err = testStorage.SaveQualityProfile(ctx, profile)
+		require.ErrorIs(t, err, storagebase.ErrAlreadyExists)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		profile := newProfile("update")
+		err := testStorage.SaveQualityProfile(ctx, profile)
+		require.NoError(t, err)
+
+		profile.MinResolution = qualityprofile.Resolution720
+		profile.RequiredAudio = []string{}
+		profile.UpdatedAt = time.Now()
+		err = testStorage.UpdateQualityProfile(ctx, profile)
+		require.NoError(t, err)
+
+		saved, err := testStorage.GetQualityProfile(ctx, profile.ID)
+		require.NoError(t, err)
+		equalProfile(t, profile, saved)
+
+		err = testStorage.UpdateQualityProfile(ctx, newProfile("missing"))
+		require.ErrorIs(t, err, storagebase.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		profile := newProfile("delete")
+		err := testStorage.SaveQualityProfile(ctx, profile)
+		require.NoError(t, err)
+
+		profiles, err := testStorage.GetQualityProfiles(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, profiles)
+
+		err = testStorage.DeleteQualityProfile(ctx, profile.ID)
+		require.NoError(t, err)
+
+		_, err = testStorage.GetQualityProfile(ctx, profile.ID)
+		require.ErrorIs(t, err, storagebase.ErrNotFound)
+
+		err = testStorage.DeleteQualityProfile(ctx, profile.ID)
+		require.ErrorIs(t, err, storagebase.ErrNotFound)
+	})
+}
@@ -0,0 +1,85 @@
+package runners
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kkiling/statemachine"
+)
+
+// RetryData данные стейта, в которых хранится состояние автоматических повторов шага
+type RetryData[DataT any] interface {
+	GetStepRetry() *StepRetry
+	WithStepRetry(retry *StepRetry) DataT
+}
+
+// Backoff автоматические повторы шагов стейта при временных ошибках
+type Backoff[DataT RetryData[DataT], StepT ~string] struct {
+	// Policies политики повторов по шагам, для остальных шагов DefaultRetryPolicy
+	Policies RetryPolicies[StepT]
+	Clock    Clock
+}
+
+// StepError завершение шага ошибкой
+// Временные ошибки не завершают шаг, а повторяются с задержкой, пока не исчерпаются попытки
+func (b Backoff[DataT, StepT]) StepError(
+	stepContext statemachine.StepContext[DataT, FailData, Metadata, StepT, Type],
+	err error,
+) *statemachine.StepResult[DataT, StepT] {
+	step := stepContext.State.Step
+	policy := b.Policies.Get(step)
+	data := stepContext.State.Data
+	retry := NextRetry(policy, data.GetStepRetry(), string(step), err, b.Clock.Now())
+	if retry == nil {
+		return stepContext.Error(err)
+	}
+
+	data = data.WithStepRetry(retry)
+	if retry.Exhausted(policy) {
+		return stepContext.Error(err).WithData(data)
+	}
+	return stepContext.Empty().WithData(data)
+}
+
+// Exhausted повторов шага после ошибки err не будет: ошибка не временная или это была последняя попытка
+// Для шагов, которые после неудачных повторов завершаются без ошибки
+func (b Backoff[DataT, StepT]) Exhausted(
+	stepContext statemachine.StepContext[DataT, FailData, Metadata, StepT, Type],
+	err error,
+) bool {
+	step := stepContext.State.Step
+	policy := b.Policies.Get(step)
+	retry := NextRetry(policy, stepContext.State.Data.GetStepRetry(), string(step), err, b.Clock.Now())
+	return retry == nil || retry.Exhausted(policy)
+}
+
+// wrap шаг не выполняется, пока не наступило время следующей попытки
+// Когда попытки исчерпаны, шаг больше не выполняется и остается с ошибкой до ручного повтора
+func (b Backoff[DataT, StepT]) wrap(
+	step StepT,
+	s statemachine.Step[DataT, FailData, Metadata, StepT, Type],
+) statemachine.Step[DataT, FailData, Metadata, StepT, Type] {
+	onStep := s.OnStep
+	s.OnStep = func(ctx context.Context, stepContext statemachine.StepContext[DataT, FailData, Metadata, StepT, Type]) *statemachine.StepResult[DataT, StepT] {
+		retry := stepContext.State.Data.GetStepRetry()
+		policy := b.Policies.Get(step)
+		if retry.Stopped(string(step), policy) {
+			return stepContext.Error(errors.New(retry.LastError))
+		}
+		if retry.Wait(string(step), policy, b.Clock.Now()) {
+			return stepContext.Empty()
+		}
+		return onStep(ctx, stepContext)
+	}
+	return s
+}
+
+// Register добавляет автоматические повторы всем шагам стейта
+// Шаги ожидания пользователя принимают свои опции и не повторяются автоматически
+func (b Backoff[DataT, StepT]) Register(steps map[StepT]statemachine.Step[DataT, FailData, Metadata, StepT, Type]) {
+	for step, s := range steps {
+		if s.OptionsType == nil {
+			steps[step] = b.wrap(step, s)
+		}
+	}
+}
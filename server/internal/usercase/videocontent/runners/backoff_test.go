@@ -0,0 +1,101 @@
+package runners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kkiling/statemachine"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+type testStep string
+
+type testData struct {
+	StepRetry *StepRetry
+}
+
+func (d testData) GetStepRetry() *StepRetry {
+	return d.StepRetry
+}
+
+func (d testData) WithStepRetry(retry *StepRetry) testData {
+	d.StepRetry = retry
+	return d
+}
+
+type testStepContext = statemachine.StepContext[testData, FailData, Metadata, testStep, Type]
+type testStepResult = statemachine.StepResult[testData, testStep]
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func TestStepError(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+	backoff := Backoff[testData, testStep]{
+		Policies: RetryPolicies[testStep]{"step": {MaxAttempts: 2, InitialBackoff: time.Minute, MaxBackoff: time.Hour}},
+		Clock:    clock,
+	}
+	stepContext := testStepContext{}
+	stepContext.State.Step = "step"
+
+	t.Run("not retryable error", func(t *testing.T) {
+		err := errors.New("some error")
+		require.Equal(t, stepContext.Error(err), backoff.StepError(stepContext, err))
+	})
+
+	t.Run("retryable error", func(t *testing.T) {
+		err := fmt.Errorf("search: %w", apierr.ServiceUnavailableErr)
+		retry := &StepRetry{Step: "step", Attempts: 1, NextAttemptAt: clock.now.Add(time.Minute), LastError: err.Error()}
+		require.Equal(t, stepContext.Empty().WithData(testData{StepRetry: retry}), backoff.StepError(stepContext, err))
+
+		// Попытки исчерпаны
+		ctx := stepContext
+		ctx.State.Data.StepRetry = retry
+		exhausted := &StepRetry{Step: "step", Attempts: 2, NextAttemptAt: clock.now.Add(2 * time.Minute), LastError: err.Error()}
+		require.Equal(t, ctx.Error(err).WithData(testData{StepRetry: exhausted}), backoff.StepError(ctx, err))
+	})
+}
+
+func TestBackoffRegister(t *testing.T) {
+	clock := &testClock{now: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+	backoff := Backoff[testData, testStep]{
+		Policies: RetryPolicies[testStep]{"step": {MaxAttempts: 2, InitialBackoff: time.Minute, MaxBackoff: time.Hour}},
+		Clock:    clock,
+	}
+	called := 0
+	steps := map[testStep]statemachine.Step[testData, FailData, Metadata, testStep, Type]{
+		"step": {
+			OnStep: func(_ context.Context, stepContext testStepContext) *testStepResult {
+				called++
+				return stepContext.Complete()
+			},
+		},
+	}
+	backoff.Register(steps)
+	step := steps["step"]
+
+	stepContext := testStepContext{}
+	stepContext.State.Data.StepRetry = &StepRetry{Step: "step", Attempts: 1, NextAttemptAt: clock.now.Add(time.Minute)}
+	require.Equal(t, stepContext.Empty(), step.OnStep(context.Background(), stepContext))
+	require.Equal(t, 0, called)
+
+	clock.now = clock.now.Add(time.Minute)
+	require.Equal(t, stepContext.Complete(), step.OnStep(context.Background(), stepContext))
+	require.Equal(t, 1, called)
+
+	// Попытки исчерпаны - шаг больше не выполняется и остается с ошибкой до ручного повтора
+	stepContext.State.Data.StepRetry = &StepRetry{Step: "step", Attempts: 2, NextAttemptAt: clock.now, LastError: "search: service unavailable"}
+	clock.now = clock.now.Add(24 * time.Hour)
+	require.Equal(t, stepContext.Error(errors.New("search: service unavailable")), step.OnStep(context.Background(), stepContext))
+	require.Equal(t, 1, called)
+}
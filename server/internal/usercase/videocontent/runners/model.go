@@ -9,10 +9,16 @@ type Type string
 const (
 	TVShowDelivery Type = "tv_show_delivery"
 	TVShowDelete   Type = "tv_show_delete"
+	TVShowCancel   Type = "tv_show_cancel"
+	TVShowUpdate   Type = "tv_show_update"
+	MovieDelivery  Type = "movie_delivery"
+	MovieDelete    Type = "movie_delete"
 )
 
 type Metadata struct {
 	ContentID common.ContentID
+	// Version версия видеоконтента, добавляется суффиксом к каталогам и файлам на медиа сервере
+	Version string
 }
 
 type FailData struct {
@@ -0,0 +1,17 @@
+package moviedeletestate
+
+import (
+	"context"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelete"
+)
+
+type ContentDeleted interface {
+	DeleteMovieFromMediaServer(ctx context.Context, moviePath moviedelete.MovieCatalogPath) error
+	DeleteMovieFiles(ctx context.Context, moviePath moviedelete.MovieCatalogPath) error
+	DeleteMovieVersionFiles(ctx context.Context, moviePath moviedelete.MovieCatalogPath) error
+	DeleteTorrentFiles(ctx context.Context, torrentPath string) error
+	DeleteTorrentFromTorrentClient(ctx context.Context, magnetHash string) error
+	DeleteLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error
+}
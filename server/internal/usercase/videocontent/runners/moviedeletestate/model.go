@@ -0,0 +1,76 @@
+package moviedeletestate
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelete"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+// StepDelete статус удаления файлов фильма
+type StepDelete string
+
+const (
+	// StartDeleteMovie начальный шаг для удаления фильма
+	StartDeleteMovie StepDelete = "start_delete_movie"
+	// DeleteTorrentFromTorrentClient - удаление торрент раздачи из торрент клиента
+	DeleteTorrentFromTorrentClient StepDelete = "delete_torrent_from_torrent_client"
+	// DeleteTorrentFiles удаление файлов раздачи с диска
+	DeleteTorrentFiles StepDelete = "delete_torrent_files"
+	// DeleteMovieFiles удаление каталога фильма с медиасервера
+	DeleteMovieFiles StepDelete = "delete_movie_files_from_media_server"
+	// DeleteMovieFromMediaServer проверка что фильм удален из медиасервера
+	DeleteMovieFromMediaServer StepDelete = "delete_movie_from_media_server"
+	// DeleteLabel удаление лейбла
+	DeleteLabel StepDelete = "delete_label"
+)
+
+// MovieDeleteData модель содержащая информацию о процессе удаления файлов фильма
+type MovieDeleteData struct {
+	// Хеш торрент раздачи (что бы удалить раздачу в торрент клиенте)
+	MagnetHash string
+	// Путь до раздачи фильма
+	TorrentPath string
+	// Путь каталога фильма на медиа сервере
+	MovieCatalogPath moviedelete.MovieCatalogPath
+	// KeepLabel у фильма остались другие версии с файлами, лейбл HasVideoContentFiles не удаляется
+	KeepLabel bool
+	// KeepCatalog каталог фильма общий с другими версиями, удаляются только файлы версии
+	KeepCatalog bool
+	// StepRetry автоматические повторы шага после временной ошибки
+	StepRetry *runners.StepRetry
+}
+
+func (d MovieDeleteData) GetStepRetry() *runners.StepRetry {
+	return d.StepRetry
+}
+
+func (d MovieDeleteData) WithStepRetry(retry *runners.StepRetry) MovieDeleteData {
+	d.StepRetry = retry
+	return d
+}
+
+type CreateOptions struct {
+	//
+	Index int
+	// VideoContentID видеоконтент, к которому относится стейт
+	VideoContentID uuid.UUID
+
+	MovieID uint64
+	// Хеш торрент раздачи (что бы удалить раздачу в торрент клиенте)
+	MagnetHash string
+	// Путь до раздачи фильма
+	TorrentPath string
+	// Путь каталога фильма на медиа сервере
+	MovieCatalogPath moviedelete.MovieCatalogPath
+	// KeepLabel не удалять лейбл, у фильма остались другие версии с файлами
+	KeepLabel bool
+	// KeepCatalog не удалять каталог фильма, в нем лежат файлы других версий
+	KeepCatalog bool
+}
+
+func (c CreateOptions) GetIdempotencyKey() string {
+	return fmt.Sprintf("delete_movie_%d_content_%s_n_%d", c.MovieID, c.VideoContentID, c.Index)
+}
@@ -0,0 +1,130 @@
+package moviedeletestate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/statemachine"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type Runner struct {
+	contentDeleted ContentDeleted
+	backoff        runners.Backoff[MovieDeleteData, StepDelete]
+}
+
+func NewTaskRunner(contentDeleted ContentDeleted) *Runner {
+	return &Runner{
+		contentDeleted: contentDeleted,
+		backoff:        runners.Backoff[MovieDeleteData, StepDelete]{Policies: retryPolicies, Clock: &common.RealClock{}},
+	}
+}
+
+func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState, error) {
+	data := MovieDeleteData{
+		MagnetHash:       options.MagnetHash,
+		TorrentPath:      options.TorrentPath,
+		MovieCatalogPath: options.MovieCatalogPath,
+		KeepLabel:        options.KeepLabel,
+		KeepCatalog:      options.KeepCatalog,
+	}
+
+	movieID := options.MovieID
+	return CreateState{
+		FirstStep: StartDeleteMovie,
+		Data:      data,
+		MetaData: runners.Metadata{
+			ContentID: common.ContentID{
+				MovieID:        &movieID,
+				VideoContentID: &options.VideoContentID,
+			},
+		},
+	}, nil
+}
+
+func (r *Runner) Type() runners.Type {
+	return runners.MovieDelete
+}
+
+func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepRegistration {
+	registration := StepRegistration{
+		Steps: map[StepDelete]Step{
+			StartDeleteMovie: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// начальный шаг
+					return stepContext.Next(DeleteTorrentFromTorrentClient)
+				},
+			},
+			DeleteTorrentFromTorrentClient: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// удаление торрент раздачи из торрент клиента
+					data := stepContext.State.Data
+					err := r.contentDeleted.DeleteTorrentFromTorrentClient(ctx, data.MagnetHash)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteTorrentFromTorrentClient: %w", err))
+					}
+					return stepContext.Next(DeleteTorrentFiles)
+				},
+			},
+			DeleteTorrentFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// удаление файлов раздачи с диска
+					data := stepContext.State.Data
+					err := r.contentDeleted.DeleteTorrentFiles(ctx, data.TorrentPath)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteTorrentFiles: %w", err))
+					}
+					return stepContext.Next(DeleteMovieFiles)
+				},
+			},
+			DeleteMovieFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// удаление каталога фильма с медиасервера
+					data := stepContext.State.Data
+					if data.KeepCatalog {
+						// Каталог фильма остается у других версий, медиасервер продолжит его видеть
+						err := r.contentDeleted.DeleteMovieVersionFiles(ctx, data.MovieCatalogPath)
+						if err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("DeleteMovieVersionFiles: %w", err))
+						}
+						return stepContext.Next(DeleteLabel)
+					}
+					err := r.contentDeleted.DeleteMovieFiles(ctx, data.MovieCatalogPath)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteMovieFiles: %w", err))
+					}
+					return stepContext.Next(DeleteMovieFromMediaServer)
+				},
+			},
+			DeleteMovieFromMediaServer: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// проверка что медиасервер больше не видит фильм
+					data := stepContext.State.Data
+					err := r.contentDeleted.DeleteMovieFromMediaServer(ctx, data.MovieCatalogPath)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteMovieFromMediaServer: %w", err))
+					}
+					return stepContext.Next(DeleteLabel)
+				},
+			},
+			DeleteLabel: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					if stepContext.State.Data.KeepLabel {
+						return stepContext.Complete()
+					}
+					data := stepContext.State.MetaData
+					err := r.contentDeleted.DeleteLabelHasVideoContentFiles(ctx, data.ContentID)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteLabelHasVideoContentFiles: %w", err))
+					}
+					return stepContext.Complete()
+				},
+			},
+		},
+	}
+
+	r.backoff.Register(registration.Steps)
+	return registration
+}
@@ -0,0 +1,23 @@
+package moviedeletestate
+
+import (
+	"github.com/kkiling/statemachine"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type CreateState = statemachine.CreateState[MovieDeleteData, runners.Metadata, StepDelete]
+type State = statemachine.State[MovieDeleteData, runners.FailData, runners.Metadata, StepDelete, runners.Type]
+type Step = statemachine.Step[MovieDeleteData, runners.FailData, runners.Metadata, StepDelete, runners.Type]
+type StepRegistration = statemachine.StepRegistration[MovieDeleteData, runners.FailData, runners.Metadata, StepDelete, runners.Type]
+type StepContext = statemachine.StepContext[MovieDeleteData, runners.FailData, runners.Metadata, StepDelete, runners.Type]
+type StepResult = statemachine.StepResult[MovieDeleteData, StepDelete]
+type StateMachineService = statemachine.StateMachine[MovieDeleteData, runners.FailData, runners.Metadata, StepDelete, runners.Type, CreateOptions]
+
+func NewState(contentDeleted ContentDeleted, stateMachineStorage statemachine.Storage) *StateMachineService {
+	return statemachine.NewService[MovieDeleteData, runners.FailData, runners.Metadata, StepDelete, runners.Type, CreateOptions](
+		statemachine.Config{},
+		stateMachineStorage,
+		NewTaskRunner(contentDeleted),
+	)
+}
@@ -0,0 +1,29 @@
+package moviedeliverystate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+)
+
+type ContentDelivery interface {
+	GenerateSearchQuery(ctx context.Context, params moviedelivery.GenerateSearchQueryParams) (*moviedelivery.SearchQuery, error)
+	SearchTorrent(ctx context.Context, params moviedelivery.SearchTorrentParams) ([]moviedelivery.TorrentSearch, error)
+	GetMagnetLink(ctx context.Context, params moviedelivery.GetMagnetLinkParams) (*moviedelivery.MagnetLink, error)
+	AddTorrentToTorrentClient(ctx context.Context, params moviedelivery.AddTorrentParams) error
+	WaitingTorrentFiles(ctx context.Context, params moviedelivery.WaitingTorrentFilesParams) (*moviedelivery.TorrentFilesData, error)
+	GetMovieData(ctx context.Context, params moviedelivery.GetMovieDataParams) (*moviedelivery.MovieData, error)
+	PrepareMovieMatch(ctx context.Context, params moviedelivery.PrepareMovieMatchParams) (*moviedelivery.MovieMatch, error)
+	WaitingTorrentDownloadComplete(ctx context.Context, params moviedelivery.WaitingTorrentDownloadCompleteParams) (*moviedelivery.TorrentDownloadStatus, error)
+	CreateContentCatalogs(ctx context.Context, params moviedelivery.CreateContentCatalogsParams) error
+	NeedMergeMovie(match *moviedelivery.MovieMatch) bool
+	StartMergeVideo(ctx context.Context, params moviedelivery.MergeVideoParams) (uuid.UUID, error)
+	GetMergeVideoStatus(ctx context.Context, mergeID uuid.UUID) (*moviedelivery.MergeVideoStatus, error)
+	CreateHardLinkCopyToMediaServer(ctx context.Context, params moviedelivery.CreateHardLinkCopyParams) error
+	GetCatalogSize(ctx context.Context, catalogPath string) (uint64, error)
+	SetMediaMetaData(ctx context.Context, params moviedelivery.SetMediaMetaDataParams) error
+	AddLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error
+}
@@ -0,0 +1,119 @@
+package moviedeliverystate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+// StepDelivery шаг доставки файлов фильма до медиа сервера
+type StepDelivery string
+
+const (
+	// GenerateSearchQuery - генерация запросса к трекеру по названию и году выхода фильма
+	GenerateSearchQuery StepDelivery = "generate_search_query"
+	// SearchTorrents - ищем раздачи фильма
+	SearchTorrents StepDelivery = "search_torrents"
+	// WaitingUserChoseTorrent - ожидание когда пользователь выберет раздачу
+	WaitingUserChoseTorrent StepDelivery = "waiting_user_chose_torrent"
+	// GetMagnetLink получение магнет ссылки
+	GetMagnetLink StepDelivery = "get_magnet_link"
+	// AddTorrentToTorrentClient Добавление раздачи для скачивания торрент клиентом
+	AddTorrentToTorrentClient StepDelivery = "add_torrent_to_torrent_client"
+	// WaitingTorrentFiles Ожидание когда появится информация о файлах в раздаче
+	WaitingTorrentFiles StepDelivery = "waiting_torrent_files"
+	// GetMovieData получение информации о фильме и каталоге фильма
+	GetMovieData StepDelivery = "get_movie_data"
+	// PrepareMovieMatch выбор основного видеофайла раздачи
+	PrepareMovieMatch StepDelivery = "prepare_movie_match"
+	// WaitingTorrentDownloadComplete ожидание завершения окончания скачивания раздачи
+	WaitingTorrentDownloadComplete StepDelivery = "waiting_torrent_download_complete"
+	// CreateVideoContentCatalogs Формирование каталога фильма
+	CreateVideoContentCatalogs StepDelivery = "create_video_content_catalogs"
+	// DeterminingNeedConvertFiles Определение необходимости конвертации файлов
+	DeterminingNeedConvertFiles StepDelivery = "determining_need_convert_files"
+	// --- Ветвь если необходимо добавление аудио дорожек/субтитров
+
+	// StartMergeVideoFiles Запуск конвертирования видеофайла - полученный файл сразу сохраняются в каталог медиасервера
+	StartMergeVideoFiles StepDelivery = "merge_video_files"
+	// WaitingMergeVideoFiles ожидание завершения конвертации видеофайла
+	WaitingMergeVideoFiles StepDelivery = "waiting_merge_video_files"
+
+	// -- Ветвь если не нужно изменять исходные файлы
+
+	// CreateHardLinkCopy создание хардлинка основного видеофайла в каталоге медиасервера
+	CreateHardLinkCopy StepDelivery = "create_hardlink_copy"
+	// GetCatalogsSize получение размеров каталогов фильма
+	GetCatalogsSize StepDelivery = "get_catalogs_size"
+
+	// SetMediaMetaData установка методаных фильма в медиасервере
+	SetMediaMetaData StepDelivery = "set_media_meta_data"
+	// AddLabel Установить лейбл для видеоконтента
+	AddLabel StepDelivery = "add_label"
+)
+
+// MovieDeliveryData модель содержащая информацию о доставке фильма
+type MovieDeliveryData struct {
+	// SearchQuery сформированный запрос на основе названия фильма
+	SearchQuery *moviedelivery.SearchQuery
+	// TorrentSearch Результат поиска торрентов
+	TorrentSearch []moviedelivery.TorrentSearch
+	// Torrent данные найденной раздачи
+	Torrent *moviedelivery.Torrent
+	// TorrentFilesData файлы раздачи
+	TorrentFilesData *moviedelivery.TorrentFilesData
+	// MovieData информация о каталоге фильма на медиасервере
+	MovieData *moviedelivery.MovieData
+	// MovieMatch основной видеофайл и внешние дорожки
+	MovieMatch *moviedelivery.MovieMatch
+	// TorrentDownloadStatus статус скачивания раздачи
+	TorrentDownloadStatus *moviedelivery.TorrentDownloadStatus
+	// MergeID идентификатор сшивания файлов (если нужен)
+	MergeID *uuid.UUID
+	// MergeVideoStatus статус сшивания файлов (если нужен)
+	MergeVideoStatus *moviedelivery.MergeVideoStatus
+	// MovieCatalogInfo информация о каталогах фильма
+	MovieCatalogInfo *moviedelivery.MovieCatalog
+	// RetryHistory история ручных повторов шагов, завершившихся ошибкой
+	RetryHistory []RetryAttempt
+	// StepRetry автоматические повторы шага после временной ошибки
+	StepRetry *runners.StepRetry
+}
+
+func (d MovieDeliveryData) GetStepRetry() *runners.StepRetry {
+	return d.StepRetry
+}
+
+func (d MovieDeliveryData) WithStepRetry(retry *runners.StepRetry) MovieDeliveryData {
+	d.StepRetry = retry
+	return d
+}
+
+// RetryAttempt информация о ручном повторе шага
+type RetryAttempt struct {
+	// Step шаг, который повторили
+	Step StepDelivery
+	// Error ошибка, с которой завершилось последнее выполнение шага
+	Error string
+	// RetriedAt время запроса повтора
+	RetriedAt time.Time
+	// Href новая раздача, если была указана
+	Href *string
+}
+
+type CreateOptions struct {
+	Index int
+	// VideoContentID видеоконтент, к которому относится стейт
+	VideoContentID uuid.UUID
+	// Version версия видеоконтента
+	Version string
+	MovieID uint64
+}
+
+func (c CreateOptions) GetIdempotencyKey() string {
+	return fmt.Sprintf("delivery_movie_%d_content_%s_n_%d", c.MovieID, c.VideoContentID, c.Index)
+}
@@ -0,0 +1,18 @@
+package moviedeliverystate
+
+import "time"
+
+type ChoseTorrentOptions struct {
+	// Пользователь выбрал конкретный торрента файл
+	Href *string
+	// Пользователь поменял поисковый запрос
+	NewSearchQuery *string
+}
+
+// RetryStepOptions повторное выполнение шага, завершившегося ошибкой
+type RetryStepOptions struct {
+	// RetriedAt время запроса повтора
+	RetriedAt time.Time
+	// Href новая раздача (только для шагов до добавления раздачи в торрент клиент)
+	Href *string
+}
@@ -0,0 +1,89 @@
+package moviedeliverystate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/samber/lo"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+)
+
+// hrefRetrySteps шаги, на которых еще можно заменить раздачу - в торрент клиент она еще не добавлена
+var hrefRetrySteps = []StepDelivery{
+	GetMagnetLink,
+	AddTorrentToTorrentClient,
+}
+
+// ValidateRetryStep проверка что стейт можно повторить с шага, завершившегося ошибкой
+func ValidateRetryStep(state *State, opts RetryStepOptions) error {
+	if state.Error == nil {
+		return fmt.Errorf("step %s has no error: %w", state.Step, ucerr.InvalidArgument)
+	}
+	// На шаге ожидания пользователя свои опции
+	if state.Step == WaitingUserChoseTorrent {
+		return fmt.Errorf("step %s is waiting user options: %w", state.Step, ucerr.InvalidArgument)
+	}
+	if opts.Href != nil {
+		if *opts.Href == "" {
+			return fmt.Errorf("href is empty: %w", ucerr.InvalidArgument)
+		}
+		if !lo.Contains(hrefRetrySteps, state.Step) {
+			return fmt.Errorf("href can not be changed on step %s: %w", state.Step, ucerr.InvalidArgument)
+		}
+		// Раздачу можно заменить только на другую из результатов поиска
+		contains := lo.ContainsBy(state.Data.TorrentSearch, func(item moviedelivery.TorrentSearch) bool {
+			return item.Href == *opts.Href
+		})
+		if !contains {
+			return fmt.Errorf("no such href: %w", ucerr.InvalidArgument)
+		}
+	}
+	return nil
+}
+
+// withRetry добавляет шагу возможность повтора с опцией RetryStepOptions
+/*
+	Повтор только сохраняет историю и новые данные, сам шаг выполнится
+	при следующем добивании стейта уже без опций
+*/
+func (r *Runner) withRetry(step StepDelivery, s Step) Step {
+	onStep := s.OnStep
+	return Step{
+		OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+			opts := RetryStepOptions{}
+			ok, err := stepContext.GetOptions(&opts)
+			if err != nil {
+				return stepContext.Error(err)
+			}
+			if !ok {
+				return onStep(ctx, stepContext)
+			}
+
+			data := stepContext.State.Data
+			data.RetryHistory = append(data.RetryHistory, RetryAttempt{
+				Step:      step,
+				Error:     lo.FromPtr(stepContext.State.Error),
+				RetriedAt: opts.RetriedAt,
+				Href:      opts.Href,
+			})
+			// Ручной повтор начинает автоматические повторы заново
+			data.StepRetry = nil
+
+			if opts.Href != nil {
+				data.Torrent = &moviedelivery.Torrent{
+					Href: *opts.Href,
+				}
+				// Для новой раздачи нужно заново получить магнет ссылку
+				if step != GetMagnetLink {
+					return stepContext.Next(GetMagnetLink).WithData(data)
+				}
+			}
+
+			return stepContext.Empty().WithData(data)
+		},
+		OptionsType: reflect.TypeOf(RetryStepOptions{}),
+	}
+}
@@ -0,0 +1,80 @@
+package moviedeliverystate
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+)
+
+func TestValidateRetryStep(t *testing.T) {
+	newState := func(step StepDelivery, stepErr *string) *State {
+		state := &State{Step: step, Error: stepErr}
+		state.Data.TorrentSearch = []moviedelivery.TorrentSearch{
+			{Href: "https://rutracker.org/forum/viewtopic.php?t=1"},
+			{Href: "https://rutracker.org/forum/viewtopic.php?t=2"},
+		}
+		return state
+	}
+	stepErr := lo.ToPtr("emby unavailable")
+	href := lo.ToPtr("https://rutracker.org/forum/viewtopic.php?t=2")
+
+	tests := []struct {
+		name    string
+		state   *State
+		opts    RetryStepOptions
+		wantErr bool
+	}{
+		{
+			name:  "retry without overrides",
+			state: newState(SetMediaMetaData, stepErr),
+		},
+		{
+			name:    "step without error",
+			state:   newState(SetMediaMetaData, nil),
+			wantErr: true,
+		},
+		{
+			name:    "waiting user step",
+			state:   newState(WaitingUserChoseTorrent, stepErr),
+			wantErr: true,
+		},
+		{
+			name:  "new href before torrent added",
+			state: newState(AddTorrentToTorrentClient, stepErr),
+			opts:  RetryStepOptions{Href: href},
+		},
+		{
+			name:    "new href after torrent added",
+			state:   newState(WaitingTorrentFiles, stepErr),
+			opts:    RetryStepOptions{Href: href},
+			wantErr: true,
+		},
+		{
+			name:    "href not from search results",
+			state:   newState(GetMagnetLink, stepErr),
+			opts:    RetryStepOptions{Href: lo.ToPtr("https://rutracker.org/forum/viewtopic.php?t=3")},
+			wantErr: true,
+		},
+		{
+			name:    "empty href",
+			state:   newState(GetMagnetLink, stepErr),
+			opts:    RetryStepOptions{Href: lo.ToPtr("")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRetryStep(tt.state, tt.opts)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ucerr.InvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package moviedeliverystate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type Runner struct {
+	contentDelivery ContentDelivery
+	backoff         runners.Backoff[MovieDeliveryData, StepDelivery]
+}
+
+func NewTaskRunner(contentDelivery ContentDelivery) *Runner {
+	return &Runner{
+		contentDelivery: contentDelivery,
+		backoff:         runners.Backoff[MovieDeliveryData, StepDelivery]{Policies: retryPolicies, Clock: &common.RealClock{}},
+	}
+}
+
+func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState, error) {
+	data := MovieDeliveryData{}
+
+	return CreateState{
+		FirstStep: GenerateSearchQuery,
+		Data:      data,
+		MetaData: runners.Metadata{
+			ContentID: common.ContentID{
+				MovieID:        &options.MovieID,
+				VideoContentID: &options.VideoContentID,
+			},
+			Version: options.Version,
+		},
+	}, nil
+}
+
+func (r *Runner) Type() runners.Type {
+	return runners.MovieDelivery
+}
+
+func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepRegistration {
+	registration := StepRegistration{
+		Steps: map[StepDelivery]Step{
+			GenerateSearchQuery: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Генерация поискового запроса по названию и году выхода фильма
+					data := stepContext.State.Data
+
+					res, err := r.contentDelivery.GenerateSearchQuery(ctx, moviedelivery.GenerateSearchQueryParams{
+						MovieID: *stepContext.State.MetaData.ContentID.MovieID,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("GenerateSearchQuery: %w", err))
+					}
+
+					data.SearchQuery = res
+					return stepContext.Next(SearchTorrents).WithData(data)
+				},
+			},
+			SearchTorrents: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// ищем раздачи фильма
+					data := stepContext.State.Data
+					res, err := r.contentDelivery.SearchTorrent(ctx, moviedelivery.SearchTorrentParams{
+						SearchQuery: data.SearchQuery.Query,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("SearchTorrent: %w", err))
+					}
+					data.TorrentSearch = res
+					return stepContext.Next(WaitingUserChoseTorrent).WithData(data)
+				},
+			},
+			WaitingUserChoseTorrent: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Ожидаем когда пользователь выберет раздачу
+					// Или ожидаем что клиент изменит поисковый запрос, тогда прыгаем на SearchTorrents
+					opts := ChoseTorrentOptions{}
+					ok, err := stepContext.GetOptions(&opts)
+					if err != nil {
+						return stepContext.Error(err)
+					}
+					if !ok { // Пока не получили опцию, не идем дальше
+						return stepContext.Empty()
+					}
+					if opts.Href == nil && opts.NewSearchQuery == nil {
+						return stepContext.Error(fmt.Errorf("either Href or NewSearchQuery must be specified: %w", ucerr.InvalidArgument))
+					} else if opts.Href != nil && opts.NewSearchQuery != nil {
+						return stepContext.Error(fmt.Errorf("only one of Href or NewSearchQuery can be specified: %w", ucerr.InvalidArgument))
+					}
+
+					data := stepContext.State.Data
+
+					// Пользователь изменил поисковый запрос
+					if opts.NewSearchQuery != nil {
+						data.SearchQuery.Query = *opts.NewSearchQuery
+						return stepContext.Next(SearchTorrents).WithData(data)
+					}
+
+					// Пользователь выбрал раздачу для скачивания
+					// Проверяем что клиент выбрал href из списка
+					contains := lo.ContainsBy(data.TorrentSearch, func(item moviedelivery.TorrentSearch) bool {
+						return item.Href == *opts.Href
+					})
+					if !contains {
+						return stepContext.Error(fmt.Errorf("no such href: %w", ucerr.InvalidArgument))
+					}
+
+					data.Torrent = &moviedelivery.Torrent{
+						Href: *opts.Href,
+					}
+
+					return stepContext.Next(GetMagnetLink).WithData(data)
+				},
+				OptionsType: reflect.TypeOf(ChoseTorrentOptions{}),
+			},
+			GetMagnetLink: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Получение магнет ссылки
+					data := stepContext.State.Data
+					res, err := r.contentDelivery.GetMagnetLink(ctx, moviedelivery.GetMagnetLinkParams{
+						Href: data.Torrent.Href,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("GetMagnetLink: %w", err))
+					}
+
+					data.Torrent.MagnetLink = res
+					return stepContext.Next(AddTorrentToTorrentClient).WithData(data)
+				},
+			},
+			AddTorrentToTorrentClient: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Добавление раздачи для скачивания торрент клиентом
+					data := stepContext.State.Data
+					err := r.contentDelivery.AddTorrentToTorrentClient(ctx, moviedelivery.AddTorrentParams{
+						MovieID: *stepContext.State.MetaData.ContentID.MovieID,
+						Magnet:  data.Torrent.MagnetLink.Magnet,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("AddTorrentToTorrentClient: %w", err))
+					}
+					return stepContext.Next(WaitingTorrentFiles)
+				},
+			},
+			WaitingTorrentFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Ожидание когда появится информация о файлах в раздаче
+					data := stepContext.State.Data
+					res, err := r.contentDelivery.WaitingTorrentFiles(ctx, moviedelivery.WaitingTorrentFilesParams{
+						Hash: data.Torrent.MagnetLink.Hash,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentFiles: %w", err))
+					}
+					if res == nil {
+						data.TorrentDownloadStatus, err = r.contentDelivery.WaitingTorrentDownloadComplete(ctx, moviedelivery.WaitingTorrentDownloadCompleteParams{
+							Hash: data.Torrent.MagnetLink.Hash,
+						})
+						if err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
+						}
+						return stepContext.Empty().WithData(data)
+					}
+					data.TorrentFilesData = res
+					return stepContext.Next(GetMovieData).WithData(data)
+				},
+			},
+			GetMovieData: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Получение информации о фильме и формирование пути каталога фильма
+					data := stepContext.State.Data
+					res, err := r.contentDelivery.GetMovieData(ctx, moviedelivery.GetMovieDataParams{
+						MovieID: *stepContext.State.MetaData.ContentID.MovieID,
+						Version: stepContext.State.MetaData.Version,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("GetMovieData: %w", err))
+					}
+					data.MovieData = res
+					return stepContext.Next(PrepareMovieMatch).WithData(data)
+				},
+			},
+			PrepareMovieMatch: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Выбор основного видеофайла раздачи
+					data := stepContext.State.Data
+					res, err := r.contentDelivery.PrepareMovieMatch(ctx, moviedelivery.PrepareMovieMatchParams{
+						TorrentFiles:     data.TorrentFilesData.Files,
+						MovieCatalogPath: data.MovieData.MovieCatalogPath,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("PrepareMovieMatch: %w", err))
+					}
+					data.MovieMatch = res
+					return stepContext.Next(WaitingTorrentDownloadComplete).WithData(data)
+				},
+			},
+			WaitingTorrentDownloadComplete: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Ожидание когда торрент докачается до конца
+					data := stepContext.State.Data
+					res, err := r.contentDelivery.WaitingTorrentDownloadComplete(ctx, moviedelivery.WaitingTorrentDownloadCompleteParams{
+						Hash: data.Torrent.MagnetLink.Hash,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
+					}
+					data.TorrentDownloadStatus = res
+					if res.IsComplete {
+						return stepContext.Next(CreateVideoContentCatalogs).WithData(data)
+					}
+					return stepContext.Empty().WithData(data)
+				},
+			},
+			CreateVideoContentCatalogs: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Формирование каталога фильма
+					data := stepContext.State.Data
+					err := r.contentDelivery.CreateContentCatalogs(ctx, moviedelivery.CreateContentCatalogsParams{
+						MovieCatalogPath: data.MovieData.MovieCatalogPath,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("CreateContentCatalogs: %w", err))
+					}
+
+					return stepContext.Next(DeterminingNeedConvertFiles)
+				},
+			},
+			DeterminingNeedConvertFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Определение необходимости сшивания видеофайла с внешними дорожками
+					data := stepContext.State.Data
+					if r.contentDelivery.NeedMergeMovie(data.MovieMatch) {
+						return stepContext.Next(StartMergeVideoFiles)
+					}
+					return stepContext.Next(CreateHardLinkCopy)
+				},
+			},
+			CreateHardLinkCopy: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Создание хардлинка основного видеофайла в каталоге медиасервера
+					data := stepContext.State.Data
+					if err := r.contentDelivery.CreateHardLinkCopyToMediaServer(ctx, moviedelivery.CreateHardLinkCopyParams{
+						MovieMatch: data.MovieMatch,
+					}); err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("CreateHardLinkCopyToMediaServer: %w", err))
+					}
+
+					return stepContext.Next(GetCatalogsSize)
+				},
+			},
+			StartMergeVideoFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Сшивание видеофайла - полученный файл сразу сохраняется в каталог медиасервера
+					data := stepContext.State.Data
+					mergeID, err := r.contentDelivery.StartMergeVideo(ctx, moviedelivery.MergeVideoParams{
+						IdempotencyKey: stepContext.State.ID.String(),
+						MovieMatch:     data.MovieMatch,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("StartMergeVideoFiles: %w", err))
+					}
+					data.MergeID = &mergeID
+					return stepContext.Next(WaitingMergeVideoFiles).WithData(data)
+				},
+			},
+			WaitingMergeVideoFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					status, err := r.contentDelivery.GetMergeVideoStatus(ctx, *data.MergeID)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingMergeVideoFiles: %w", err))
+					}
+
+					data.MergeVideoStatus = status
+					if status.IsComplete {
+						if len(status.Errors) == 0 {
+							return stepContext.Next(GetCatalogsSize).WithData(data)
+						}
+						return stepContext.Error(fmt.Errorf("merge videos contains errors")).WithData(data)
+					}
+					return stepContext.Empty().WithData(data)
+				},
+			},
+			GetCatalogsSize: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+
+					data.MovieCatalogInfo = &moviedelivery.MovieCatalog{
+						TorrentPath:              data.TorrentFilesData.ContentFullPath,
+						MediaServerPath:          data.MovieData.MovieCatalogPath,
+						IsCopyFilesInMediaServer: r.contentDelivery.NeedMergeMovie(data.MovieMatch),
+					}
+
+					torrentSize, err := r.contentDelivery.GetCatalogSize(ctx, data.MovieCatalogInfo.TorrentPath)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("contentDelivery.GetCatalogSize: %w", err))
+					}
+					mediaServerSize, err := r.contentDelivery.GetCatalogSize(ctx, data.MovieCatalogInfo.MediaServerPath.MoviePath)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("contentDelivery.GetCatalogSize: %w", err))
+					}
+
+					data.MovieCatalogInfo.TorrentSize = torrentSize
+					data.MovieCatalogInfo.TorrentSizePretty = formatBytesWithPrecision(torrentSize, 2)
+					data.MovieCatalogInfo.MediaServerSize = mediaServerSize
+					data.MovieCatalogInfo.MediaServerSizePretty = formatBytesWithPrecision(mediaServerSize, 2)
+
+					return stepContext.Next(SetMediaMetaData).WithData(data)
+				},
+			},
+			SetMediaMetaData: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					err := r.contentDelivery.SetMediaMetaData(ctx, moviedelivery.SetMediaMetaDataParams{
+						MovieFilePath: data.MovieMatch.MovieFile.FullPath,
+						MovieID:       *stepContext.State.MetaData.ContentID.MovieID,
+					})
+					if err != nil {
+						if errors.Is(err, apierr.ContentNotFound) {
+							// emby не сразу раздупляет, поможет ретрай
+							return stepContext.Empty()
+						}
+						return r.backoff.StepError(stepContext, fmt.Errorf("SetMediaMetaData: %w", err))
+					}
+
+					return stepContext.Next(AddLabel)
+				},
+			},
+			AddLabel: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					err := r.contentDelivery.AddLabelHasVideoContentFiles(ctx, stepContext.State.MetaData.ContentID)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("AddLabelHasVideoContentFiles: %w", err))
+					}
+					return stepContext.Complete()
+				},
+			},
+		},
+	}
+
+	r.backoff.Register(registration.Steps)
+	for step, s := range registration.Steps {
+		// Шаг ожидания пользователя принимает свои опции и не повторяется автоматически
+		if s.OptionsType == nil {
+			registration.Steps[step] = r.withRetry(step, s)
+		}
+	}
+	return registration
+}
@@ -0,0 +1,146 @@
+package moviedeliverystate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type fakeContentDelivery struct {
+	ContentDelivery
+	searchQuery    *moviedelivery.SearchQuery
+	searchErr      error
+	downloadStatus *moviedelivery.TorrentDownloadStatus
+	mergeStatus    *moviedelivery.MergeVideoStatus
+}
+
+func (f *fakeContentDelivery) GenerateSearchQuery(_ context.Context, _ moviedelivery.GenerateSearchQueryParams) (*moviedelivery.SearchQuery, error) {
+	return f.searchQuery, nil
+}
+
+func (f *fakeContentDelivery) SearchTorrent(_ context.Context, _ moviedelivery.SearchTorrentParams) ([]moviedelivery.TorrentSearch, error) {
+	return nil, f.searchErr
+}
+
+func (f *fakeContentDelivery) WaitingTorrentDownloadComplete(_ context.Context, _ moviedelivery.WaitingTorrentDownloadCompleteParams) (*moviedelivery.TorrentDownloadStatus, error) {
+	return f.downloadStatus, nil
+}
+
+func (f *fakeContentDelivery) NeedMergeMovie(match *moviedelivery.MovieMatch) bool {
+	return len(match.AudioTracks) > 0
+}
+
+func (f *fakeContentDelivery) GetMergeVideoStatus(_ context.Context, _ uuid.UUID) (*moviedelivery.MergeVideoStatus, error) {
+	return f.mergeStatus, nil
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func newStepContext(step StepDelivery, data MovieDeliveryData) StepContext {
+	movieID := uint64(27205)
+	stepContext := StepContext{}
+	stepContext.State.Step = step
+	stepContext.State.Data = data
+	stepContext.State.MetaData.ContentID.MovieID = &movieID
+	return stepContext
+}
+
+func TestRunnerCreate(t *testing.T) {
+	r := NewTaskRunner(nil)
+	videoContentID := uuid.New()
+
+	state, err := r.Create(context.Background(), CreateOptions{
+		MovieID:        27205,
+		VideoContentID: videoContentID,
+		Version:        "4K",
+	})
+	require.NoError(t, err)
+	require.Equal(t, GenerateSearchQuery, state.FirstStep)
+	require.Nil(t, state.Data.Torrent)
+	require.Equal(t, uint64(27205), *state.MetaData.ContentID.MovieID)
+	require.Equal(t, &videoContentID, state.MetaData.ContentID.VideoContentID)
+	require.Equal(t, "4K", state.MetaData.Version)
+	require.Equal(t, runners.MovieDelivery, r.Type())
+}
+
+func TestRunnerSteps(t *testing.T) {
+	ctx := context.Background()
+	contentDelivery := &fakeContentDelivery{}
+	r := NewTaskRunner(contentDelivery)
+	clock := &testClock{now: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+	r.backoff.Clock = clock
+	steps := r.StepRegistration(struct{}{}).Steps
+
+	t.Run("generate search query", func(t *testing.T) {
+		contentDelivery.searchQuery = &moviedelivery.SearchQuery{Query: "Начало 2010"}
+		stepContext := newStepContext(GenerateSearchQuery, MovieDeliveryData{})
+		res := steps[GenerateSearchQuery].OnStep(ctx, stepContext)
+		require.Equal(t, stepContext.Next(SearchTorrents).WithData(MovieDeliveryData{SearchQuery: contentDelivery.searchQuery}), res)
+	})
+
+	t.Run("tracker unavailable - step is retried", func(t *testing.T) {
+		contentDelivery.searchErr = fmt.Errorf("search: %w", apierr.ServiceUnavailableErr)
+		data := MovieDeliveryData{SearchQuery: &moviedelivery.SearchQuery{Query: "Начало 2010"}}
+		stepContext := newStepContext(SearchTorrents, data)
+		res := steps[SearchTorrents].OnStep(ctx, stepContext)
+
+		data.StepRetry = runners.NextRetry(retryPolicies.Get(SearchTorrents), nil, string(SearchTorrents),
+			fmt.Errorf("SearchTorrent: %w", contentDelivery.searchErr), clock.now)
+		require.Equal(t, stepContext.Empty().WithData(data), res)
+
+		// До следующей попытки шаг не выполняется
+		stepContext.State.Data.StepRetry = data.StepRetry
+		contentDelivery.searchErr = errors.New("must not be called")
+		require.Equal(t, stepContext.Empty(), steps[SearchTorrents].OnStep(ctx, stepContext))
+		contentDelivery.searchErr = nil
+	})
+
+	t.Run("waiting user chose torrent", func(t *testing.T) {
+		stepContext := newStepContext(WaitingUserChoseTorrent, MovieDeliveryData{})
+		require.Equal(t, stepContext.Empty(), steps[WaitingUserChoseTorrent].OnStep(ctx, stepContext))
+	})
+
+	t.Run("waiting torrent download complete", func(t *testing.T) {
+		data := MovieDeliveryData{Torrent: &moviedelivery.Torrent{MagnetLink: &moviedelivery.MagnetLink{Hash: "abc"}}}
+		stepContext := newStepContext(WaitingTorrentDownloadComplete, data)
+
+		contentDelivery.downloadStatus = &moviedelivery.TorrentDownloadStatus{IsComplete: false}
+		data.TorrentDownloadStatus = contentDelivery.downloadStatus
+		require.Equal(t, stepContext.Empty().WithData(data), steps[WaitingTorrentDownloadComplete].OnStep(ctx, stepContext))
+
+		contentDelivery.downloadStatus = &moviedelivery.TorrentDownloadStatus{IsComplete: true}
+		data.TorrentDownloadStatus = contentDelivery.downloadStatus
+		require.Equal(t, stepContext.Next(CreateVideoContentCatalogs).WithData(data), steps[WaitingTorrentDownloadComplete].OnStep(ctx, stepContext))
+	})
+
+	t.Run("determining need convert files", func(t *testing.T) {
+		stepContext := newStepContext(DeterminingNeedConvertFiles, MovieDeliveryData{MovieMatch: &moviedelivery.MovieMatch{}})
+		require.Equal(t, stepContext.Next(CreateHardLinkCopy), steps[DeterminingNeedConvertFiles].OnStep(ctx, stepContext))
+
+		stepContext.State.Data.MovieMatch.AudioTracks = []moviedelivery.Track{{Type: moviedelivery.TrackTypeAudio}}
+		require.Equal(t, stepContext.Next(StartMergeVideoFiles), steps[DeterminingNeedConvertFiles].OnStep(ctx, stepContext))
+	})
+
+	t.Run("merge video files with errors", func(t *testing.T) {
+		data := MovieDeliveryData{MergeID: new(uuid.UUID)}
+		stepContext := newStepContext(WaitingMergeVideoFiles, data)
+		contentDelivery.mergeStatus = &moviedelivery.MergeVideoStatus{IsComplete: true, Errors: []string{"mkvmerge failed"}}
+		data.MergeVideoStatus = contentDelivery.mergeStatus
+		require.Equal(t, stepContext.Error(fmt.Errorf("merge videos contains errors")).WithData(data), steps[WaitingMergeVideoFiles].OnStep(ctx, stepContext))
+	})
+}
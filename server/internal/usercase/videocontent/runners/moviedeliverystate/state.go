@@ -0,0 +1,23 @@
+package moviedeliverystate
+
+import (
+	"github.com/kkiling/statemachine"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type CreateState = statemachine.CreateState[MovieDeliveryData, runners.Metadata, StepDelivery]
+type State = statemachine.State[MovieDeliveryData, runners.FailData, runners.Metadata, StepDelivery, runners.Type]
+type Step = statemachine.Step[MovieDeliveryData, runners.FailData, runners.Metadata, StepDelivery, runners.Type]
+type StepRegistration = statemachine.StepRegistration[MovieDeliveryData, runners.FailData, runners.Metadata, StepDelivery, runners.Type]
+type StepContext = statemachine.StepContext[MovieDeliveryData, runners.FailData, runners.Metadata, StepDelivery, runners.Type]
+type StepResult = statemachine.StepResult[MovieDeliveryData, StepDelivery]
+type StateMachineService = statemachine.StateMachine[MovieDeliveryData, runners.FailData, runners.Metadata, StepDelivery, runners.Type, CreateOptions]
+
+func NewState(contentDelivery ContentDelivery, stateMachineStorage statemachine.Storage) *StateMachineService {
+	return statemachine.NewService[MovieDeliveryData, runners.FailData, runners.Metadata, StepDelivery, runners.Type, CreateOptions](
+		statemachine.Config{},
+		stateMachineStorage,
+		NewTaskRunner(contentDelivery),
+	)
+}
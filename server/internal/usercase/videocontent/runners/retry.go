@@ -0,0 +1,96 @@
+package runners
+
+import (
+	"time"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+// RetryPolicy политика автоматических повторов шага при временных ошибках
+type RetryPolicy struct {
+	// MaxAttempts после стольких неудачных попыток шаг завершается ошибкой
+	MaxAttempts int
+	// InitialBackoff задержка перед первым повтором, дальше удваивается
+	InitialBackoff time.Duration
+	// MaxBackoff максимальная задержка между повторами
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy политика повторов для шагов, у которых нет своей
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 10 * time.Second,
+	MaxBackoff:     5 * time.Minute,
+}
+
+// Backoff задержка перед повтором после attempt неудачных попыток
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, p.MaxBackoff)
+}
+
+// RetryPolicies политики повторов по шагам стейта
+type RetryPolicies[StepT ~string] map[StepT]RetryPolicy
+
+// Get политика повторов шага
+func (p RetryPolicies[StepT]) Get(step StepT) RetryPolicy {
+	if policy, ok := p[step]; ok {
+		return policy
+	}
+	return DefaultRetryPolicy
+}
+
+// StepRetry состояние автоматических повторов шага
+type StepRetry struct {
+	// Step шаг, который повторяется
+	Step string
+	// Attempts количество неудачных попыток
+	Attempts int
+	// NextAttemptAt время следующей попытки
+	NextAttemptAt time.Time
+	// LastError ошибка последней попытки
+	LastError string
+}
+
+// Wait шаг ждет следующей попытки
+// Когда попытки исчерпаны, шаг выполняется как обычно и завершается ошибкой
+func (r *StepRetry) Wait(step string, policy RetryPolicy, now time.Time) bool {
+	return r != nil && r.Step == step && !r.Exhausted(policy) && now.Before(r.NextAttemptAt)
+}
+
+// Stopped попытки повторов шага исчерпаны, шаг ждет ручного повтора
+func (r *StepRetry) Stopped(step string, policy RetryPolicy) bool {
+	return r != nil && r.Step == step && r.Exhausted(policy)
+}
+
+// Exhausted попытки повторов исчерпаны
+func (r *StepRetry) Exhausted(policy RetryPolicy) bool {
+	return r.Attempts >= policy.MaxAttempts
+}
+
+// NextRetry фиксирует неудачную попытку выполнения шага
+// Возвращает nil, если ошибка не временная и повторять шаг автоматически не нужно
+func NextRetry(policy RetryPolicy, retry *StepRetry, step string, err error, now time.Time) *StepRetry {
+	if !apierr.IsRetryable(err) {
+		return nil
+	}
+
+	attempts := 1
+	if retry != nil && retry.Step == step {
+		attempts = retry.Attempts + 1
+	}
+
+	return &StepRetry{
+		Step:          step,
+		Attempts:      attempts,
+		NextAttemptAt: now.Add(policy.Backoff(attempts)),
+		LastError:     err.Error(),
+	}
+}
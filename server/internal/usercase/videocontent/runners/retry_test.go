@@ -0,0 +1,85 @@
+package runners
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     time.Minute,
+	}
+
+	require.Equal(t, 10*time.Second, policy.Backoff(1))
+	require.Equal(t, 20*time.Second, policy.Backoff(2))
+	require.Equal(t, 40*time.Second, policy.Backoff(3))
+	require.Equal(t, time.Minute, policy.Backoff(4))
+	require.Equal(t, time.Minute, policy.Backoff(100))
+}
+
+func TestRetryPolicies_Get(t *testing.T) {
+	custom := RetryPolicy{MaxAttempts: 1}
+	policies := RetryPolicies[string]{"set_media_meta_data": custom}
+
+	require.Equal(t, custom, policies.Get("set_media_meta_data"))
+	require.Equal(t, DefaultRetryPolicy, policies.Get("add_label"))
+}
+
+func TestNextRetry(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     time.Minute,
+	}
+	unavailable := fmt.Errorf("SetMediaMetaData: %w", apierr.ServiceUnavailableErr)
+
+	t.Run("not retryable error", func(t *testing.T) {
+		require.Nil(t, NextRetry(policy, nil, "set_media_meta_data", fmt.Errorf("catalog is not empty"), now))
+	})
+
+	t.Run("attempts are counted per step", func(t *testing.T) {
+		retry := NextRetry(policy, nil, "set_media_meta_data", unavailable, now)
+		require.Equal(t, &StepRetry{
+			Step:          "set_media_meta_data",
+			Attempts:      1,
+			NextAttemptAt: now.Add(10 * time.Second),
+			LastError:     unavailable.Error(),
+		}, retry)
+		require.False(t, retry.Exhausted(policy))
+
+		retry = NextRetry(policy, retry, "set_media_meta_data", unavailable, now)
+		require.Equal(t, 2, retry.Attempts)
+		require.Equal(t, now.Add(20*time.Second), retry.NextAttemptAt)
+
+		retry = NextRetry(policy, retry, "set_media_meta_data", unavailable, now)
+		require.True(t, retry.Exhausted(policy))
+
+		// На другом шаге попытки считаются заново
+		retry = NextRetry(policy, retry, "add_label", fmt.Errorf("AddLabel: %w", apierr.NotAuthorizedErr), now)
+		require.Equal(t, 1, retry.Attempts)
+	})
+
+	t.Run("wait next attempt", func(t *testing.T) {
+		retry := NextRetry(policy, nil, "set_media_meta_data", unavailable, now)
+		require.True(t, retry.Wait("set_media_meta_data", policy, now.Add(5*time.Second)))
+		require.False(t, retry.Wait("set_media_meta_data", policy, now.Add(10*time.Second)))
+		require.False(t, retry.Wait("add_label", policy, now))
+
+		var empty *StepRetry
+		require.False(t, empty.Wait("set_media_meta_data", policy, now))
+
+		retry.Attempts = policy.MaxAttempts
+		require.False(t, retry.Wait("set_media_meta_data", policy, now))
+		require.True(t, retry.Stopped("set_media_meta_data", policy))
+		require.False(t, retry.Stopped("add_label", policy))
+		require.False(t, empty.Stopped("set_media_meta_data", policy))
+	})
+}
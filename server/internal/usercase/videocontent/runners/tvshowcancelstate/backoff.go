@@ -0,0 +1,17 @@
+package tvshowcancelstate
+
+import (
+	"time"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+// retryPolicies политики автоматических повторов шагов при временных ошибках
+var retryPolicies = runners.RetryPolicies[StepCancel]{
+	// торрент клиент может перезапускаться
+	DeleteTorrentFromTorrentClient: {
+		MaxAttempts:    10,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+	},
+}
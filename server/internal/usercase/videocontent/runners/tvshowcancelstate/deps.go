@@ -0,0 +1,19 @@
+package tvshowcancelstate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowcancel"
+)
+
+type ContentCanceled interface {
+	CancelMergeVideoFiles(ctx context.Context, mergeIDs []uuid.UUID) error
+	DeleteTorrentFromTorrentClient(ctx context.Context, magnetHash string) error
+	DeleteTorrentFiles(ctx context.Context, torrentPath string) error
+	DeleteSeasonCatalog(ctx context.Context, catalogPath tvshowcancel.TVShowCatalogPath) error
+	DeleteEpisodeFiles(ctx context.Context, catalogPath tvshowcancel.TVShowCatalogPath, episodeFiles []string) error
+	DeleteLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error
+}
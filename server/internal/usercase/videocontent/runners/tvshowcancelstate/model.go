@@ -0,0 +1,76 @@
+package tvshowcancelstate
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowcancel"
+)
+
+// StepCancel шаг отмены доставки сезона сериала
+type StepCancel string
+
+const (
+	// StartCancelTVShowDelivery начальный шаг отмены доставки
+	StartCancelTVShowDelivery StepCancel = "start_cancel_tv_show_delivery"
+	// CancelMergeVideoFiles отмена обработки видеофайлов
+	CancelMergeVideoFiles StepCancel = "cancel_merge_video_files"
+	// DeleteTorrentFromTorrentClient удаление раздачи из торрент клиента вместе со скачанными файлами
+	DeleteTorrentFromTorrentClient StepCancel = "delete_torrent_from_torrent_client"
+	// DeleteTorrentFiles удаление оставшихся файлов раздачи с диска
+	DeleteTorrentFiles StepCancel = "delete_torrent_files"
+	// DeleteSeasonCatalog удаление созданного каталога сезона на медиасервере
+	DeleteSeasonCatalog StepCancel = "delete_season_catalog"
+	// DeleteLabel удаление лейбла, если доставка успела его поставить
+	DeleteLabel StepCancel = "delete_label"
+)
+
+// TVShowCancelData информация о том, что успела сделать отменяемая доставка
+// Пустые поля означают, что до этого шага доставка не дошла и откатывать нечего
+type TVShowCancelData struct {
+	// DeliveryStateID отменяемый стейт доставки
+	DeliveryStateID uuid.UUID
+	// DeliveryStep шаг, на котором была остановлена доставка
+	DeliveryStep string
+	// MergeIDs запущенные обработки видеофайлов
+	MergeIDs []uuid.UUID
+	// MagnetHash хеш раздачи, добавленной в торрент клиент
+	MagnetHash *string
+	// TorrentPath путь до файлов раздачи
+	TorrentPath *string
+	// TVShowCatalogPath созданный каталог сезона на медиасервере
+	TVShowCatalogPath *tvshowcancel.TVShowCatalogPath
+	// KeepLabel у сезона остались другие версии с файлами, лейбл HasVideoContentFiles не удаляется
+	KeepLabel bool
+	// KeepCatalog каталог сезона общий с другими версиями, удаляются только файлы эпизодов EpisodeFiles
+	KeepCatalog bool
+	// EpisodeFiles имена файлов эпизодов версии (без расширения) в каталоге сезона
+	EpisodeFiles []string
+	// StepRetry автоматические повторы шага после временной ошибки
+	StepRetry *runners.StepRetry
+}
+
+func (d TVShowCancelData) GetStepRetry() *runners.StepRetry {
+	return d.StepRetry
+}
+
+func (d TVShowCancelData) WithStepRetry(retry *runners.StepRetry) TVShowCancelData {
+	d.StepRetry = retry
+	return d
+}
+
+type CreateOptions struct {
+	Index int
+	// VideoContentID видеоконтент, к которому относится стейт
+	VideoContentID uuid.UUID
+	TVShowID       common.TVShowID
+	// Data что нужно откатить
+	Data TVShowCancelData
+}
+
+func (c CreateOptions) GetIdempotencyKey() string {
+	return fmt.Sprintf("cancel_tv_%d_season_%d_content_%s_n_%d", c.TVShowID.ID, c.TVShowID.SeasonNumber, c.VideoContentID, c.Index)
+}
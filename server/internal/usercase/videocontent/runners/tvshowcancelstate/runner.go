@@ -0,0 +1,121 @@
+package tvshowcancelstate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kkiling/statemachine"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type Runner struct {
+	contentCanceled ContentCanceled
+	backoff         runners.Backoff[TVShowCancelData, StepCancel]
+}
+
+func NewTaskRunner(contentCanceled ContentCanceled) *Runner {
+	return &Runner{
+		contentCanceled: contentCanceled,
+		backoff:         runners.Backoff[TVShowCancelData, StepCancel]{Policies: retryPolicies, Clock: &common.RealClock{}},
+	}
+}
+
+func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState, error) {
+	return CreateState{
+		FirstStep: StartCancelTVShowDelivery,
+		Data:      options.Data,
+		MetaData: runners.Metadata{
+			ContentID: common.ContentID{
+				TVShow:         &options.TVShowID,
+				VideoContentID: &options.VideoContentID,
+			},
+		},
+	}, nil
+}
+
+func (r *Runner) Type() runners.Type {
+	return runners.TVShowCancel
+}
+
+func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepRegistration {
+	registration := StepRegistration{
+		Steps: map[StepCancel]Step{
+			StartCancelTVShowDelivery: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// начальный шаг
+					return stepContext.Next(CancelMergeVideoFiles)
+				},
+			},
+			CancelMergeVideoFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// отмена обработки видеофайлов, что бы она не писала в удаляемый каталог
+					data := stepContext.State.Data
+					if len(data.MergeIDs) > 0 {
+						if err := r.contentCanceled.CancelMergeVideoFiles(ctx, data.MergeIDs); err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("CancelMergeVideoFiles: %w", err))
+						}
+					}
+					return stepContext.Next(DeleteTorrentFromTorrentClient)
+				},
+			},
+			DeleteTorrentFromTorrentClient: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// удаление раздачи из торрент клиента
+					data := stepContext.State.Data
+					if data.MagnetHash != nil {
+						if err := r.contentCanceled.DeleteTorrentFromTorrentClient(ctx, *data.MagnetHash); err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("DeleteTorrentFromTorrentClient: %w", err))
+						}
+					}
+					return stepContext.Next(DeleteTorrentFiles)
+				},
+			},
+			DeleteTorrentFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// удаление оставшихся файлов раздачи с диска
+					data := stepContext.State.Data
+					if data.TorrentPath != nil {
+						if err := r.contentCanceled.DeleteTorrentFiles(ctx, *data.TorrentPath); err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("DeleteTorrentFiles: %w", err))
+						}
+					}
+					return stepContext.Next(DeleteSeasonCatalog)
+				},
+			},
+			DeleteSeasonCatalog: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// удаление каталога сезона на медиасервере
+					data := stepContext.State.Data
+					if data.TVShowCatalogPath != nil && data.KeepCatalog {
+						// Каталог сезона остается у других версий
+						if err := r.contentCanceled.DeleteEpisodeFiles(ctx, *data.TVShowCatalogPath, data.EpisodeFiles); err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("DeleteEpisodeFiles: %w", err))
+						}
+					} else if data.TVShowCatalogPath != nil {
+						if err := r.contentCanceled.DeleteSeasonCatalog(ctx, *data.TVShowCatalogPath); err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("DeleteSeasonCatalog: %w", err))
+						}
+					}
+					return stepContext.Next(DeleteLabel)
+				},
+			},
+			DeleteLabel: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					if stepContext.State.Data.KeepLabel {
+						return stepContext.Complete()
+					}
+					data := stepContext.State.MetaData
+					if err := r.contentCanceled.DeleteLabelHasVideoContentFiles(ctx, data.ContentID); err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteLabelHasVideoContentFiles: %w", err))
+					}
+					return stepContext.Complete()
+				},
+			},
+		},
+	}
+
+	r.backoff.Register(registration.Steps)
+	return registration
+}
@@ -0,0 +1,23 @@
+package tvshowcancelstate
+
+import (
+	"github.com/kkiling/statemachine"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type CreateState = statemachine.CreateState[TVShowCancelData, runners.Metadata, StepCancel]
+type State = statemachine.State[TVShowCancelData, runners.FailData, runners.Metadata, StepCancel, runners.Type]
+type Step = statemachine.Step[TVShowCancelData, runners.FailData, runners.Metadata, StepCancel, runners.Type]
+type StepRegistration = statemachine.StepRegistration[TVShowCancelData, runners.FailData, runners.Metadata, StepCancel, runners.Type]
+type StepContext = statemachine.StepContext[TVShowCancelData, runners.FailData, runners.Metadata, StepCancel, runners.Type]
+type StepResult = statemachine.StepResult[TVShowCancelData, StepCancel]
+type StateMachineService = statemachine.StateMachine[TVShowCancelData, runners.FailData, runners.Metadata, StepCancel, runners.Type, CreateOptions]
+
+func NewState(contentCanceled ContentCanceled, stateMachineStorage statemachine.Storage) *StateMachineService {
+	return statemachine.NewService[TVShowCancelData, runners.FailData, runners.Metadata, StepCancel, runners.Type, CreateOptions](
+		statemachine.Config{},
+		stateMachineStorage,
+		NewTaskRunner(contentCanceled),
+	)
+}
@@ -10,6 +10,7 @@ import (
 type ContentDeleted interface {
 	DeleteSeasonFromMediaServer(ctx context.Context, tvShowPath tvshowdelete.TVShowCatalogPath) error
 	DeleteSeasonFiles(ctx context.Context, tvShowPath tvshowdelete.TVShowCatalogPath) error
+	DeleteEpisodeFiles(ctx context.Context, tvShowPath tvshowdelete.TVShowCatalogPath, episodeFiles []string) error
 	DeleteTorrentFiles(ctx context.Context, torrentPath string) error
 	DeleteTorrentFromTorrentClient(ctx context.Context, magnetHash string) error
 	DeleteLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error
@@ -3,7 +3,10 @@ package tvshowdeletestate
 import (
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelete"
 )
 
@@ -33,11 +36,32 @@ type TVShowDeleteData struct {
 	TorrentPath string
 	// Путь каталога сериала и сезона на медиа сервере
 	TVShowCatalogPath tvshowdelete.TVShowCatalogPath
+	// KeepTorrent раздача (пак) еще используется другими сезонами, удаляются только файлы сезона
+	KeepTorrent bool
+	// KeepLabel у сезона остались другие версии с файлами, лейбл HasVideoContentFiles не удаляется
+	KeepLabel bool
+	// KeepCatalog каталог сезона общий с другими версиями, удаляются только файлы эпизодов EpisodeFiles
+	KeepCatalog bool
+	// EpisodeFiles имена файлов эпизодов версии (без расширения) в каталоге сезона
+	EpisodeFiles []string
+	// StepRetry автоматические повторы шага после временной ошибки
+	StepRetry *runners.StepRetry
+}
+
+func (d TVShowDeleteData) GetStepRetry() *runners.StepRetry {
+	return d.StepRetry
+}
+
+func (d TVShowDeleteData) WithStepRetry(retry *runners.StepRetry) TVShowDeleteData {
+	d.StepRetry = retry
+	return d
 }
 
 type CreateOptions struct {
 	//
 	Index int
+	// VideoContentID видеоконтент, к которому относится стейт
+	VideoContentID uuid.UUID
 
 	TVShowID common.TVShowID
 	// Хеш торрент раздачи (что бы удалить раздачу в торрент клиенте)
@@ -46,8 +70,16 @@ type CreateOptions struct {
 	TorrentPath string
 	// Путь каталога сериала и сезона на медиа сервере
 	TVShowCatalogPath tvshowdelete.TVShowCatalogPath
+	// KeepTorrent не удалять раздачу, она используется другими сезонами
+	KeepTorrent bool
+	// KeepLabel не удалять лейбл, у сезона остались другие версии с файлами
+	KeepLabel bool
+	// KeepCatalog не удалять каталог сезона, в нем лежат файлы других версий
+	KeepCatalog bool
+	// EpisodeFiles имена файлов эпизодов версии (без расширения) в каталоге сезона
+	EpisodeFiles []string
 }
 
 func (c CreateOptions) GetIdempotencyKey() string {
-	return fmt.Sprintf("delete_tv_%d_season_%d_n_%", c.TVShowID.ID, c.TVShowID.SeasonNumber, c.Index)
+	return fmt.Sprintf("delete_tv_%d_season_%d_content_%s_n_%d", c.TVShowID.ID, c.TVShowID.SeasonNumber, c.VideoContentID, c.Index)
 }
@@ -12,11 +12,13 @@ import (
 
 type Runner struct {
 	contentDeleted ContentDeleted
+	backoff        runners.Backoff[TVShowDeleteData, StepDelete]
 }
 
 func NewTaskRunner(contentDelivery ContentDeleted) *Runner {
 	return &Runner{
 		contentDeleted: contentDelivery,
+		backoff:        runners.Backoff[TVShowDeleteData, StepDelete]{Policies: retryPolicies, Clock: &common.RealClock{}},
 	}
 }
 
@@ -26,6 +28,10 @@ func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState,
 		MagnetHash:        options.MagnetHash,
 		TorrentPath:       options.TorrentPath,
 		TVShowCatalogPath: options.TVShowCatalogPath,
+		KeepTorrent:       options.KeepTorrent,
+		KeepLabel:         options.KeepLabel,
+		KeepCatalog:       options.KeepCatalog,
+		EpisodeFiles:      options.EpisodeFiles,
 	}
 
 	return CreateState{
@@ -33,22 +39,26 @@ func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState,
 		Data:      data,
 		MetaData: runners.Metadata{
 			ContentID: common.ContentID{
-				TVShow: &options.TVShowID,
+				TVShow:         &options.TVShowID,
+				VideoContentID: &options.VideoContentID,
 			},
 		},
 	}, nil
 }
 
 func (r *Runner) Type() runners.Type {
-	return runners.TVShowDelivery
+	return runners.TVShowDelete
 }
 
 func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepRegistration {
-	return StepRegistration{
+	registration := StepRegistration{
 		Steps: map[StepDelete]Step{
 			StartDeleteTVShowSeason: {
 				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
 					// начальный шаг
+					if stepContext.State.Data.KeepTorrent {
+						return stepContext.Next(DeleteSeasonFiles)
+					}
 					return stepContext.Next(DeleteTorrentFromTorrentClient)
 				},
 			},
@@ -58,7 +68,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					data := stepContext.State.Data
 					err := r.contentDeleted.DeleteTorrentFromTorrentClient(ctx, data.MagnetHash)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("DeleteTorrentFromTorrentClient: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteTorrentFromTorrentClient: %w", err))
 					}
 					return stepContext.Next(DeleteTorrentFiles)
 				},
@@ -69,7 +79,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					data := stepContext.State.Data
 					err := r.contentDeleted.DeleteTorrentFiles(ctx, data.TorrentPath)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("DeleteTorrentFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteTorrentFiles: %w", err))
 					}
 					return stepContext.Next(DeleteSeasonFiles)
 				},
@@ -78,9 +88,17 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
 					//  удаление файлов сезона с медиасервера
 					data := stepContext.State.Data
+					if data.KeepCatalog {
+						// Каталог сезона остается у других версий, медиасервер продолжит его видеть
+						err := r.contentDeleted.DeleteEpisodeFiles(ctx, data.TVShowCatalogPath, data.EpisodeFiles)
+						if err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("DeleteEpisodeFiles: %w", err))
+						}
+						return stepContext.Next(DeleteLabel)
+					}
 					err := r.contentDeleted.DeleteSeasonFiles(ctx, data.TVShowCatalogPath)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("DeleteSeasonFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteSeasonFiles: %w", err))
 					}
 					return stepContext.Next(DeleteSeasonFromMediaServer)
 				},
@@ -91,21 +109,27 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					data := stepContext.State.Data
 					err := r.contentDeleted.DeleteSeasonFromMediaServer(ctx, data.TVShowCatalogPath)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("DeleteSeasonFromMediaServer: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteSeasonFromMediaServer: %w", err))
 					}
 					return stepContext.Next(DeleteLabel)
 				},
 			},
 			DeleteLabel: {
 				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					if stepContext.State.Data.KeepLabel {
+						return stepContext.Complete()
+					}
 					data := stepContext.State.MetaData
 					err := r.contentDeleted.DeleteLabelHasVideoContentFiles(ctx, data.ContentID)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("DeleteLabelHasVideoContentFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteLabelHasVideoContentFiles: %w", err))
 					}
 					return stepContext.Complete()
 				},
 			},
 		},
 	}
+
+	r.backoff.Register(registration.Steps)
+	return registration
 }
@@ -0,0 +1,29 @@
+package tvshowdeliverystate
+
+import (
+	"time"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+// retryPolicies политики автоматических повторов шагов при временных ошибках
+var retryPolicies = runners.RetryPolicies[StepDelivery]{
+	// трекер может быть недоступен продолжительное время
+	SearchTorrents: {
+		MaxAttempts:    10,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     30 * time.Minute,
+	},
+	// медиасервер может перезапускаться
+	SetMediaMetaData: {
+		MaxAttempts:    10,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+	},
+	// уведомление повторяется, пока telegram недоступен, после последней попытки доставка завершается без него
+	SendDeliveryNotification: {
+		MaxAttempts:    10,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+	},
+}
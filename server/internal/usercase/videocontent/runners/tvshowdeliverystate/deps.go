@@ -12,12 +12,14 @@ import (
 type ContentDelivery interface {
 	GenerateSearchQuery(ctx context.Context, params tvshowdelivery.GenerateSearchQueryParams) (*tvshowdelivery.SearchQuery, error)
 	SearchTorrent(ctx context.Context, params tvshowdelivery.SearchTorrentParams) ([]tvshowdelivery.TorrentSearch, error)
+	AutoSelectTorrent(ctx context.Context, torrents []tvshowdelivery.TorrentSearch) *tvshowdelivery.TorrentSearch
 	GetMagnetLink(ctx context.Context, params tvshowdelivery.GetMagnetLinkParams) (*tvshowdelivery.MagnetLink, error)
 	AddTorrentToTorrentClient(ctx context.Context, params tvshowdelivery.AddTorrentParams) error
 	WaitingTorrentFiles(ctx context.Context, params tvshowdelivery.WaitingTorrentFilesParams) (*tvshowdelivery.TorrentFilesData, error)
 	PrepareFileMatches(ctx context.Context, params tvshowdelivery.PreparingFileMatchesParams) (*tvshowdelivery.ContentMatches, error)
 	WaitingTorrentDownloadComplete(ctx context.Context, params tvshowdelivery.WaitingTorrentDownloadCompleteParams) (*tvshowdelivery.TorrentDownloadStatus, error)
 	GetEpisodesData(ctx context.Context, params tvshowdelivery.GetEpisodesDataParams) (*tvshowdelivery.EpisodesData, error)
+	ProbeMediaFiles(ctx context.Context, params tvshowdelivery.ProbeMediaFilesParams) error
 	CreateContentCatalogs(ctx context.Context, params tvshowdelivery.CreateContentCatalogsParams) error
 	StartMergeVideo(ctx context.Context, params tvshowdelivery.MergeVideoParams) ([]uuid.UUID, error)
 	GetMergeVideoStatus(ctx context.Context, mergeIDs []uuid.UUID) (*tvshowdelivery.MergeVideoStatus, error)
@@ -25,6 +27,9 @@ type ContentDelivery interface {
 	SetMediaMetaData(ctx context.Context, params tvshowdelivery.SetMediaMetaDataParams) error
 	NeedPrepareFileMatches(contentMatches []tvshowdelivery.ContentMatch) bool
 	CreateHardLinkCopyToMediaServer(ctx context.Context, params tvshowdelivery.CreateHardLinkCopyParams) error
+	RestoreFileMatches(params tvshowdelivery.RestoreFileMatchesParams) (*tvshowdelivery.ContentMatches, bool)
+	AutoConfirmFileMatches(contentMatches *tvshowdelivery.ContentMatches) bool
 	ValidateContentMatch(oldContentMatch *tvshowdelivery.ContentMatches, newContentMatch *tvshowdelivery.ContentMatches) error
 	AddLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error
+	SendDeliveryNotification(ctx context.Context, params tvshowdelivery.SendDeliveryNotificationParams) error
 }
@@ -2,10 +2,13 @@ package tvshowdeliverystate
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 )
 
@@ -33,6 +36,8 @@ const (
 	WaitingChoseFileMatches StepDelivery = "waiting_chose_file_matches"
 	// WaitingTorrentDownloadComplete ожидание завершения окончания скачивания раздачи
 	WaitingTorrentDownloadComplete StepDelivery = "waiting_torrent_download_complete"
+	// ProbeMediaFiles получение информации о дорожках скачанных файлов (кодек, каналы, длительность, язык)
+	ProbeMediaFiles StepDelivery = "probe_media_files"
 	// CreateVideoContentCatalogs Формирование каталогов и иерархии файлов
 	CreateVideoContentCatalogs StepDelivery = "create_video_content_catalogs"
 	// DeterminingNeedConvertFiles Определение необходимости конвертации файлов
@@ -62,9 +67,11 @@ const (
 // TVShowDeliveryData модель содержащая информацию о видео контенте для сезона сериала / фильма
 /*
 	К одному сезону сериала / фильму может быть привязано несколько VideoContent,
-	но для упрощения пока будем пока разрешать только 1
+	каталоги и файлы каждого отличаются суффиксом версии (см. runners.Metadata.Version)
 */
 type TVShowDeliveryData struct {
+	// QualityProfile копия профиля качества, выбранного при создании доставки
+	QualityProfile *qualityprofile.QualityProfile
 	// SearchQuery сформированный запрос на основе названия сериала
 	SearchQuery *tvshowdelivery.SearchQuery
 	// TorrentSearch Результат поиска торрентов
@@ -77,6 +84,8 @@ type TVShowDeliveryData struct {
 	EpisodesData *tvshowdelivery.EpisodesData
 	// ContentMatches Информация о метче файлов (метч видофайлов с аудиодоржками и субтитрами)
 	ContentMatches *tvshowdelivery.ContentMatches
+	// FileMatchesAutoConfirmed метч файлов подтвержден автоматически, без участия пользователя
+	FileMatchesAutoConfirmed bool
 	// TorrentDownloadStatus статус скачивания раздачи
 	TorrentDownloadStatus *tvshowdelivery.TorrentDownloadStatus
 	// MergeIDs информация
@@ -85,13 +94,80 @@ type TVShowDeliveryData struct {
 	MergeVideoStatus *tvshowdelivery.MergeVideoStatus
 	// TVShowCatalogInfo информация о каталогах сериала
 	TVShowCatalogInfo *tvshowdelivery.TVShowCatalog
+	// RetryHistory история ручных повторов шагов, завершившихся ошибкой
+	RetryHistory []RetryAttempt
+	// StepRetry автоматические повторы шага после временной ошибки
+	StepRetry *runners.StepRetry
+	// Restore данные предыдущей доставки, если сезон восстанавливается после удаления файлов
+	Restore *RestoreData
+	// Pack раздача-пак нескольких сезонов, выбранная доставкой другого сезона
+	Pack *PackData
+}
+
+func (d TVShowDeliveryData) GetStepRetry() *runners.StepRetry {
+	return d.StepRetry
+}
+
+func (d TVShowDeliveryData) WithStepRetry(retry *runners.StepRetry) TVShowDeliveryData {
+	d.StepRetry = retry
+	return d
+}
+
+// PackData раздача с несколькими сезонами, которая уже выбрана доставкой другого сезона сериала
+/*
+	Раздача скачивается торрент клиентом один раз,
+	каждый сезон метчит из нее только свои файлы и раскладывает их в свой каталог
+*/
+type PackData struct {
+	// SourceVideoContentID видеоконтент сезона, доставка которого выбрала раздачу
+	SourceVideoContentID uuid.UUID
+	// SourceSeasonNumber номер сезона, доставка которого выбрала раздачу
+	SourceSeasonNumber uint8
+	// Torrent раздача-пак
+	Torrent tvshowdelivery.Torrent
+}
+
+// RestoreData данные предыдущей доставки для восстановления сезона без участия пользователя
+type RestoreData struct {
+	// DeliveryStateID стейт доставки, из которого восстанавливается сезон
+	DeliveryStateID uuid.UUID
+	// Torrent раздача предыдущей доставки
+	Torrent tvshowdelivery.Torrent
+	// TorrentFiles файлы раздачи на момент предыдущей доставки
+	TorrentFiles []tvshowdelivery.FileInfo
+	// ContentMatches подтвержденный метч файлов предыдущей доставки
+	ContentMatches *tvshowdelivery.ContentMatches
+}
+
+// RetryAttempt информация о ручном повторе шага
+type RetryAttempt struct {
+	// Step шаг, который повторили
+	Step StepDelivery
+	// Error ошибка, с которой завершилось последнее выполнение шага
+	Error string
+	// RetriedAt время запроса повтора
+	RetriedAt time.Time
+	// Href новая раздача, если была указана
+	Href *string
+	// ContentMatchesChanged был передан новый метч файлов
+	ContentMatchesChanged bool
 }
 
 type CreateOptions struct {
-	Index    int
+	Index int
+	// VideoContentID видеоконтент, к которому относится стейт
+	VideoContentID uuid.UUID
+	// Version версия видеоконтента
+	Version  string
 	TVShowID common.TVShowID
+	// QualityProfile профиль качества для отбора раздач (может быть nil)
+	QualityProfile *qualityprofile.QualityProfile
+	// Restore восстановление сезона по данным предыдущей доставки (может быть nil)
+	Restore *RestoreData
+	// Pack доставка сезона из раздачи-пака другого сезона (может быть nil)
+	Pack *PackData
 }
 
 func (c CreateOptions) GetIdempotencyKey() string {
-	return fmt.Sprintf("delivery_tv_%d_season_%d_n_%d", c.TVShowID.ID, c.TVShowID.SeasonNumber, c.Index)
+	return fmt.Sprintf("delivery_tv_%d_season_%d_content_%s_n_%d", c.TVShowID.ID, c.TVShowID.SeasonNumber, c.VideoContentID, c.Index)
 }
@@ -1,6 +1,8 @@
 package tvshowdeliverystate
 
 import (
+	"time"
+
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 )
 
@@ -17,3 +19,13 @@ type ChoseFileMatchesOptions struct {
 	// Метч контента если указан
 	ContentMatches *tvshowdelivery.ContentMatches
 }
+
+// RetryStepOptions повторное выполнение шага, завершившегося ошибкой
+type RetryStepOptions struct {
+	// RetriedAt время запроса повтора
+	RetriedAt time.Time
+	// Href новая раздача (только для шагов до добавления раздачи в торрент клиент)
+	Href *string
+	// ContentMatches новый метч файлов (только для шагов до размещения файлов на медиасервере)
+	ContentMatches *tvshowdelivery.ContentMatches
+}
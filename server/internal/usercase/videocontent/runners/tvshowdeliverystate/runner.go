@@ -20,25 +20,50 @@ import (
 
 type Runner struct {
 	contentDelivery ContentDelivery
+	backoff         runners.Backoff[TVShowDeliveryData, StepDelivery]
 }
 
 func NewTaskRunner(contentDelivery ContentDelivery) *Runner {
 	return &Runner{
 		contentDelivery: contentDelivery,
+		backoff:         runners.Backoff[TVShowDeliveryData, StepDelivery]{Policies: retryPolicies, Clock: &common.RealClock{}},
 	}
 }
 
 func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState, error) {
 	// Логика создания задачи
-	data := TVShowDeliveryData{}
+	data := TVShowDeliveryData{
+		QualityProfile: options.QualityProfile,
+	}
+	firstStep := GenerateSearchQuery
+	// При восстановлении раздача уже известна, поиск и выбор раздачи пропускаются
+	if options.Restore != nil {
+		data.Restore = options.Restore
+		data.Torrent = &tvshowdelivery.Torrent{
+			Href:       options.Restore.Torrent.Href,
+			MagnetLink: options.Restore.Torrent.MagnetLink,
+		}
+		firstStep = AddTorrentToTorrentClient
+	}
+	// Раздача-пак уже выбрана доставкой другого сезона, поиск и выбор раздачи пропускаются
+	if options.Pack != nil {
+		data.Pack = options.Pack
+		data.Torrent = &tvshowdelivery.Torrent{
+			Href:       options.Pack.Torrent.Href,
+			MagnetLink: options.Pack.Torrent.MagnetLink,
+		}
+		firstStep = AddTorrentToTorrentClient
+	}
 
 	return CreateState{
-		FirstStep: GenerateSearchQuery,
+		FirstStep: firstStep,
 		Data:      data,
 		MetaData: runners.Metadata{
 			ContentID: common.ContentID{
-				TVShow: &options.TVShowID,
+				TVShow:         &options.TVShowID,
+				VideoContentID: &options.VideoContentID,
 			},
+			Version: options.Version,
 		},
 	}, nil
 }
@@ -48,7 +73,7 @@ func (r *Runner) Type() runners.Type {
 }
 
 func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepRegistration {
-	return StepRegistration{
+	registration := StepRegistration{
 		Steps: map[StepDelivery]Step{
 			GenerateSearchQuery: {
 				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
@@ -59,7 +84,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 						TVShowID: *stepContext.State.MetaData.ContentID.TVShow,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("GenerateSearchQuery: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("GenerateSearchQuery: %w", err))
 					}
 
 					data.SearchQuery = res
@@ -71,12 +96,22 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					// ищем раздачи сезона сериала / фильма
 					data := stepContext.State.Data
 					res, err := r.contentDelivery.SearchTorrent(ctx, tvshowdelivery.SearchTorrentParams{
-						SearchQuery: data.SearchQuery.Query,
+						TVShowID:       *stepContext.State.MetaData.ContentID.TVShow,
+						SearchQuery:    data.SearchQuery.Query,
+						QualityProfile: data.QualityProfile,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("SearchTorrent: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("SearchTorrent: %w", err))
 					}
 					data.TorrentSearch = res
+
+					// Раздача с достаточной оценкой выбирается без участия пользователя
+					if best := r.contentDelivery.AutoSelectTorrent(ctx, res); best != nil {
+						data.Torrent = &tvshowdelivery.Torrent{
+							Href: best.Href,
+						}
+						return stepContext.Next(GetMagnetLink).WithData(data)
+					}
 					return stepContext.Next(WaitingUserChoseTorrent).WithData(data)
 				},
 			},
@@ -136,7 +171,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					})
 
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("GetMagnetLink: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("GetMagnetLink: %w", err))
 					}
 
 					data.Torrent.MagnetLink = res
@@ -150,9 +185,11 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					err := r.contentDelivery.AddTorrentToTorrentClient(ctx, tvshowdelivery.AddTorrentParams{
 						TVShowID: *stepContext.State.MetaData.ContentID.TVShow,
 						Magnet:   data.Torrent.MagnetLink.Magnet,
+						Hash:     data.Torrent.MagnetLink.Hash,
+						Pack:     data.Pack != nil,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("AddTorrentToTorrentClient: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("AddTorrentToTorrentClient: %w", err))
 					}
 					return stepContext.Next(WaitingTorrentFiles)
 				},
@@ -166,14 +203,14 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 						Hash: data.Torrent.MagnetLink.Hash,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("WaitingTorrentFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentFiles: %w", err))
 					}
 					if res == nil {
 						data.TorrentDownloadStatus, err = r.contentDelivery.WaitingTorrentDownloadComplete(ctx, tvshowdelivery.WaitingTorrentDownloadCompleteParams{
 							Hash: data.Torrent.MagnetLink.Hash,
 						})
 						if err != nil {
-							return stepContext.Error(fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
+							return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
 						}
 						return stepContext.Empty().WithData(data)
 					}
@@ -187,9 +224,10 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					data := stepContext.State.Data
 					res, err := r.contentDelivery.GetEpisodesData(ctx, tvshowdelivery.GetEpisodesDataParams{
 						TVShowID: *stepContext.State.MetaData.ContentID.TVShow,
+						Version:  stepContext.State.MetaData.Version,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("GetEpisodesData: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("GetEpisodesData: %w", err))
 					}
 					data.EpisodesData = res
 					return stepContext.Next(PrepareFileMatches).WithData(data)
@@ -200,17 +238,36 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					// Получение информации о файлах раздачи
 					data := stepContext.State.Data
 					contentMatches, err := r.contentDelivery.PrepareFileMatches(ctx, tvshowdelivery.PreparingFileMatchesParams{
+						TVShowID:     stepContext.State.MetaData.ContentID.TVShow.ID,
 						TorrentFiles: data.TorrentFilesData.Files,
 						Episodes:     data.EpisodesData.Episodes,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("PrepareFileMatches: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("PrepareFileMatches: %w", err))
 					}
 					if contentMatches == nil || (len(contentMatches.Matches) == 0 && len(contentMatches.Unallocated) == 0) {
 						return stepContext.Empty()
 					}
 
 					data.ContentMatches = contentMatches
+					// При восстановлении метч подтверждать не нужно, если файлы раздачи не изменились
+					if data.Restore != nil {
+						restored, ok := r.contentDelivery.RestoreFileMatches(tvshowdelivery.RestoreFileMatchesParams{
+							ContentMatches:   data.Restore.ContentMatches,
+							PrevTorrentFiles: data.Restore.TorrentFiles,
+							TorrentFiles:     data.TorrentFilesData.Files,
+							Episodes:         data.EpisodesData.Episodes,
+						})
+						if ok {
+							data.ContentMatches = restored
+							return stepContext.Next(WaitingTorrentDownloadComplete).WithData(data)
+						}
+					}
+					// Однозначный метч подтверждается без участия пользователя
+					if r.contentDelivery.AutoConfirmFileMatches(data.ContentMatches) {
+						data.FileMatchesAutoConfirmed = true
+						return stepContext.Next(WaitingTorrentDownloadComplete).WithData(data)
+					}
 					// Определение необходимости конвертации файлов
 					return stepContext.Next(WaitingChoseFileMatches).WithData(data)
 				},
@@ -252,24 +309,39 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 						Hash: data.Torrent.MagnetLink.Hash,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
 					}
 					data.TorrentDownloadStatus = res
 					if res.IsComplete {
-						return stepContext.Next(CreateVideoContentCatalogs).WithData(data)
+						return stepContext.Next(ProbeMediaFiles).WithData(data)
 					}
 					return stepContext.Empty().WithData(data)
 				},
 			},
+			ProbeMediaFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Получение информации о дорожках скачанных файлов
+					// Метч уже подтвержден, проба только дополняет метаданные треков (язык, кодек) перед склейкой
+					data := stepContext.State.Data
+					err := r.contentDelivery.ProbeMediaFiles(ctx, tvshowdelivery.ProbeMediaFilesParams{
+						ContentMatches: data.ContentMatches,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("ProbeMediaFiles: %w", err))
+					}
+					return stepContext.Next(CreateVideoContentCatalogs).WithData(data)
+				},
+			},
 			CreateVideoContentCatalogs: {
 				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
 					// Формирование каталогов и иерархии файлов
 					data := stepContext.State.Data
 					err := r.contentDelivery.CreateContentCatalogs(ctx, tvshowdelivery.CreateContentCatalogsParams{
 						TVShowCatalogPath: data.EpisodesData.TVShowCatalogPath,
+						Episodes:          data.EpisodesData.Episodes,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("CreateContentCatalogs: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("CreateContentCatalogs: %w", err))
 					}
 
 					return stepContext.Next(DeterminingNeedConvertFiles)
@@ -300,7 +372,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					if err := r.contentDelivery.CreateHardLinkCopyToMediaServer(ctx, tvshowdelivery.CreateHardLinkCopyParams{
 						ContentMatches: data.ContentMatches.Matches,
 					}); err != nil {
-						return stepContext.Error(fmt.Errorf("CreateHardLinkCopyToMediaServer: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("CreateHardLinkCopyToMediaServer: %w", err))
 					}
 
 					return stepContext.Next(GetCatalogsSize)
@@ -317,7 +389,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 						ContentMatches: data.ContentMatches,
 					})
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("StartMergeVideoFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("StartMergeVideoFiles: %w", err))
 					}
 					data.MergeIDs = mergeIDs
 					return stepContext.Next(WaitingMergeVideoFiles).WithData(data)
@@ -330,7 +402,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					//  Конвертирование файлов - полученные файлы сразу сохраняются в каталог медиасервера
 					status, err := r.contentDelivery.GetMergeVideoStatus(ctx, data.MergeIDs)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("WaitingMergeVideoFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingMergeVideoFiles: %w", err))
 					}
 
 					data.MergeVideoStatus = status
@@ -356,11 +428,11 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 					// Получение размера каталогов
 					torrentTVShowSeasonSize, err := r.contentDelivery.GetCatalogSize(ctx, data.TVShowCatalogInfo.TorrentPath)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("contentDelivery.GetCatalogSize: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("contentDelivery.GetCatalogSize: %w", err))
 					}
 					mediaServerTVShowSize, err := r.contentDelivery.GetCatalogSize(ctx, data.TVShowCatalogInfo.MediaServerPath.FullSeasonPath())
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("contentDelivery.GetCatalogSize: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("contentDelivery.GetCatalogSize: %w", err))
 					}
 
 					data.TVShowCatalogInfo.TorrentSize = torrentTVShowSeasonSize
@@ -383,7 +455,7 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 							// emby не сразу раздупляет, поможет ретрай
 							return stepContext.Empty()
 						}
-						return stepContext.Error(fmt.Errorf("SetMediaMetaData: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("SetMediaMetaData: %w", err))
 					}
 
 					return stepContext.Next(AddLabel)
@@ -393,11 +465,44 @@ func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepReg
 				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
 					err := r.contentDelivery.AddLabelHasVideoContentFiles(ctx, stepContext.State.MetaData.ContentID)
 					if err != nil {
-						return stepContext.Error(fmt.Errorf("AddLabelHasVideoContentFiles: %w", err))
+						return r.backoff.StepError(stepContext, fmt.Errorf("AddLabelHasVideoContentFiles: %w", err))
+					}
+					return stepContext.Next(SendDeliveryNotification)
+				},
+			},
+			SendDeliveryNotification: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Уведомление не должно ломать доставку - файлы уже на медиасервере
+					data := stepContext.State.Data
+					episodesCount := 0
+					if data.ContentMatches != nil {
+						episodesCount = len(data.ContentMatches.Matches)
+					}
+					err := r.contentDelivery.SendDeliveryNotification(ctx, tvshowdelivery.SendDeliveryNotificationParams{
+						TVShowID:      *stepContext.State.MetaData.ContentID.TVShow,
+						EpisodesCount: episodesCount,
+						TVShowCatalog: data.TVShowCatalogInfo,
+					})
+					if err != nil {
+						err = fmt.Errorf("SendDeliveryNotification: %w", err)
+						if r.backoff.Exhausted(stepContext, err) {
+							// Повторы закончились, завершаем доставку без уведомления
+							return stepContext.Complete()
+						}
+						return r.backoff.StepError(stepContext, err)
 					}
 					return stepContext.Complete()
 				},
 			},
 		},
 	}
+
+	r.backoff.Register(registration.Steps)
+	for step, s := range registration.Steps {
+		// Шаги ожидания пользователя принимают свои опции и не повторяются автоматически
+		if s.OptionsType == nil {
+			registration.Steps[step] = r.withRetry(step, s)
+		}
+	}
+	return registration
 }
@@ -0,0 +1,124 @@
+package tvshowdeliverystate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+func TestRunnerCreate(t *testing.T) {
+	r := NewTaskRunner(nil)
+	tvShowID := common.TVShowID{ID: 70523, SeasonNumber: 2}
+	videoContentID := uuid.New()
+
+	t.Run("new delivery", func(t *testing.T) {
+		state, err := r.Create(context.Background(), CreateOptions{
+			TVShowID:       tvShowID,
+			VideoContentID: videoContentID,
+			Version:        "4K",
+		})
+		require.NoError(t, err)
+		require.Equal(t, GenerateSearchQuery, state.FirstStep)
+		require.Nil(t, state.Data.Torrent)
+		require.Equal(t, &tvShowID, state.MetaData.ContentID.TVShow)
+		require.Equal(t, &videoContentID, state.MetaData.ContentID.VideoContentID)
+		require.Equal(t, "4K", state.MetaData.Version)
+	})
+
+	t.Run("season from pack", func(t *testing.T) {
+		pack := &PackData{
+			SourceVideoContentID: uuid.New(),
+			SourceSeasonNumber:   1,
+			Torrent: tvshowdelivery.Torrent{
+				Href:       "https://rutracker.org/forum/viewtopic.php?t=1",
+				MagnetLink: &tvshowdelivery.MagnetLink{Magnet: "magnet:?xt=urn:btih:abc", Hash: "abc"},
+			},
+		}
+		state, err := r.Create(context.Background(), CreateOptions{
+			TVShowID:       tvShowID,
+			VideoContentID: videoContentID,
+			Pack:           pack,
+		})
+		require.NoError(t, err)
+		// Поиск и выбор раздачи пропускаются
+		require.Equal(t, AddTorrentToTorrentClient, state.FirstStep)
+		require.Equal(t, pack, state.Data.Pack)
+		require.Equal(t, pack.Torrent, *state.Data.Torrent)
+	})
+}
+
+type fakeContentDelivery struct {
+	ContentDelivery
+	notificationErr error
+}
+
+func (f *fakeContentDelivery) SendDeliveryNotification(_ context.Context, _ tvshowdelivery.SendDeliveryNotificationParams) error {
+	return f.notificationErr
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func TestRunnerSendDeliveryNotification(t *testing.T) {
+	ctx := context.Background()
+	contentDelivery := &fakeContentDelivery{}
+	r := NewTaskRunner(contentDelivery)
+	clock := &testClock{now: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+	r.backoff.Clock = clock
+	step := r.StepRegistration(struct{}{}).Steps[SendDeliveryNotification]
+
+	newStepContext := func(retry *runners.StepRetry) StepContext {
+		stepContext := StepContext{}
+		stepContext.State.Step = SendDeliveryNotification
+		stepContext.State.Data.StepRetry = retry
+		stepContext.State.MetaData.ContentID.TVShow = &common.TVShowID{ID: 70523, SeasonNumber: 2}
+		return stepContext
+	}
+	unavailable := fmt.Errorf("notifier.Send: %w", apierr.ServiceUnavailableErr)
+
+	t.Run("notification sent", func(t *testing.T) {
+		contentDelivery.notificationErr = nil
+		stepContext := newStepContext(nil)
+		require.Equal(t, stepContext.Complete(), step.OnStep(ctx, stepContext))
+	})
+
+	t.Run("telegram unavailable - notification is retried", func(t *testing.T) {
+		contentDelivery.notificationErr = unavailable
+		stepContext := newStepContext(nil)
+
+		data := stepContext.State.Data
+		data.StepRetry = runners.NextRetry(retryPolicies.Get(SendDeliveryNotification), nil, string(SendDeliveryNotification),
+			fmt.Errorf("SendDeliveryNotification: %w", unavailable), clock.now)
+		require.Equal(t, stepContext.Empty().WithData(data), step.OnStep(ctx, stepContext))
+	})
+
+	t.Run("last attempt failed - delivery completes without notification", func(t *testing.T) {
+		contentDelivery.notificationErr = unavailable
+		stepContext := newStepContext(&runners.StepRetry{
+			Step:          string(SendDeliveryNotification),
+			Attempts:      retryPolicies.Get(SendDeliveryNotification).MaxAttempts - 1,
+			NextAttemptAt: clock.now,
+		})
+		require.Equal(t, stepContext.Complete(), step.OnStep(ctx, stepContext))
+	})
+
+	t.Run("not retryable error - delivery completes without notification", func(t *testing.T) {
+		contentDelivery.notificationErr = fmt.Errorf("notifier.Send: %w", apierr.AuthenticationFailedErr)
+		stepContext := newStepContext(nil)
+		require.Equal(t, stepContext.Complete(), step.OnStep(ctx, stepContext))
+	})
+}
@@ -0,0 +1,26 @@
+package tvshowupdatestate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+type ContentUpdate interface {
+	GetMagnetLink(ctx context.Context, params tvshowdelivery.GetMagnetLinkParams) (*tvshowdelivery.MagnetLink, error)
+	AddTorrentToTorrentClient(ctx context.Context, params tvshowdelivery.AddTorrentParams) error
+	DeleteOldTorrentFromTorrentClient(ctx context.Context, hash string) error
+	WaitingTorrentFiles(ctx context.Context, params tvshowdelivery.WaitingTorrentFilesParams) (*tvshowdelivery.TorrentFilesData, error)
+	WaitingTorrentDownloadComplete(ctx context.Context, params tvshowdelivery.WaitingTorrentDownloadCompleteParams) (*tvshowdelivery.TorrentDownloadStatus, error)
+	GetEpisodesData(ctx context.Context, params tvshowdelivery.GetEpisodesDataParams) (*tvshowdelivery.EpisodesData, error)
+	FilterNewEpisodes(delivered []tvshowdelivery.ContentMatch, episodes []tvshowdelivery.EpisodeInfo) []tvshowdelivery.EpisodeInfo
+	ProbeMediaFiles(ctx context.Context, params tvshowdelivery.ProbeMediaFilesParams) error
+	PrepareFileMatches(ctx context.Context, params tvshowdelivery.PreparingFileMatchesParams) (*tvshowdelivery.ContentMatches, error)
+	NeedPrepareFileMatches(contentMatches []tvshowdelivery.ContentMatch) bool
+	StartMergeVideo(ctx context.Context, params tvshowdelivery.MergeVideoParams) ([]uuid.UUID, error)
+	GetMergeVideoStatus(ctx context.Context, mergeIDs []uuid.UUID) (*tvshowdelivery.MergeVideoStatus, error)
+	CreateHardLinkCopyToMediaServer(ctx context.Context, params tvshowdelivery.CreateHardLinkCopyParams) error
+	SetMediaMetaData(ctx context.Context, params tvshowdelivery.SetMediaMetaDataParams) error
+}
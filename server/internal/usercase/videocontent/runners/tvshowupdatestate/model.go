@@ -0,0 +1,114 @@
+package tvshowupdatestate
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+// StepUpdate шаг обновления раздачи доставленного сезона сериала
+type StepUpdate string
+
+const (
+	// StartUpdateTVShowSeason начальный шаг обновления
+	StartUpdateTVShowSeason StepUpdate = "start_update_tv_show_season"
+	// GetMagnetLink повторное получение магнет ссылки по сохраненной ссылке на раздачу
+	GetMagnetLink StepUpdate = "get_magnet_link"
+	// AddTorrentToTorrentClient добавление обновленной раздачи в торрент клиент
+	AddTorrentToTorrentClient StepUpdate = "add_torrent_to_torrent_client"
+	// DeleteOldTorrentFromTorrentClient удаление старой раздачи из торрент клиента (без файлов)
+	DeleteOldTorrentFromTorrentClient StepUpdate = "delete_old_torrent_from_torrent_client"
+	// WaitingTorrentFiles ожидание когда появится информация о файлах в обновленной раздаче
+	WaitingTorrentFiles StepUpdate = "waiting_torrent_files"
+	// GetEpisodesData получение информации о эпизодах сезона
+	GetEpisodesData StepUpdate = "get_episodes_data"
+	// PrepareNewFileMatches метч файлов только для новых эпизодов
+	PrepareNewFileMatches StepUpdate = "prepare_new_file_matches"
+	// WaitingTorrentDownloadComplete ожидание окончания скачивания обновленной раздачи
+	WaitingTorrentDownloadComplete StepUpdate = "waiting_torrent_download_complete"
+	// ProbeMediaFiles получение информации о дорожках скачанных файлов новых эпизодов
+	ProbeMediaFiles StepUpdate = "probe_media_files"
+	// DeterminingNeedConvertFiles определение необходимости конвертации файлов новых эпизодов
+	DeterminingNeedConvertFiles StepUpdate = "determining_need_convert_files"
+	// StartMergeVideoFiles запуск конвертирования файлов новых эпизодов в каталог сезона
+	StartMergeVideoFiles StepUpdate = "merge_video_files"
+	// WaitingMergeVideoFiles ожидание завершения конвертации файлов
+	WaitingMergeVideoFiles StepUpdate = "waiting_merge_video_files"
+	// CreateHardLinkCopy создание ссылок на файлы новых эпизодов в каталоге сезона
+	CreateHardLinkCopy StepUpdate = "create_hardlink_copy"
+	// SetMediaMetaData обновление информации о сезоне в медиасервере
+	SetMediaMetaData StepUpdate = "set_media_meta_data"
+)
+
+// TVShowUpdateData информация об обновлении раздачи доставленного сезона
+type TVShowUpdateData struct {
+	// DeliveryStateID стейт доставки сезона
+	DeliveryStateID uuid.UUID
+	// Torrent раздача, с которой был доставлен сезон
+	Torrent tvshowdelivery.Torrent
+	// TVShowCatalogPath каталог сезона на медиасервере
+	TVShowCatalogPath tvshowdelivery.TVShowCatalogPath
+	// DeliveredMatches уже доставленные эпизоды
+	DeliveredMatches []tvshowdelivery.ContentMatch
+	// ContentMatchesOptions опции метча, подтвержденные пользователем при доставке
+	ContentMatchesOptions tvshowdelivery.ContentMatchesOptions
+	// KeepOldTorrent старая раздача (пак) используется другими сезонами, из торрент клиента ее не удаляем
+	KeepOldTorrent bool
+	// NewMagnetLink магнет ссылка раздачи на момент обновления
+	NewMagnetLink *tvshowdelivery.MagnetLink
+	// TorrentFilesData файлы обновленной раздачи
+	TorrentFilesData *tvshowdelivery.TorrentFilesData
+	// EpisodesData информация о эпизодах сезона
+	EpisodesData *tvshowdelivery.EpisodesData
+	// ContentMatches метч файлов новых эпизодов
+	ContentMatches *tvshowdelivery.ContentMatches
+	// TorrentDownloadStatus статус скачивания обновленной раздачи
+	TorrentDownloadStatus *tvshowdelivery.TorrentDownloadStatus
+	// MergeIDs запущенные обработки видеофайлов
+	MergeIDs []uuid.UUID
+	// MergeVideoStatus статус сшивания файлов (если нужен)
+	MergeVideoStatus *tvshowdelivery.MergeVideoStatus
+	// StepRetry автоматические повторы шага после временной ошибки
+	StepRetry *runners.StepRetry
+}
+
+func (d TVShowUpdateData) GetStepRetry() *runners.StepRetry {
+	return d.StepRetry
+}
+
+func (d TVShowUpdateData) WithStepRetry(retry *runners.StepRetry) TVShowUpdateData {
+	d.StepRetry = retry
+	return d
+}
+
+// TorrentChanged раздача обновилась - поменялся хеш
+func (d TVShowUpdateData) TorrentChanged() bool {
+	return d.NewMagnetLink != nil && d.Torrent.MagnetLink != nil && d.NewMagnetLink.Hash != d.Torrent.MagnetLink.Hash
+}
+
+// NewEpisodesCount количество новых эпизодов, найденных в обновленной раздаче
+func (d TVShowUpdateData) NewEpisodesCount() int {
+	if d.ContentMatches == nil {
+		return 0
+	}
+	return len(d.ContentMatches.Matches)
+}
+
+type CreateOptions struct {
+	Index int
+	// VideoContentID видеоконтент, к которому относится стейт
+	VideoContentID uuid.UUID
+	// Version версия видеоконтента
+	Version  string
+	TVShowID common.TVShowID
+	// Data доставленный сезон, который нужно обновить
+	Data TVShowUpdateData
+}
+
+func (c CreateOptions) GetIdempotencyKey() string {
+	return fmt.Sprintf("update_tv_%d_season_%d_content_%s_n_%d", c.TVShowID.ID, c.TVShowID.SeasonNumber, c.VideoContentID, c.Index)
+}
@@ -0,0 +1,289 @@
+package tvshowupdatestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/kkiling/statemachine"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
+)
+
+type Runner struct {
+	contentUpdate ContentUpdate
+	backoff       runners.Backoff[TVShowUpdateData, StepUpdate]
+}
+
+func NewTaskRunner(contentUpdate ContentUpdate) *Runner {
+	return &Runner{
+		contentUpdate: contentUpdate,
+		backoff:       runners.Backoff[TVShowUpdateData, StepUpdate]{Policies: retryPolicies, Clock: &common.RealClock{}},
+	}
+}
+
+func (r *Runner) Create(_ context.Context, options CreateOptions) (CreateState, error) {
+	return CreateState{
+		FirstStep: StartUpdateTVShowSeason,
+		Data:      options.Data,
+		MetaData: runners.Metadata{
+			ContentID: common.ContentID{
+				TVShow:         &options.TVShowID,
+				VideoContentID: &options.VideoContentID,
+			},
+			Version: options.Version,
+		},
+	}, nil
+}
+
+func (r *Runner) Type() runners.Type {
+	return runners.TVShowUpdate
+}
+
+func (r *Runner) StepRegistration(_ statemachine.StepRegistrationParams) StepRegistration {
+	registration := StepRegistration{
+		Steps: map[StepUpdate]Step{
+			StartUpdateTVShowSeason: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// начальный шаг
+					return stepContext.Next(GetMagnetLink)
+				},
+			},
+			GetMagnetLink: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Раздачи онгоингов перезаливаются с новой магнет ссылкой при добавлении серий
+					data := stepContext.State.Data
+					res, err := r.contentUpdate.GetMagnetLink(ctx, tvshowdelivery.GetMagnetLinkParams{
+						Href: data.Torrent.Href,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("GetMagnetLink: %w", err))
+					}
+
+					data.NewMagnetLink = res
+					if !data.TorrentChanged() {
+						// Раздача не обновлялась, обновлять нечего
+						return stepContext.Complete().WithData(data)
+					}
+					return stepContext.Next(AddTorrentToTorrentClient).WithData(data)
+				},
+			},
+			AddTorrentToTorrentClient: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Обновленная раздача скачивается в тот же каталог, уже скачанные файлы переиспользуются
+					data := stepContext.State.Data
+					err := r.contentUpdate.AddTorrentToTorrentClient(ctx, tvshowdelivery.AddTorrentParams{
+						TVShowID: *stepContext.State.MetaData.ContentID.TVShow,
+						Magnet:   data.NewMagnetLink.Magnet,
+						Hash:     data.NewMagnetLink.Hash,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("AddTorrentToTorrentClient: %w", err))
+					}
+					if data.KeepOldTorrent {
+						return stepContext.Next(WaitingTorrentFiles)
+					}
+					return stepContext.Next(DeleteOldTorrentFromTorrentClient)
+				},
+			},
+			DeleteOldTorrentFromTorrentClient: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					err := r.contentUpdate.DeleteOldTorrentFromTorrentClient(ctx, data.Torrent.MagnetLink.Hash)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("DeleteOldTorrentFromTorrentClient: %w", err))
+					}
+					return stepContext.Next(WaitingTorrentFiles)
+				},
+			},
+			WaitingTorrentFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					res, err := r.contentUpdate.WaitingTorrentFiles(ctx, tvshowdelivery.WaitingTorrentFilesParams{
+						Hash: data.NewMagnetLink.Hash,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentFiles: %w", err))
+					}
+					if res == nil {
+						data.TorrentDownloadStatus, err = r.contentUpdate.WaitingTorrentDownloadComplete(ctx, tvshowdelivery.WaitingTorrentDownloadCompleteParams{
+							Hash: data.NewMagnetLink.Hash,
+						})
+						if err != nil {
+							return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
+						}
+						return stepContext.Empty().WithData(data)
+					}
+					data.TorrentFilesData = res
+					return stepContext.Next(GetEpisodesData).WithData(data)
+				},
+			},
+			GetEpisodesData: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					res, err := r.contentUpdate.GetEpisodesData(ctx, tvshowdelivery.GetEpisodesDataParams{
+						TVShowID: *stepContext.State.MetaData.ContentID.TVShow,
+						Version:  stepContext.State.MetaData.Version,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("GetEpisodesData: %w", err))
+					}
+					// Новые эпизоды кладем в уже существующий каталог сезона,
+					// даже если название сериала с момента доставки поменялось
+					res.TVShowCatalogPath = data.TVShowCatalogPath
+					for index, episode := range res.Episodes {
+						episode.FullPath = filepath.Join(data.TVShowCatalogPath.FullSeasonPath(), episode.RelativePath)
+						res.Episodes[index] = episode
+					}
+					data.EpisodesData = res
+					return stepContext.Next(PrepareNewFileMatches).WithData(data)
+				},
+			},
+			PrepareNewFileMatches: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					newEpisodes := r.contentUpdate.FilterNewEpisodes(data.DeliveredMatches, data.EpisodesData.Episodes)
+					if len(newEpisodes) == 0 {
+						// Раздача обновилась, но новых эпизодов в сезоне нет
+						return stepContext.Complete().WithData(data)
+					}
+
+					contentMatches, err := r.contentUpdate.PrepareFileMatches(ctx, tvshowdelivery.PreparingFileMatchesParams{
+						TVShowID:     stepContext.State.MetaData.ContentID.TVShow.ID,
+						TorrentFiles: data.TorrentFilesData.Files,
+						Episodes:     newEpisodes,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("PrepareFileMatches: %w", err))
+					}
+					// Эпизоды, которые вышли, но еще не появились в раздаче, пропускаем до следующего обновления
+					contentMatches.Matches = lo.Filter(contentMatches.Matches, func(item tvshowdelivery.ContentMatch, _ int) bool {
+						return item.Video != nil
+					})
+					// Опции метча берем те, что пользователь подтвердил при доставке
+					contentMatches.Options = data.ContentMatchesOptions
+					data.ContentMatches = contentMatches
+					if len(contentMatches.Matches) == 0 {
+						return stepContext.Complete().WithData(data)
+					}
+					return stepContext.Next(WaitingTorrentDownloadComplete).WithData(data)
+				},
+			},
+			WaitingTorrentDownloadComplete: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					res, err := r.contentUpdate.WaitingTorrentDownloadComplete(ctx, tvshowdelivery.WaitingTorrentDownloadCompleteParams{
+						Hash: data.NewMagnetLink.Hash,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingTorrentDownloadComplete: %w", err))
+					}
+					data.TorrentDownloadStatus = res
+					if res.IsComplete {
+						return stepContext.Next(ProbeMediaFiles).WithData(data)
+					}
+					return stepContext.Empty().WithData(data)
+				},
+			},
+			ProbeMediaFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					// Метаданные треков (язык, кодек) новых эпизодов нужны для склейки, как и при доставке
+					data := stepContext.State.Data
+					err := r.contentUpdate.ProbeMediaFiles(ctx, tvshowdelivery.ProbeMediaFilesParams{
+						ContentMatches: data.ContentMatches,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("ProbeMediaFiles: %w", err))
+					}
+					return stepContext.Next(DeterminingNeedConvertFiles).WithData(data)
+				},
+			},
+			DeterminingNeedConvertFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					// Для файлов эпизодов назначаем расширения файлов
+					for index, match := range data.ContentMatches.Matches {
+						ext := strings.ToLower(filepath.Ext(match.Video.File.FullPath))
+						match.Episode.FullPath += ext
+						match.Episode.RelativePath += ext
+						data.ContentMatches.Matches[index] = match
+					}
+
+					if r.contentUpdate.NeedPrepareFileMatches(data.ContentMatches.Matches) {
+						return stepContext.Next(StartMergeVideoFiles).WithData(data)
+					}
+					return stepContext.Next(CreateHardLinkCopy).WithData(data)
+				},
+			},
+			CreateHardLinkCopy: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					if err := r.contentUpdate.CreateHardLinkCopyToMediaServer(ctx, tvshowdelivery.CreateHardLinkCopyParams{
+						ContentMatches: data.ContentMatches.Matches,
+					}); err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("CreateHardLinkCopyToMediaServer: %w", err))
+					}
+					return stepContext.Next(SetMediaMetaData)
+				},
+			},
+			StartMergeVideoFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					mergeIDs, err := r.contentUpdate.StartMergeVideo(ctx, tvshowdelivery.MergeVideoParams{
+						IdempotencyKey: stepContext.State.ID.String(),
+						ContentMatches: data.ContentMatches,
+					})
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("StartMergeVideoFiles: %w", err))
+					}
+					data.MergeIDs = mergeIDs
+					return stepContext.Next(WaitingMergeVideoFiles).WithData(data)
+				},
+			},
+			WaitingMergeVideoFiles: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					status, err := r.contentUpdate.GetMergeVideoStatus(ctx, data.MergeIDs)
+					if err != nil {
+						return r.backoff.StepError(stepContext, fmt.Errorf("WaitingMergeVideoFiles: %w", err))
+					}
+
+					data.MergeVideoStatus = status
+					if status.IsComplete {
+						if len(status.Errors) == 0 {
+							return stepContext.Next(SetMediaMetaData).WithData(data)
+						}
+						return stepContext.Error(fmt.Errorf("merge videos contains errors")).WithData(data)
+					}
+					return stepContext.Empty().WithData(data)
+				},
+			},
+			SetMediaMetaData: {
+				OnStep: func(ctx context.Context, stepContext StepContext) *StepResult {
+					data := stepContext.State.Data
+					err := r.contentUpdate.SetMediaMetaData(ctx, tvshowdelivery.SetMediaMetaDataParams{
+						TVShowPath: data.TVShowCatalogPath.TVShowPath,
+						TVShowID:   *stepContext.State.MetaData.ContentID.TVShow,
+					})
+					if err != nil {
+						if errors.Is(err, apierr.ContentNotFound) {
+							// медиасервер не сразу видит новые файлы, поможет ретрай
+							return stepContext.Empty()
+						}
+						return r.backoff.StepError(stepContext, fmt.Errorf("SetMediaMetaData: %w", err))
+					}
+					return stepContext.Complete()
+				},
+			},
+		},
+	}
+
+	r.backoff.Register(registration.Steps)
+	return registration
+}
@@ -0,0 +1,23 @@
+package tvshowupdatestate
+
+import (
+	"github.com/kkiling/statemachine"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners"
+)
+
+type CreateState = statemachine.CreateState[TVShowUpdateData, runners.Metadata, StepUpdate]
+type State = statemachine.State[TVShowUpdateData, runners.FailData, runners.Metadata, StepUpdate, runners.Type]
+type Step = statemachine.Step[TVShowUpdateData, runners.FailData, runners.Metadata, StepUpdate, runners.Type]
+type StepRegistration = statemachine.StepRegistration[TVShowUpdateData, runners.FailData, runners.Metadata, StepUpdate, runners.Type]
+type StepContext = statemachine.StepContext[TVShowUpdateData, runners.FailData, runners.Metadata, StepUpdate, runners.Type]
+type StepResult = statemachine.StepResult[TVShowUpdateData, StepUpdate]
+type StateMachineService = statemachine.StateMachine[TVShowUpdateData, runners.FailData, runners.Metadata, StepUpdate, runners.Type, CreateOptions]
+
+func NewState(contentUpdate ContentUpdate, stateMachineStorage statemachine.Storage) *StateMachineService {
+	return statemachine.NewService[TVShowUpdateData, runners.FailData, runners.Metadata, StepUpdate, runners.Type, CreateOptions](
+		statemachine.Config{},
+		stateMachineStorage,
+		NewTaskRunner(contentUpdate),
+	)
+}
@@ -0,0 +1,154 @@
+package torrentrank
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+)
+
+// MaxScore максимальное значение оценки раздачи
+const MaxScore = 100
+
+var (
+	res2160Re    = regexp.MustCompile(`(?i)\b(2160p|4k|uhd)\b`)
+	res1080Re    = regexp.MustCompile(`(?i)\b1080[pi]\b`)
+	res720Re     = regexp.MustCompile(`(?i)\b720p\b`)
+	res480Re     = regexp.MustCompile(`(?i)\b(480p|576p)\b`)
+	hevcRe       = regexp.MustCompile(`(?i)\b(hevc|x\.?265|h\.?265)\b`)
+	avcRe        = regexp.MustCompile(`(?i)\b(avc|x\.?264|h\.?264)\b`)
+	av1Re        = regexp.MustCompile(`(?i)\bav1\b`)
+	xvidRe       = regexp.MustCompile(`(?i)\b(xvid|divx)\b`)
+	hdrRe        = regexp.MustCompile(`(?i)\b(hdr10\+?|hdr|dolby\s*vision|dv)\b`)
+	remuxRe      = regexp.MustCompile(`(?i)\b(bd)?remux\b`)
+	webDLRe      = regexp.MustCompile(`(?i)\bweb-?dl\b`)
+	blurayRe     = regexp.MustCompile(`(?i)\b(blu-?ray|bdrip)\b`)
+	webRipRe     = regexp.MustCompile(`(?i)\b(web-?dlrip|webrip|hdrip)\b`)
+	hdtvRe       = regexp.MustCompile(`(?i)\bhdtv(rip)?\b`)
+	dvdRe        = regexp.MustCompile(`(?i)\b(dvdrip|dvd5|dvd9|satrip|tvrip)\b`)
+	camRe        = regexp.MustCompile(`(?i)\b(camrip|ts|telesync)\b`)
+	voiceOverRe  = regexp.MustCompile(`(?i)(дубляж|\b(dub|mvo|dvo)\b)`)
+	voiceStudios = []string{
+		"lostfilm", "newstudio", "hdrezka", "кубик в кубе", "alexfilm", "jaskier", "tvshows",
+		"baibako", "coldfilm", "amedia", "пифагор", "novamedia", "red head sound",
+	}
+)
+
+// Rank оценка раздачи и из чего она сложилась
+/*
+	Общие для фильмов и сериалов критерии (качество, озвучка, сиды) живут здесь,
+	доставка сериала добавляет к ним свои (сезон, количество серий)
+*/
+type Rank struct {
+	score   float64
+	reasons []string
+}
+
+// Add добавить к оценке баллы с причиной
+func (r *Rank) Add(points float64, reason string, args ...any) {
+	r.score += points
+	r.reasons = append(r.reasons, fmt.Sprintf("%s (%+.0f)", fmt.Sprintf(reason, args...), points))
+}
+
+// Result итоговая оценка (0 - 100) и причины
+func (r *Rank) Result() (float64, []string) {
+	score := math.Round(math.Max(0, math.Min(MaxScore, r.score)))
+	return score, r.reasons
+}
+
+// ParseResolution разрешение (высота кадра) указанное в заголовке раздачи, 0 если не удалось распознать
+func ParseResolution(title string) int {
+	switch {
+	case res2160Re.MatchString(title):
+		return qualityprofile.Resolution2160
+	case res1080Re.MatchString(title):
+		return qualityprofile.Resolution1080
+	case res720Re.MatchString(title):
+		return qualityprofile.Resolution720
+	case res480Re.MatchString(title), dvdRe.MatchString(title):
+		return qualityprofile.ResolutionSD
+	}
+	return 0
+}
+
+// ParseCodec кодек видео указанный в заголовке раздачи, пустая строка если не удалось распознать
+func ParseCodec(title string) string {
+	switch {
+	case hevcRe.MatchString(title):
+		return qualityprofile.CodecHEVC
+	case av1Re.MatchString(title):
+		return qualityprofile.CodecAV1
+	case avcRe.MatchString(title):
+		return qualityprofile.CodecAVC
+	case xvidRe.MatchString(title):
+		return qualityprofile.CodecXviD
+	}
+	return ""
+}
+
+func (r *Rank) Resolution(title string) {
+	switch {
+	case res2160Re.MatchString(title):
+		r.Add(20, "разрешение 2160p")
+	case res1080Re.MatchString(title):
+		r.Add(25, "разрешение 1080p")
+	case res720Re.MatchString(title):
+		r.Add(15, "разрешение 720p")
+	case dvdRe.MatchString(title):
+		r.Add(3, "SD разрешение")
+	}
+}
+
+func (r *Rank) Source(title string) {
+	switch {
+	case camRe.MatchString(title):
+		r.Add(-40, "экранка")
+	case remuxRe.MatchString(title):
+		r.Add(10, "Remux")
+	case webRipRe.MatchString(title):
+		r.Add(8, "WEBRip")
+	case webDLRe.MatchString(title):
+		r.Add(12, "WEB-DL")
+	case blurayRe.MatchString(title):
+		r.Add(12, "BluRay")
+	case hdtvRe.MatchString(title):
+		r.Add(5, "HDTV")
+	case dvdRe.MatchString(title):
+		r.Add(2, "DVD")
+	}
+}
+
+func (r *Rank) Codec(title string) {
+	switch {
+	case hevcRe.MatchString(title):
+		r.Add(4, "кодек HEVC")
+	case avcRe.MatchString(title):
+		r.Add(5, "кодек AVC")
+	}
+	if hdrRe.MatchString(title) {
+		r.Add(3, "HDR")
+	}
+}
+
+func (r *Rank) VoiceOver(title string) {
+	lower := strings.ToLower(title)
+	for _, studio := range voiceStudios {
+		if strings.Contains(lower, studio) {
+			r.Add(8, "озвучка %s", studio)
+			return
+		}
+	}
+	if voiceOverRe.MatchString(title) {
+		r.Add(5, "многоголосая озвучка / дубляж")
+	}
+}
+
+func (r *Rank) Seeds(seeds uint32) {
+	if seeds == 0 {
+		r.Add(-30, "нет сидов")
+		return
+	}
+	r.Add(math.Min(12, 5*math.Log10(float64(seeds)+1)), "сидов %d", seeds)
+}
@@ -0,0 +1,24 @@
+package tvshowcancel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/adapter/mkvmerge"
+)
+
+// CancelMergeVideoFiles отмена ожидающих и выполняемых обработок видеофайлов
+func (s *Service) CancelMergeVideoFiles(ctx context.Context, mergeIDs []uuid.UUID) error {
+	for _, id := range mergeIDs {
+		if err := s.mkvMerge.CancelMerge(ctx, id); err != nil {
+			if errors.Is(err, mkvmerge.ErrNotFound) {
+				continue
+			}
+			return fmt.Errorf("mkvMerge.CancelMerge: %w", err)
+		}
+	}
+	return nil
+}
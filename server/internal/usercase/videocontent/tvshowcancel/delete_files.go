@@ -0,0 +1,112 @@
+package tvshowcancel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// checkInBasePath защита от удаления файлов вне каталога BasePath
+func (s *Service) checkInBasePath(path string) error {
+	relPath, err := filepath.Rel(s.config.BasePath, path)
+	if err != nil {
+		return fmt.Errorf("failed to get relative path: %w", err)
+	}
+	if relPath == "." || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("path %s is not a subdirectory of base path", path)
+	}
+	return nil
+}
+
+// isDirEmpty проверяет, пуста ли директория
+func isDirEmpty(dirPath string) (bool, error) {
+	f, err := os.Open(dirPath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	_, err = f.Readdirnames(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
+
+// DeleteTorrentFiles удаление скачанных (в том числе частично) файлов раздачи
+// Если файлов уже нет - это не ошибка
+func (s *Service) DeleteTorrentFiles(ctx context.Context, torrentPath string) error {
+	if err := s.checkInBasePath(torrentPath); err != nil {
+		return fmt.Errorf("checkInBasePath: %w", err)
+	}
+
+	if err := os.RemoveAll(torrentPath); err != nil {
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSeasonCatalog удаление созданного доставкой каталога сезона
+// Каталог сериала удаляется только если в нем не осталось других сезонов
+func (s *Service) DeleteSeasonCatalog(ctx context.Context, catalogPath TVShowCatalogPath) error {
+	seasonPath := catalogPath.FullSeasonPath()
+	if err := s.checkInBasePath(seasonPath); err != nil {
+		return fmt.Errorf("checkInBasePath: %w", err)
+	}
+
+	if err := os.RemoveAll(seasonPath); err != nil {
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+
+	if err := s.checkInBasePath(catalogPath.TVShowPath); err != nil {
+		return nil
+	}
+	isEmpty, err := isDirEmpty(catalogPath.TVShowPath)
+	if err != nil {
+		// Каталога сериала нет или его не удалось прочитать, сезон уже удален
+		return nil
+	}
+	if isEmpty {
+		if err = os.Remove(catalogPath.TVShowPath); err != nil {
+			return fmt.Errorf("failed to delete folder: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteEpisodeFiles удаление файлов эпизодов одной версии из каталога сезона
+// Каталог сезона общий с другими версиями и остается на месте
+// Если файлов уже нет - это не ошибка
+func (s *Service) DeleteEpisodeFiles(ctx context.Context, catalogPath TVShowCatalogPath, episodeFiles []string) error {
+	seasonPath := catalogPath.FullSeasonPath()
+	if err := s.checkInBasePath(seasonPath); err != nil {
+		return fmt.Errorf("checkInBasePath: %w", err)
+	}
+
+	entries, err := os.ReadDir(seasonPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read folder: %w", err)
+	}
+
+	for _, entry := range entries {
+		for _, name := range episodeFiles {
+			if entry.Name() != name && !strings.HasPrefix(entry.Name(), name+".") {
+				continue
+			}
+			if err = os.RemoveAll(filepath.Join(seasonPath, entry.Name())); err != nil {
+				return fmt.Errorf("failed to delete file: %w", err)
+			}
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package tvshowcancel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSeasonCatalog(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	s := NewService(Config{BasePath: base}, nil, nil, nil)
+
+	tvShowPath := filepath.Join(base, "tvshows", "Тьма (2017)")
+	require.NoError(t, os.MkdirAll(filepath.Join(tvShowPath, "Season 1"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(tvShowPath, "Season 2"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tvShowPath, "Season 2", "s02e01.mkv"), []byte("x"), 0o644))
+
+	t.Run("other seasons are kept", func(t *testing.T) {
+		err := s.DeleteSeasonCatalog(ctx, TVShowCatalogPath{TVShowPath: tvShowPath, SeasonPath: "Season 2"})
+		require.NoError(t, err)
+		require.NoDirExists(t, filepath.Join(tvShowPath, "Season 2"))
+		require.DirExists(t, filepath.Join(tvShowPath, "Season 1"))
+	})
+
+	t.Run("empty tv show catalog is deleted", func(t *testing.T) {
+		err := s.DeleteSeasonCatalog(ctx, TVShowCatalogPath{TVShowPath: tvShowPath, SeasonPath: "Season 1"})
+		require.NoError(t, err)
+		require.NoDirExists(t, tvShowPath)
+		require.DirExists(t, filepath.Join(base, "tvshows"))
+	})
+
+	t.Run("catalog already deleted", func(t *testing.T) {
+		err := s.DeleteSeasonCatalog(ctx, TVShowCatalogPath{TVShowPath: tvShowPath, SeasonPath: "Season 1"})
+		require.NoError(t, err)
+	})
+}
+
+func TestDeleteEpisodeFiles(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	s := NewService(Config{BasePath: base}, nil, nil, nil)
+
+	catalogPath := TVShowCatalogPath{TVShowPath: filepath.Join(base, "tvshows", "Тьма (2017)"), SeasonPath: "S02 Сезон 2"}
+	seasonPath := catalogPath.FullSeasonPath()
+	require.NoError(t, os.MkdirAll(seasonPath, 0o755))
+	for _, name := range []string{"S02E01 Эпизод 1.mkv", "S02E01 Эпизод 1 - 4K.mkv", "S02E01 Эпизод 1 - 4K.rus.srt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(seasonPath, name), []byte("x"), 0o644))
+	}
+
+	require.NoError(t, s.DeleteEpisodeFiles(ctx, catalogPath, []string{"S02E01 Эпизод 1 - 4K", "S02E02 Эпизод 2 - 4K"}))
+	entries, err := os.ReadDir(seasonPath)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "S02E01 Эпизод 1.mkv", entries[0].Name())
+
+	// Каталога уже нет
+	require.NoError(t, s.DeleteEpisodeFiles(ctx, TVShowCatalogPath{TVShowPath: catalogPath.TVShowPath, SeasonPath: "S03 Сезон 3"}, []string{"S03E01"}))
+}
+
+func TestDeleteTorrentFiles(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	s := NewService(Config{BasePath: base}, nil, nil, nil)
+
+	torrentPath := filepath.Join(base, "downloads", "Dark.S02.1080p")
+	require.NoError(t, os.MkdirAll(torrentPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(torrentPath, "s02e01.mkv.part"), []byte("x"), 0o644))
+
+	require.NoError(t, s.DeleteTorrentFiles(ctx, torrentPath))
+	require.NoDirExists(t, torrentPath)
+	// Повторное удаление не ошибка
+	require.NoError(t, s.DeleteTorrentFiles(ctx, torrentPath))
+
+	// Вне базового каталога ничего не удаляется
+	require.Error(t, s.DeleteTorrentFiles(ctx, base))
+	require.Error(t, s.DeleteTorrentFiles(ctx, filepath.Dir(base)))
+}
@@ -0,0 +1,14 @@
+package tvshowcancel
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteTorrentFromTorrentClient удаление раздачи из торрент клиента вместе со скачанными файлами
+func (s *Service) DeleteTorrentFromTorrentClient(ctx context.Context, magnetHash string) error {
+	if err := s.torrentClient.DeleteTorrent(magnetHash, true); err != nil {
+		return fmt.Errorf("torrentClient.DeleteTorrent: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package tvshowcancel
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/labels"
+)
+
+type TorrentClient interface {
+	DeleteTorrent(hash string, deleteFiles bool) error
+}
+
+type MkvMergePipeline interface {
+	CancelMerge(ctx context.Context, id uuid.UUID) error
+}
+
+type Labels interface {
+	DeleteLabel(ctx context.Context, contentID common.ContentID, typeLabel labels.TypeLabel) error
+}
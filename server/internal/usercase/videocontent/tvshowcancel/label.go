@@ -0,0 +1,21 @@
+package tvshowcancel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/labels"
+)
+
+// DeleteLabelHasVideoContentFiles удаление лейбла, если доставка успела его поставить
+func (s *Service) DeleteLabelHasVideoContentFiles(ctx context.Context, contentID common.ContentID) error {
+	err := s.labels.DeleteLabel(ctx, contentID, labels.HasVideoContentFiles)
+	if err != nil && !errors.Is(err, ucerr.NotFound) {
+		return fmt.Errorf("labels.DeleteLabel: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package tvshowcancel
+
+import "path/filepath"
+
+// TVShowCatalogPath пути каталогов сериала и сезона на медиа сервере
+type TVShowCatalogPath struct {
+	// Путь до каталога сериала
+	TVShowPath string
+	// Путь до каталога сезона (относительно каталога сериала)
+	SeasonPath string
+}
+
+func (t TVShowCatalogPath) FullSeasonPath() string {
+	return filepath.Join(t.TVShowPath, t.SeasonPath)
+}
@@ -0,0 +1,28 @@
+package tvshowcancel
+
+type Config struct {
+	// BasePath Базовый путь от которого расположены все файлы торрента или медиа сервера
+	// Удаляются только файлы внутри этого каталога
+	BasePath string // "/nfs"
+}
+
+type Service struct {
+	config        Config
+	torrentClient TorrentClient
+	mkvMerge      MkvMergePipeline
+	labels        Labels
+}
+
+func NewService(
+	config Config,
+	torrentClient TorrentClient,
+	mkvMerge MkvMergePipeline,
+	labelsService Labels,
+) *Service {
+	return &Service{
+		config:        config,
+		torrentClient: torrentClient,
+		mkvMerge:      mkvMerge,
+		labels:        labelsService,
+	}
+}
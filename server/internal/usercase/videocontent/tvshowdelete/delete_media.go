@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/kkiling/media-delivery/internal/adapter/apierr"
 )
@@ -17,8 +18,9 @@ func (s *Service) DeleteSeasonFromMediaServer(ctx context.Context, tvShowPath TV
 		return fmt.Errorf("failed to get relative path: %w", err)
 	}
 
-	if err = s.embyApi.Refresh(); err != nil {
-		return fmt.Errorf("failed to refresh emby api: %w", err)
+	// Каталог сериала мог быть удален вместе с сезоном, поэтому сканируем родительский каталог
+	if err = s.embyApi.ScanPath(filepath.Dir("/" + path)); err != nil {
+		return fmt.Errorf("failed to scan path: %w", err)
 	}
 
 	info, err := s.embyApi.GetCatalogInfo("/" + path)
@@ -84,3 +86,39 @@ func (s *Service) DeleteSeasonFiles(ctx context.Context, tvShowPath TVShowCatalo
 
 	return nil
 }
+
+// deleteFiles удаление файлов каталога с указанными именами (без учета расширения)
+func deleteFiles(dirPath string, names []string) error {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to read folder: %w", err)
+	}
+	for _, entry := range entries {
+		for _, name := range names {
+			if entry.Name() != name && !strings.HasPrefix(entry.Name(), name+".") {
+				continue
+			}
+			if err = os.RemoveAll(filepath.Join(dirPath, entry.Name())); err != nil {
+				return fmt.Errorf("failed to delete file: %w", err)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// DeleteEpisodeFiles удаление файлов эпизодов одной версии из каталога сезона
+// Каталог сезона общий с другими версиями и остается на месте
+func (s *Service) DeleteEpisodeFiles(ctx context.Context, tvShowPath TVShowCatalogPath, episodeFiles []string) error {
+	seasonPath := filepath.Join(tvShowPath.TVShowPath, tvShowPath.SeasonPath)
+	// Проверяем, существует ли путь
+	if _, err := os.Stat(seasonPath); os.IsNotExist(err) {
+		return fmt.Errorf("not found path")
+	}
+
+	if err := deleteFiles(seasonPath, episodeFiles); err != nil {
+		return fmt.Errorf("deleteFiles: %w", err)
+	}
+
+	return nil
+}
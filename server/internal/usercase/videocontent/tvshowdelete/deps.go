@@ -13,7 +13,7 @@ type TorrentClient interface {
 }
 
 type EmbyApi interface {
-	Refresh() error
+	ScanPath(path string) error
 	GetCatalogInfo(path string) (*emby.CatalogInfo, error)
 }
 
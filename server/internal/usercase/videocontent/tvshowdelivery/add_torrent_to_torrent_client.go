@@ -2,8 +2,10 @@ package tvshowdelivery
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
 	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
 	"github.com/kkiling/media-delivery/internal/common"
 )
@@ -11,10 +13,25 @@ import (
 type AddTorrentParams struct {
 	TVShowID common.TVShowID
 	Magnet   string
+	Hash     string
+	// Pack раздача-пак с несколькими сезонами, ее могла уже добавить доставка другого сезона
+	Pack bool
 }
 
 // AddTorrentToTorrentClient добавление торрент раздачи в торрент клиент
 func (s *Service) AddTorrentToTorrentClient(_ context.Context, params AddTorrentParams) error {
+	if params.Pack {
+		// Раздача-пак могла быть уже добавлена доставкой другого сезона, второй раз ее не качаем
+		_, err := s.torrentClient.GetTorrentInfo(params.Hash)
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, apierr.ContentNotFound):
+		default:
+			return fmt.Errorf("torrentClient.GetTorrentInfo: %w", err)
+		}
+	}
+
 	// Создание раздачи в торрент клиенте, выставление его сразу в паузу
 	err := s.torrentClient.AddTorrent(qbittorrent.TorrentAddOptions{
 		Magnet:   params.Magnet,
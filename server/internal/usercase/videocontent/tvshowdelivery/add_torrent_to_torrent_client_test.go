@@ -0,0 +1,64 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/apierr"
+	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
+	"github.com/kkiling/media-delivery/internal/common"
+)
+
+type fakeTorrentClient struct {
+	TorrentClient
+	torrents map[string]bool
+	added    []qbittorrent.TorrentAddOptions
+}
+
+func (f *fakeTorrentClient) GetTorrentInfo(hash string) (*qbittorrent.TorrentInfo, error) {
+	if !f.torrents[hash] {
+		return nil, apierr.ContentNotFound
+	}
+	return &qbittorrent.TorrentInfo{}, nil
+}
+
+func (f *fakeTorrentClient) AddTorrent(opts qbittorrent.TorrentAddOptions) error {
+	f.added = append(f.added, opts)
+	return nil
+}
+
+func TestAddTorrentToTorrentClient(t *testing.T) {
+	ctx := context.Background()
+	params := AddTorrentParams{
+		TVShowID: common.TVShowID{ID: 70523, SeasonNumber: 2},
+		Magnet:   "magnet:?xt=urn:btih:abc",
+		Hash:     "abc",
+	}
+
+	t.Run("pack already added by another season", func(t *testing.T) {
+		client := &fakeTorrentClient{torrents: map[string]bool{"abc": true}}
+		s := &Service{torrentClient: client}
+		packParams := params
+		packParams.Pack = true
+		require.NoError(t, s.AddTorrentToTorrentClient(ctx, packParams))
+		require.Empty(t, client.added)
+	})
+
+	t.Run("pack not added yet", func(t *testing.T) {
+		client := &fakeTorrentClient{}
+		s := &Service{torrentClient: client}
+		packParams := params
+		packParams.Pack = true
+		require.NoError(t, s.AddTorrentToTorrentClient(ctx, packParams))
+		require.Len(t, client.added, 1)
+	})
+
+	t.Run("season torrent is always added", func(t *testing.T) {
+		client := &fakeTorrentClient{torrents: map[string]bool{"abc": true}}
+		s := &Service{torrentClient: client}
+		require.NoError(t, s.AddTorrentToTorrentClient(ctx, params))
+		require.Len(t, client.added, 1)
+	})
+}
@@ -0,0 +1,65 @@
+package tvshowdelivery
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// ignoredUnallocatedPattern файлы, которые можно не распределять по эпизодам: сэмплы, трейлеры, доп. материалы
+var ignoredUnallocatedPattern = regexp.MustCompile(`(?i)(^|[/\\\s\.\-_\[(])(sample|trailer|extras?|featurettes?|bonus|доп[\.\s]*материалы)($|[/\\\s\.\-_\])])`)
+
+// trackNames отсортированные названия дорожек эпизода
+func trackNames(tracks []Track) []string {
+	names := lo.Map(tracks, func(track Track, _ int) string {
+		return lo.FromPtr(track.Name)
+	})
+	slices.Sort(names)
+	return names
+}
+
+// confidentMatch видеофайл сопоставлен с эпизодом с достаточной уверенностью
+func (s *Service) confidentMatch(video Track) bool {
+	if s.config.AutoConfirmMinConfidence <= 0 {
+		return true
+	}
+	return video.Match != nil && video.Match.Confidence >= s.config.AutoConfirmMinConfidence
+}
+
+// AutoConfirmFileMatches метч файлов однозначный и может быть подтвержден без участия пользователя
+/*
+	Метч однозначный, если:
+	- у каждого эпизода есть видеофайл, сопоставленный с уверенностью не ниже AutoConfirmMinConfidence;
+	- нет предупреждений (дубли серий, один видеофайл у нескольких эпизодов, разное количество аудиодорожек);
+	- у всех эпизодов одинаковые аудиодорожки и субтитры (по названиям);
+	- нераспределенными остались только сэмплы, доп. материалы или файлы других сезонов (пак)
+*/
+func (s *Service) AutoConfirmFileMatches(contentMatches *ContentMatches) bool {
+	if !s.config.AutoConfirmFileMatches || contentMatches == nil || len(contentMatches.Matches) == 0 {
+		return false
+	}
+
+	seasonNumber := contentMatches.Matches[0].Episode.SeasonNumber
+	audioTracks := trackNames(contentMatches.Matches[0].AudioTracks)
+	subtitles := trackNames(contentMatches.Matches[0].Subtitles)
+	for _, match := range contentMatches.Matches {
+		if match.Video == nil || len(match.Warnings) > 0 {
+			return false
+		}
+		if !s.confidentMatch(*match.Video) {
+			return false
+		}
+		if !slices.Equal(trackNames(match.AudioTracks), audioTracks) || !slices.Equal(trackNames(match.Subtitles), subtitles) {
+			return false
+		}
+	}
+
+	return lo.EveryBy(contentMatches.Unallocated, func(track Track) bool {
+		if track.Match != nil && track.Match.SeasonNumber != seasonNumber {
+			return true
+		}
+		return ignoredUnallocatedPattern.MatchString(strings.ToLower(track.File.RelativePath))
+	})
+}
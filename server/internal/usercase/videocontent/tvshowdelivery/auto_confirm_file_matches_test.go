@@ -0,0 +1,111 @@
+package tvshowdelivery
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoConfirmFileMatches(t *testing.T) {
+	track := func(trackType TrackType, relativePath string) Track {
+		return Track{Type: trackType, File: FileInfo{RelativePath: relativePath}}
+	}
+	episode := func(number int) ContentMatch {
+		video := track(TrackTypeVideo, fmt.Sprintf("Show.S01E%02d.mkv", number))
+		video.Match = &TrackMatch{SeasonNumber: 1, EpisodeNumber: number, Confidence: 1}
+		audio := track(TrackTypeAudio, "Rus/"+video.File.RelativePath)
+		audio.Name = lo.ToPtr("Rus")
+		return ContentMatch{
+			Episode:     EpisodeInfo{SeasonNumber: 1, EpisodeNumber: number},
+			Video:       &video,
+			AudioTracks: []Track{audio},
+		}
+	}
+	matches := func(unallocated ...Track) *ContentMatches {
+		return &ContentMatches{
+			Matches:     []ContentMatch{episode(1), episode(2)},
+			Unallocated: unallocated,
+		}
+	}
+
+	s := &Service{config: Config{AutoConfirmFileMatches: true, AutoConfirmMinConfidence: 0.8}}
+	require.True(t, s.AutoConfirmFileMatches(matches()))
+	// Сэмплы и доп. материалы не мешают подтверждению
+	require.True(t, s.AutoConfirmFileMatches(matches(
+		track(TrackTypeVideo, "Sample/Show.S01E01.sample.mkv"),
+		track(TrackTypeVideo, "Extras/Making of.mkv"),
+	)))
+	// Файлы других сезонов пака
+	otherSeason := track(TrackTypeVideo, "Season 2/Show.S02E01.mkv")
+	otherSeason.Match = &TrackMatch{SeasonNumber: 2, EpisodeNumber: 1}
+	require.True(t, s.AutoConfirmFileMatches(matches(otherSeason)))
+
+	// Выключено
+	disabled := &Service{}
+	require.False(t, disabled.AutoConfirmFileMatches(matches()))
+
+	// Нераспределенный файл, который не удалось сопоставить
+	require.False(t, s.AutoConfirmFileMatches(matches(track(TrackTypeAudio, "Eng/Show.mka"))))
+
+	// Эпизод без видео
+	result := matches()
+	result.Matches[1].Video = nil
+	require.False(t, s.AutoConfirmFileMatches(result))
+
+	// Есть предупреждения
+	result = matches()
+	result.Matches[0].Warnings = []MatchWarning{MatchWarningAudioTracksCountMismatch}
+	require.False(t, s.AutoConfirmFileMatches(result))
+
+	// Разное количество субтитров
+	result = matches()
+	result.Matches[0].Subtitles = []Track{track(TrackTypeSubtitle, "Subs/Show.S01E01.srt")}
+	require.False(t, s.AutoConfirmFileMatches(result))
+
+	// Разные названия аудиодорожек
+	result = matches()
+	result.Matches[1].AudioTracks[0].Name = lo.ToPtr("Eng")
+	require.False(t, s.AutoConfirmFileMatches(result))
+
+	// Разные названия субтитров при одинаковом количестве
+	result = matches()
+	result.Matches[0].Subtitles = []Track{track(TrackTypeSubtitle, "Rus Subs/Show.S01E01.srt")}
+	result.Matches[0].Subtitles[0].Name = lo.ToPtr("Rus Subs")
+	result.Matches[1].Subtitles = []Track{track(TrackTypeSubtitle, "Eng Subs/Show.S01E02.srt")}
+	result.Matches[1].Subtitles[0].Name = lo.ToPtr("Eng Subs")
+	require.False(t, s.AutoConfirmFileMatches(result))
+	result.Matches[1].Subtitles[0].Name = lo.ToPtr("Rus Subs")
+	require.True(t, s.AutoConfirmFileMatches(result))
+
+	require.False(t, s.AutoConfirmFileMatches(&ContentMatches{}))
+}
+
+func TestAutoConfirmFileMatchesMinConfidence(t *testing.T) {
+	episode := func(number int, confidence float64) ContentMatch {
+		return ContentMatch{
+			Episode: EpisodeInfo{SeasonNumber: 1, EpisodeNumber: number},
+			Video: &Track{
+				Type:  TrackTypeVideo,
+				File:  FileInfo{RelativePath: fmt.Sprintf("%02d.mkv", number)},
+				Match: &TrackMatch{SeasonNumber: 1, EpisodeNumber: number, Confidence: confidence},
+			},
+		}
+	}
+	matches := &ContentMatches{Matches: []ContentMatch{episode(1, 1), episode(2, 0.5)}}
+
+	s := &Service{config: Config{AutoConfirmFileMatches: true, AutoConfirmMinConfidence: 0.8}}
+	require.False(t, s.AutoConfirmFileMatches(matches))
+
+	s.config.AutoConfirmMinConfidence = 0.5
+	require.True(t, s.AutoConfirmFileMatches(matches))
+
+	// Видеофайл выбран без автоматического сопоставления
+	matches.Matches[1].Video.Match = nil
+	require.False(t, s.AutoConfirmFileMatches(matches))
+
+	// Уверенность не проверяется
+	s.config.AutoConfirmMinConfidence = 0
+	require.True(t, s.AutoConfirmFileMatches(matches))
+}
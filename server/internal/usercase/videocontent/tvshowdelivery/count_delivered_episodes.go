@@ -0,0 +1,23 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// CountDeliveredEpisodes количество эпизодов, файлы которых есть в каталоге сезона на медиасервере
+func (s *Service) CountDeliveredEpisodes(_ context.Context, contentMatches []ContentMatch) (int, error) {
+	count := 0
+	for _, match := range contentMatches {
+		_, err := os.Stat(match.Episode.FullPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("os.Stat: %w", err)
+		}
+		count++
+	}
+	return count, nil
+}
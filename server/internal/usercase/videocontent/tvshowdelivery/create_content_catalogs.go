@@ -8,11 +8,15 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/samber/lo"
+
 	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 )
 
 type CreateContentCatalogsParams struct {
 	TVShowCatalogPath TVShowCatalogPath
+	// Episodes эпизоды доставляемой версии, их файлов не должно быть в каталоге сезона
+	Episodes []EpisodeInfo
 }
 
 func (s *Service) createDirectories(seasonPath string) error {
@@ -43,12 +47,20 @@ func (s *Service) createDirectories(seasonPath string) error {
 	return nil
 }
 
-func isEmpty(dirPath string) (bool, error) {
+// hasFiles в каталоге есть файлы с указанными именами (без учета расширения)
+func hasFiles(dirPath string, names []string) (bool, error) {
 	entries, err := os.ReadDir(dirPath)
 	if err != nil {
 		return false, err
 	}
-	return len(entries) == 0, nil
+	for _, entry := range entries {
+		for _, name := range names {
+			if entry.Name() == name || strings.HasPrefix(entry.Name(), name+".") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
 }
 
 // CreateContentCatalogs формирование каталога куда будет сохранен контент
@@ -59,11 +71,15 @@ func (s *Service) CreateContentCatalogs(ctx context.Context, params CreateConten
 		return fmt.Errorf("createDirectories: %w", createErr)
 	}
 
-	// Если каталог сезона не пустой, то выдаем ошибку, что бы пользователь сам устранил ошибку
-	if ok, err := isEmpty(seasonPath); err != nil {
-		return fmt.Errorf("isEmpty: %w", err)
-	} else if !ok {
-		return fmt.Errorf("catalog is not empty: %w", ucerr.AlreadyExists)
+	// Каталог сезона общий для всех версий и может быть не пустым
+	// Если в нем уже есть файлы эпизодов этой версии, то выдаем ошибку, что бы пользователь сам устранил ошибку
+	names := lo.Map(params.Episodes, func(item EpisodeInfo, _ int) string {
+		return item.RelativePath
+	})
+	if ok, err := hasFiles(seasonPath, names); err != nil {
+		return fmt.Errorf("hasFiles: %w", err)
+	} else if ok {
+		return fmt.Errorf("episode files already exist: %w", ucerr.AlreadyExists)
 	}
 
 	return nil
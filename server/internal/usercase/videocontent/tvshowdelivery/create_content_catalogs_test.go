@@ -0,0 +1,38 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+)
+
+func TestCreateContentCatalogs(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	s := &Service{config: Config{BasePath: base}}
+
+	catalogPath := TVShowCatalogPath{TVShowPath: filepath.Join(base, "tvshows", "Тьма (2017)"), SeasonPath: "S01 Сезон 1"}
+	episodes := func(version string) []EpisodeInfo {
+		name := "S01E01 Эпизод 1"
+		if version != "" {
+			name += " - " + version
+		}
+		return []EpisodeInfo{{SeasonNumber: 1, EpisodeNumber: 1, RelativePath: name}}
+	}
+
+	require.NoError(t, s.CreateContentCatalogs(ctx, CreateContentCatalogsParams{TVShowCatalogPath: catalogPath, Episodes: episodes("")}))
+	require.DirExists(t, catalogPath.FullSeasonPath())
+	require.NoError(t, os.WriteFile(filepath.Join(catalogPath.FullSeasonPath(), "S01E01 Эпизод 1.mkv"), []byte("x"), 0o644))
+
+	// Другая версия доставляется в тот же каталог сезона
+	require.NoError(t, s.CreateContentCatalogs(ctx, CreateContentCatalogsParams{TVShowCatalogPath: catalogPath, Episodes: episodes("4K")}))
+
+	// Файлы этой версии уже лежат в каталоге
+	err := s.CreateContentCatalogs(ctx, CreateContentCatalogsParams{TVShowCatalogPath: catalogPath, Episodes: episodes("")})
+	require.ErrorIs(t, err, ucerr.AlreadyExists)
+}
@@ -7,9 +7,10 @@ import (
 
 	"github.com/kkiling/media-delivery/internal/adapter/emby"
 	"github.com/kkiling/media-delivery/internal/adapter/matchtvshow"
+	"github.com/kkiling/media-delivery/internal/adapter/mediaprobe"
 	"github.com/kkiling/media-delivery/internal/adapter/mkvmerge"
 	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
-	"github.com/kkiling/media-delivery/internal/adapter/rutracker"
+	"github.com/kkiling/media-delivery/internal/adapter/trackers"
 	"github.com/kkiling/media-delivery/internal/usercase/labels"
 	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
 )
@@ -20,8 +21,8 @@ type TVShowLibrary interface {
 }
 
 type TorrentSite interface {
-	SearchTorrents(query string) (*rutracker.TorrentResponse, error)
-	GetMagnetLink(torrentUrl string) (*rutracker.MagnetInfo, error)
+	SearchTorrents(query string) (*trackers.TorrentResponse, error)
+	GetMagnetLink(torrentUrl string) (*trackers.MagnetInfo, error)
 }
 
 type TorrentClient interface {
@@ -29,10 +30,15 @@ type TorrentClient interface {
 	GetTorrentInfo(hash string) (*qbittorrent.TorrentInfo, error)
 	GetTorrentFiles(hash string) ([]qbittorrent.TorrentFile, error)
 	ResumeTorrent(hash string) error
+	DeleteTorrent(hash string, deleteFiles bool) error
 }
 
 type PrepareTVShow interface {
-	MatchEpisodeFiles(torrentFiles []string) (*matchtvshow.ContentMatches, error)
+	MatchEpisodeFiles(torrentFiles []string, seasons []matchtvshow.SeasonInfo) (*matchtvshow.ContentMatches, error)
+}
+
+type MediaProbe interface {
+	Probe(ctx context.Context, filePath string) (*mediaprobe.MediaInfo, error)
 }
 
 type MkvMergePipeline interface {
@@ -41,7 +47,7 @@ type MkvMergePipeline interface {
 }
 
 type EmbyApi interface {
-	Refresh() error
+	ScanPath(path string) error
 	ResetMetadata(embyID uint64) error
 	RemoteSearchApply(embyID, theMovieDBID uint64) error
 	GetCatalogInfo(path string) (*emby.CatalogInfo, error)
@@ -50,3 +56,7 @@ type EmbyApi interface {
 type Labels interface {
 	AddLabel(ctx context.Context, label labels.Label) error
 }
+
+type Notifier interface {
+	Send(ctx context.Context, text string) error
+}
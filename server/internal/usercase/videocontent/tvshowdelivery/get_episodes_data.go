@@ -12,8 +12,13 @@ import (
 	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
 )
 
+// specialsSeasonName каталог спешлов (0 сезона)
+const specialsSeasonName = "Specials"
+
 type GetEpisodesDataParams struct {
 	TVShowID common.TVShowID
+	// Version версия видеоконтента (суффикс файлов эпизодов)
+	Version string
 }
 
 // GetEpisodesData получение информацию о эпизодах сериала и формируем имена каталогов и файлов
@@ -46,13 +51,19 @@ func (s *Service) GetEpisodesData(ctx context.Context, params GetEpisodesDataPar
 	}
 
 	// Название сезона
+	// Каталог сезона общий для всех версий, медиасервер (Emby) группирует версии по именам файлов
 	/*
 		Series Name/
 		  Season 01/
 		    S01E01 - Episode Name.mp4
+		    S01E01 - Episode Name - 4K.mp4
 	*/
-	tvShowName := fmt.Sprintf("%s (%d)", tvShowInfo.Result.Name, tvShowInfo.Result.FirstAirDate.Year())
-	seasonName := fmt.Sprintf("S%02d %s", season.SeasonNumber, season.Name)
+	tvShowName := fmt.Sprintf("%s (%d)", common.PathName(tvShowInfo.Result.Name), tvShowInfo.Result.FirstAirDate.Year())
+	seasonName := fmt.Sprintf("S%02d %s", season.SeasonNumber, common.PathName(season.Name))
+	// Спешлы (0 сезон) медиасервер (Emby) ищет в каталоге Specials, серии называются S00EXX
+	if season.SeasonNumber == 0 {
+		seasonName = specialsSeasonName
+	}
 	tvShowsPath := filepath.Join(s.config.BasePath, s.config.TVShowMediaSaveTvShowsPath, tvShowName)
 
 	tvShowCatalogPath := TVShowCatalogPath{
@@ -62,7 +73,7 @@ func (s *Service) GetEpisodesData(ctx context.Context, params GetEpisodesDataPar
 	return &EpisodesData{
 		TVShowCatalogPath: tvShowCatalogPath,
 		Episodes: lo.Map(seasonInfo.Result.Episodes, func(item tvshowlibrary.Episode, _ int) EpisodeInfo {
-			name := fmt.Sprintf("S%02dE%02d %s", season.SeasonNumber, item.EpisodeNumber, item.Name)
+			name := common.WithVersion(fmt.Sprintf("S%02dE%02d %s", season.SeasonNumber, item.EpisodeNumber, common.PathName(item.Name)), params.Version)
 			return EpisodeInfo{
 				SeasonNumber:  season.SeasonNumber,
 				EpisodeNumber: item.EpisodeNumber,
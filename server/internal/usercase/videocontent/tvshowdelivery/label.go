@@ -2,10 +2,12 @@ package tvshowdelivery
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
 	"github.com/kkiling/media-delivery/internal/usercase/labels"
 )
 
@@ -15,8 +17,9 @@ func (s *Service) AddLabelHasVideoContentFiles(ctx context.Context, contentID co
 		TypeLabel: labels.HasVideoContentFiles,
 		CreatedAt: time.Now(),
 	})
-	if err != nil {
-		return fmt.Errorf("labels.AddLabe: %w", err)
+	// Лейбл общий для всех версий фильма / сезона, его могла добавить доставка другой версии
+	if err != nil && !errors.Is(err, ucerr.AlreadyExists) {
+		return fmt.Errorf("labels.AddLabel: %w", err)
 	}
 
 	return nil
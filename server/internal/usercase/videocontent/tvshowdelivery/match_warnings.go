@@ -0,0 +1,50 @@
+package tvshowdelivery
+
+// setMatchWarnings проставляет эпизодам предупреждения о сомнительных сопоставлениях
+func setMatchWarnings(result *ContentMatches) {
+	// Сколько раз видеофайл сопоставлен с эпизодами
+	videoClaims := make(map[string]int)
+	// Сколько эпизодов с таким количеством аудиодорожек
+	audioCounts := make(map[int]int)
+	for _, match := range result.Matches {
+		if match.Video == nil {
+			continue
+		}
+		videoClaims[match.Video.File.RelativePath]++
+		audioCounts[len(match.AudioTracks)]++
+	}
+
+	// Нераспределенные видеофайлы, номер серии которых совпал с уже сопоставленным
+	duplicates := make(map[string]struct{})
+	for _, track := range result.Unallocated {
+		if track.Type != TrackTypeVideo || track.Match == nil {
+			continue
+		}
+		duplicates[episodeToString(track.Match.SeasonNumber, track.Match.EpisodeNumber)] = struct{}{}
+	}
+
+	// Количество аудиодорожек у большинства эпизодов
+	commonAudioCount, maxEpisodes := 0, 0
+	for count, episodes := range audioCounts {
+		if episodes > maxEpisodes || (episodes == maxEpisodes && count > commonAudioCount) {
+			commonAudioCount, maxEpisodes = count, episodes
+		}
+	}
+
+	for i := range result.Matches {
+		match := &result.Matches[i]
+		match.Warnings = nil
+		if match.Video == nil {
+			continue
+		}
+		if _, ok := duplicates[episodeToString(match.Episode.SeasonNumber, match.Episode.EpisodeNumber)]; ok {
+			match.Warnings = append(match.Warnings, MatchWarningDuplicateEpisode)
+		}
+		if videoClaims[match.Video.File.RelativePath] > 1 {
+			match.Warnings = append(match.Warnings, MatchWarningVideoClaimedTwice)
+		}
+		if len(audioCounts) > 1 && len(match.AudioTracks) != commonAudioCount {
+			match.Warnings = append(match.Warnings, MatchWarningAudioTracksCountMismatch)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package tvshowdelivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMatchWarnings(t *testing.T) {
+	video := func(relativePath string, season uint8, episode int) *Track {
+		return &Track{
+			Type:  TrackTypeVideo,
+			File:  FileInfo{RelativePath: relativePath},
+			Match: &TrackMatch{SeasonNumber: season, EpisodeNumber: episode},
+		}
+	}
+	audio := func(relativePath string) Track {
+		return Track{Type: TrackTypeAudio, File: FileInfo{RelativePath: relativePath}}
+	}
+
+	result := &ContentMatches{
+		Matches: []ContentMatch{
+			{
+				Episode:     EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 1},
+				Video:       video("Show.S01E01.mkv", 1, 1),
+				AudioTracks: []Track{audio("Rus/Show.S01E01.mka")},
+			},
+			{
+				Episode:     EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 2},
+				Video:       video("Show.S01E02.mkv", 1, 2),
+				AudioTracks: []Track{audio("Rus/Show.S01E02.mka")},
+			},
+			{
+				// Тот же видеофайл, что и у 2 серии, и без аудиодорожки
+				Episode: EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 3},
+				Video:   video("Show.S01E02.mkv", 1, 2),
+			},
+			{
+				// Эпизод без видео не проверяется
+				Episode: EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 4},
+			},
+		},
+		Unallocated: []Track{
+			*video("Show - 01.mkv", 1, 1),
+			// Нераспределенный файл без сопоставления
+			{Type: TrackTypeVideo, File: FileInfo{RelativePath: "Sample.mkv"}},
+		},
+	}
+
+	setMatchWarnings(result)
+
+	require.Equal(t, []MatchWarning{MatchWarningDuplicateEpisode}, result.Matches[0].Warnings)
+	require.Equal(t, []MatchWarning{MatchWarningVideoClaimedTwice}, result.Matches[1].Warnings)
+	require.Equal(t, []MatchWarning{
+		MatchWarningVideoClaimedTwice,
+		MatchWarningAudioTracksCountMismatch,
+	}, result.Matches[2].Warnings)
+	require.Empty(t, result.Matches[3].Warnings)
+}
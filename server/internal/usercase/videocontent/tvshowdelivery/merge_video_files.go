@@ -77,10 +77,13 @@ func (s *Service) GetMergeVideoStatus(ctx context.Context, mergeIDs []uuid.UUID)
 			return nil, fmt.Errorf("mkvMerge.GetMergeResult: %w", err)
 		}
 
-		if result.Status == mkvmerge.ErrorStatus || result.Status == mkvmerge.CompleteStatus {
+		if result.Status == mkvmerge.ErrorStatus || result.Status == mkvmerge.CompleteStatus || result.Status == mkvmerge.CanceledStatus {
 			if result.Error != nil {
 				status.Errors = append(status.Errors, *result.Error)
 			}
+			if result.Status == mkvmerge.CanceledStatus {
+				status.Errors = append(status.Errors, "merge canceled")
+			}
 			status.Progress += delta
 			continue
 		}
@@ -2,6 +2,7 @@ package tvshowdelivery
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/kkiling/media-delivery/internal/adapter/qbittorrent"
 )
@@ -16,6 +17,8 @@ type SearchQuery struct {
 
 // TorrentSearch результат поиска торрент раздачи
 type TorrentSearch struct {
+	// Source трекер на котором найдена раздача
+	Source string
 	// Наименование раздачи
 	Title string
 	// Категория
@@ -34,6 +37,14 @@ type TorrentSearch struct {
 	Downloads uint32
 	// AddedDate Дата добавления
 	AddedDate string
+	// Score оценка раздачи (0 - 100)
+	Score float64
+	// ScoreReasons из чего сложилась оценка
+	ScoreReasons []string
+	// MatchesProfile раздача подходит под профиль качества (true если профиль не задан)
+	MatchesProfile bool
+	// ProfileMismatches причины несоответствия профилю качества
+	ProfileMismatches []string
 }
 
 type MagnetLink struct {
@@ -107,19 +118,68 @@ const (
 	TrackTypeSubtitle TrackType = "subtitle"
 )
 
+// TrackMatch как файл был автоматически сопоставлен с эпизодом
+type TrackMatch struct {
+	SeasonNumber  uint8
+	EpisodeNumber int
+	// Pattern паттерн имени файла, по которому определены сезон и серия
+	Pattern string
+	// Confidence уверенность сопоставления от 0 до 1
+	Confidence float64
+}
+
 type Track struct {
 	Type     TrackType
 	Name     *string
 	Language *string
 	File     FileInfo
+	// Match nil если файл не удалось сопоставить автоматически
+	Match *TrackMatch
+	// Media информация из контейнера файла, nil пока файл не скачан или формат не распознан
+	Media *TrackMedia
+}
+
+// EmbeddedTrack дорожка внутри контейнера файла
+type EmbeddedTrack struct {
+	Type     TrackType
+	Codec    string
+	Language *string
+	Name     *string
+	// Channels количество каналов (только для аудио)
+	Channels int
+	Default  bool
+	Forced   bool
 }
 
+// TrackMedia информация о дорожке, полученная из контейнера файла (mkvmerge --identify)
+type TrackMedia struct {
+	Codec    string
+	Channels int
+	Duration time.Duration
+	Language *string
+	// EmbeddedTracks дорожки внутри видеофайла (только для видео)
+	EmbeddedTracks []EmbeddedTrack
+}
+
+type MatchWarning string
+
+const (
+	// MatchWarningDuplicateEpisode в раздаче несколько видеофайлов с номером этой серии
+	MatchWarningDuplicateEpisode MatchWarning = "duplicate_episode"
+	// MatchWarningVideoClaimedTwice видеофайл сопоставлен сразу с несколькими эпизодами
+	MatchWarningVideoClaimedTwice MatchWarning = "video_claimed_twice"
+	// MatchWarningAudioTracksCountMismatch количество аудиодорожек отличается от большинства эпизодов
+	MatchWarningAudioTracksCountMismatch MatchWarning = "audio_tracks_count_mismatch"
+)
+
 // ContentMatch сопоставление видео файла с торрент файлом
 type ContentMatch struct {
 	Episode     EpisodeInfo
 	Video       *Track
 	AudioTracks []Track
 	Subtitles   []Track
+	// Warnings на что стоит обратить внимание при подтверждении сопоставления
+	Warnings []MatchWarning
 }
 
 type ContentMatchesOptions struct {
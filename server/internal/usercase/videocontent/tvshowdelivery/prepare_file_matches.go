@@ -8,9 +8,13 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/kkiling/media-delivery/internal/adapter/matchtvshow"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
 )
 
 type PreparingFileMatchesParams struct {
+	// TVShowID сериал, количество серий в сезонах нужно для абсолютной нумерации серий
+	TVShowID     uint64
 	TorrentFiles []FileInfo
 	Episodes     []EpisodeInfo
 }
@@ -76,12 +80,21 @@ func mapResult(prepare *matchtvshow.ContentMatches, torrentFiles []FileInfo, epi
 
 	toTrack := func(item matchtvshow.Track) Track {
 		file := torrentFilesMap[item.File]
-		return Track{
+		track := Track{
 			Type:     TrackType(item.Type),
 			Name:     &item.Name,
 			Language: item.Language,
 			File:     file,
 		}
+		if item.Match != nil {
+			track.Match = &TrackMatch{
+				SeasonNumber:  item.Match.SeasonNumber,
+				EpisodeNumber: item.Match.EpisodeNumber,
+				Pattern:       item.Match.Pattern,
+				Confidence:    item.Match.Confidence,
+			}
+		}
+		return track
 	}
 	toTracks := func(tracks []matchtvshow.Track) []Track {
 		return lo.Map(tracks, func(item matchtvshow.Track, _ int) Track {
@@ -130,6 +143,7 @@ func mapResult(prepare *matchtvshow.ContentMatches, torrentFiles []FileInfo, epi
 		},
 	}
 	sortResult(&result)
+	setMatchWarnings(&result)
 
 	return &result, nil
 }
@@ -141,7 +155,24 @@ func (s *Service) PrepareFileMatches(ctx context.Context, params PreparingFileMa
 		return item.RelativePath
 	})
 
-	prepare, err := s.prepareTVShow.MatchEpisodeFiles(torrentFiles)
+	// Сезоны сериала нужны для перевода абсолютной нумерации серий (аниме) в номер сезона
+	tvShowInfo, err := s.tvShowLibrary.GetTVShowInfo(ctx, tvshowlibrary.GetTVShowParams{
+		TVShowID: params.TVShowID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tvShowLibrary.GetTVShowInfo: %w", err)
+	}
+	if tvShowInfo == nil {
+		return nil, fmt.Errorf("tvShowInfo not found: %w", ucerr.NotFound)
+	}
+	seasons := lo.Map(tvShowInfo.Result.Seasons, func(item tvshowlibrary.Season, _ int) matchtvshow.SeasonInfo {
+		return matchtvshow.SeasonInfo{
+			SeasonNumber: item.SeasonNumber,
+			EpisodeCount: int(item.EpisodeCount),
+		}
+	})
+
+	prepare, err := s.prepareTVShow.MatchEpisodeFiles(torrentFiles, seasons)
 	if err != nil {
 		return nil, fmt.Errorf("prepareTVShow.MatchEpisodeFiles: %w", err)
 	}
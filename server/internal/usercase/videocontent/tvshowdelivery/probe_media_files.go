@@ -0,0 +1,109 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/adapter/mediaprobe"
+)
+
+type ProbeMediaFilesParams struct {
+	ContentMatches *ContentMatches
+}
+
+// probeTrackTypes тип дорожки в контейнере для каждого типа трека
+var probeTrackTypes = map[TrackType]mediaprobe.TrackType{
+	TrackTypeVideo:    mediaprobe.TrackTypeVideo,
+	TrackTypeAudio:    mediaprobe.TrackTypeAudio,
+	TrackTypeSubtitle: mediaprobe.TrackTypeSubtitle,
+}
+
+func mapEmbeddedTrack(track mediaprobe.Track) (EmbeddedTrack, bool) {
+	var trackType TrackType
+	switch track.Type {
+	case mediaprobe.TrackTypeVideo:
+		trackType = TrackTypeVideo
+	case mediaprobe.TrackTypeAudio:
+		trackType = TrackTypeAudio
+	case mediaprobe.TrackTypeSubtitle:
+		trackType = TrackTypeSubtitle
+	default:
+		return EmbeddedTrack{}, false
+	}
+	return EmbeddedTrack{
+		Type:     trackType,
+		Codec:    track.Codec,
+		Language: track.Language,
+		Name:     track.Name,
+		Channels: track.Channels,
+		Default:  track.Default,
+		Forced:   track.Forced,
+	}, true
+}
+
+// mapTrackMedia информация о треке из контейнера файла
+// Для внешних аудиодорожек и субтитров берется первая дорожка своего типа
+func mapTrackMedia(trackType TrackType, info *mediaprobe.MediaInfo) *TrackMedia {
+	media := &TrackMedia{
+		Duration: info.Duration,
+	}
+	if tracks := info.TracksByType(probeTrackTypes[trackType]); len(tracks) > 0 {
+		media.Codec = tracks[0].Codec
+		media.Channels = tracks[0].Channels
+		media.Language = tracks[0].Language
+	}
+	if trackType == TrackTypeVideo {
+		media.EmbeddedTracks = lo.FilterMap(info.Tracks, func(item mediaprobe.Track, _ int) (EmbeddedTrack, bool) {
+			return mapEmbeddedTrack(item)
+		})
+	}
+	return media
+}
+
+// probeTrack заполнение информации о треке из контейнера файла
+func (s *Service) probeTrack(ctx context.Context, track *Track) error {
+	info, err := s.mediaProbe.Probe(ctx, track.File.FullPath)
+	if errors.Is(err, mediaprobe.ErrUnrecognized) {
+		// Формат файла не распознан, метч остается как есть
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("mediaProbe.Probe: %w", err)
+	}
+
+	track.Media = mapTrackMedia(track.Type, info)
+	// Язык из контейнера точнее, чем угаданный по пути файла
+	if track.Media.Language != nil {
+		track.Language = track.Media.Language
+	}
+	return nil
+}
+
+// ProbeMediaFiles получение информации о дорожках скачанных файлов (кодек, каналы, длительность, язык)
+// Файлы доступны только после скачивания, то есть уже после подтверждения метча пользователем,
+// поэтому проба не меняет сопоставление файлов с эпизодами, а только дополняет метаданные треков для склейки.
+// Ошибка пробы одного файла не мешает остальным: трек остается без метаданных, как при нераспознанном формате
+func (s *Service) ProbeMediaFiles(ctx context.Context, params ProbeMediaFilesParams) error {
+	for i := range params.ContentMatches.Matches {
+		match := &params.ContentMatches.Matches[i]
+		var tracks []*Track
+		if match.Video != nil {
+			tracks = append(tracks, match.Video)
+		}
+		for j := range match.AudioTracks {
+			tracks = append(tracks, &match.AudioTracks[j])
+		}
+		for j := range match.Subtitles {
+			tracks = append(tracks, &match.Subtitles[j])
+		}
+		for _, track := range tracks {
+			if err := s.probeTrack(ctx, track); err != nil {
+				s.logger.Warnf("probeTrack %s: %v", track.File.FullPath, err)
+			}
+		}
+	}
+	return nil
+}
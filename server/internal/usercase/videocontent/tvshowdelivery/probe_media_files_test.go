@@ -0,0 +1,102 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/adapter/mediaprobe"
+)
+
+type fakeMediaProbe map[string]*mediaprobe.MediaInfo
+
+func (f fakeMediaProbe) Probe(_ context.Context, filePath string) (*mediaprobe.MediaInfo, error) {
+	info, ok := f[filePath]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", filePath, mediaprobe.ErrUnrecognized)
+	}
+	if info == nil {
+		return nil, fmt.Errorf("%s: permission denied", filePath)
+	}
+	return info, nil
+}
+
+func TestProbeMediaFiles(t *testing.T) {
+	probe := fakeMediaProbe{
+		"/downloads/Dark.S01E01.mkv": {
+			Duration: 53 * time.Minute,
+			Tracks: []mediaprobe.Track{
+				{ID: 0, Type: mediaprobe.TrackTypeVideo, Codec: "AVC/H.264/MPEG-4p10"},
+				{ID: 1, Type: mediaprobe.TrackTypeAudio, Codec: "E-AC-3", Channels: 6, Language: lo.ToPtr("ger"), Name: lo.ToPtr("Original")},
+				{ID: 2, Type: "buttons", Codec: "HDMV PGS"},
+			},
+		},
+		"/downloads/Rus Sound/Dark.S01E01.mka": {
+			Duration: 53 * time.Minute,
+			Tracks: []mediaprobe.Track{
+				{ID: 0, Type: mediaprobe.TrackTypeAudio, Codec: "AC-3", Channels: 2, Language: lo.ToPtr("rus")},
+			},
+		},
+		"/downloads/Subs/Dark.S01E01.srt": {
+			Tracks: []mediaprobe.Track{
+				{ID: 0, Type: mediaprobe.TrackTypeSubtitle, Codec: "SubRip/SRT", Language: lo.ToPtr("eng")},
+			},
+		},
+		// Файл не читается
+		"/downloads/Eng Sound/Dark.S01E01.mka": nil,
+	}
+	s := &Service{logger: log.NewLogger(log.WarnLevel), mediaProbe: probe}
+
+	contentMatches := &ContentMatches{
+		Matches: []ContentMatch{
+			{
+				Episode: EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 1},
+				Video:   &Track{Type: TrackTypeVideo, File: FileInfo{FullPath: "/downloads/Dark.S01E01.mkv"}},
+				AudioTracks: []Track{
+					{Type: TrackTypeAudio, Name: lo.ToPtr("Eng Sound"), File: FileInfo{FullPath: "/downloads/Eng Sound/Dark.S01E01.mka"}},
+					{Type: TrackTypeAudio, Name: lo.ToPtr("Rus Sound"), File: FileInfo{FullPath: "/downloads/Rus Sound/Dark.S01E01.mka"}},
+				},
+				Subtitles: []Track{
+					// Язык из контейнера заменяет угаданный по пути
+					{Type: TrackTypeSubtitle, Language: lo.ToPtr("ru"), File: FileInfo{FullPath: "/downloads/Subs/Dark.S01E01.srt"}},
+					// Формат не распознан
+					{Type: TrackTypeSubtitle, File: FileInfo{FullPath: "/downloads/Subs/Dark.S01E01.sub"}},
+				},
+			},
+			{
+				// Эпизод еще не вышел
+				Episode: EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 2},
+			},
+		},
+	}
+
+	require.NoError(t, s.ProbeMediaFiles(context.Background(), ProbeMediaFilesParams{ContentMatches: contentMatches}))
+
+	episode := contentMatches.Matches[0]
+	require.Equal(t, &TrackMedia{
+		Codec:    "AVC/H.264/MPEG-4p10",
+		Duration: 53 * time.Minute,
+		EmbeddedTracks: []EmbeddedTrack{
+			{Type: TrackTypeVideo, Codec: "AVC/H.264/MPEG-4p10"},
+			{Type: TrackTypeAudio, Codec: "E-AC-3", Channels: 6, Language: lo.ToPtr("ger"), Name: lo.ToPtr("Original")},
+		},
+	}, episode.Video.Media)
+
+	// Ошибка пробы не мешает остальным файлам
+	require.Nil(t, episode.AudioTracks[0].Media)
+
+	audio := episode.AudioTracks[1]
+	require.Equal(t, "AC-3", audio.Media.Codec)
+	require.Equal(t, 2, audio.Media.Channels)
+	require.Equal(t, "rus", *audio.Language)
+	require.Equal(t, "Rus Sound", *audio.Name)
+
+	require.Equal(t, "eng", *episode.Subtitles[0].Language)
+	require.Equal(t, "eng", *episode.Subtitles[0].Media.Language)
+	require.Nil(t, episode.Subtitles[1].Media)
+}
@@ -0,0 +1,88 @@
+package tvshowdelivery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/torrentrank"
+)
+
+// matchQualityProfile проверяет раздачу на соответствие профилю качества
+// Возвращает список причин несоответствия, пустой список - раздача подходит
+func matchQualityProfile(t TorrentSearch, profile qualityprofile.QualityProfile, params rankTorrentParams) []string {
+	var mismatches []string
+
+	resolution := torrentrank.ParseResolution(t.Title)
+	switch {
+	case resolution == 0 && (profile.MinResolution > 0 || profile.MaxResolution > 0):
+		mismatches = append(mismatches, "разрешение не распознано")
+	case profile.MinResolution > 0 && resolution < profile.MinResolution:
+		mismatches = append(mismatches, fmt.Sprintf("разрешение %dp ниже %dp", resolution, profile.MinResolution))
+	case profile.MaxResolution > 0 && resolution > profile.MaxResolution:
+		mismatches = append(mismatches, fmt.Sprintf("разрешение %dp выше %dp", resolution, profile.MaxResolution))
+	}
+
+	codec := torrentrank.ParseCodec(t.Title)
+	if codec != "" && lo.Contains(profile.ForbiddenCodecs, codec) {
+		mismatches = append(mismatches, fmt.Sprintf("запрещенный кодек %s", codec))
+	}
+
+	lower := strings.ToLower(t.Title)
+	for _, audio := range profile.RequiredAudio {
+		if !strings.Contains(lower, strings.ToLower(audio)) {
+			mismatches = append(mismatches, fmt.Sprintf("нет озвучки %s", audio))
+		}
+	}
+
+	if profile.MaxSizePerEpisode > 0 && t.SizeBytes > 0 {
+		episodes := int(params.EpisodeCount)
+		if from, to, _, ok := parseEpisodes(t.Title); ok {
+			episodes = to - from + 1
+		}
+		if episodes > 0 {
+			perEpisode := t.SizeBytes / uint64(episodes)
+			if perEpisode > profile.MaxSizePerEpisode {
+				mismatches = append(mismatches, fmt.Sprintf("размер серии %.2fGB больше %.2fGB",
+					float64(perEpisode)/(1<<30), float64(profile.MaxSizePerEpisode)/(1<<30)))
+			}
+		}
+	}
+
+	return mismatches
+}
+
+// preferredCodecMiss пометка для раздачи с кодеком не из предпочитаемых
+// Предпочитаемые кодеки не ограничивают выбор, раздача при этом подходит под профиль
+func preferredCodecMiss(t TorrentSearch, profile qualityprofile.QualityProfile) (string, bool) {
+	if len(profile.PreferredCodecs) == 0 {
+		return "", false
+	}
+	codec := torrentrank.ParseCodec(t.Title)
+	if lo.Contains(profile.PreferredCodecs, codec) || lo.Contains(profile.ForbiddenCodecs, codec) {
+		return "", false
+	}
+	return fmt.Sprintf("кодек не из предпочитаемых: %s", strings.Join(profile.PreferredCodecs, ", ")), true
+}
+
+// applyQualityProfile помечает раздачи соответствием профилю качества
+// Подходящие раздачи поднимаются в начало списка, порядок внутри групп сохраняется
+func applyQualityProfile(torrents []TorrentSearch, profile *qualityprofile.QualityProfile, params rankTorrentParams) {
+	for i := range torrents {
+		if profile == nil {
+			torrents[i].MatchesProfile = true
+			continue
+		}
+		torrents[i].ProfileMismatches = matchQualityProfile(torrents[i], *profile, params)
+		torrents[i].MatchesProfile = len(torrents[i].ProfileMismatches) == 0
+		if note, ok := preferredCodecMiss(torrents[i], *profile); ok {
+			torrents[i].ScoreReasons = append(torrents[i].ScoreReasons, note)
+		}
+	}
+	sort.SliceStable(torrents, func(i, j int) bool {
+		return torrents[i].MatchesProfile && !torrents[j].MatchesProfile
+	})
+}
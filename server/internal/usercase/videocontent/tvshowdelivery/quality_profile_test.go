@@ -0,0 +1,83 @@
+package tvshowdelivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+)
+
+func TestMatchQualityProfile(t *testing.T) {
+	params := rankTorrentParams{SeasonNumber: 1, EpisodeCount: 10}
+	profile := qualityprofile.QualityProfile{
+		MinResolution:     qualityprofile.Resolution1080,
+		MaxResolution:     qualityprofile.Resolution1080,
+		ForbiddenCodecs:   []string{qualityprofile.CodecXviD},
+		RequiredAudio:     []string{"lostfilm"},
+		MaxSizePerEpisode: 3 << 30,
+	}
+
+	ok := TorrentSearch{
+		Title:     "Тьма / Dark / Сезон: 1 / Серии: 1-10 из 10 [2017, WEB-DL 1080p, x264] MVO (LostFilm)",
+		SizeBytes: 22 << 30,
+	}
+	require.Empty(t, matchQualityProfile(ok, profile, params))
+
+	bad := TorrentSearch{
+		Title:     "Тьма / Dark / Сезон: 1 / Серии: 1-5 из 10 [2017, WEB-DL 2160p, HEVC] MVO (NewStudio)",
+		SizeBytes: 40 << 30,
+	}
+	require.Equal(t, []string{
+		"разрешение 2160p выше 1080p",
+		"нет озвучки lostfilm",
+		"размер серии 8.00GB больше 3.00GB",
+	}, matchQualityProfile(bad, profile, params))
+
+	xvid := TorrentSearch{Title: "Тьма / Dark / Сезон: 1 [DVDRip, XviD] LostFilm"}
+	require.Equal(t, []string{
+		"разрешение 480p ниже 1080p",
+		"запрещенный кодек xvid",
+	}, matchQualityProfile(xvid, profile, params))
+
+	// Кодек не из предпочитаемых не делает раздачу неподходящей
+	preferred := qualityprofile.QualityProfile{PreferredCodecs: []string{qualityprofile.CodecHEVC}}
+	require.Empty(t, matchQualityProfile(bad, preferred, params))
+	require.Empty(t, matchQualityProfile(ok, preferred, params))
+}
+
+func TestApplyQualityProfile(t *testing.T) {
+	params := rankTorrentParams{SeasonNumber: 1, EpisodeCount: 10}
+	torrents := []TorrentSearch{
+		{Href: "720", Title: "Dark S01 720p"},
+		{Href: "1080", Title: "Dark S01 1080p"},
+	}
+
+	applyQualityProfile(torrents, nil, params)
+	require.Equal(t, "720", torrents[0].Href)
+	require.True(t, torrents[0].MatchesProfile)
+	require.True(t, torrents[1].MatchesProfile)
+
+	applyQualityProfile(torrents, &qualityprofile.QualityProfile{MinResolution: qualityprofile.Resolution1080}, params)
+	require.Equal(t, "1080", torrents[0].Href)
+	require.True(t, torrents[0].MatchesProfile)
+	require.False(t, torrents[1].MatchesProfile)
+	require.NotEmpty(t, torrents[1].ProfileMismatches)
+}
+
+func TestApplyQualityProfilePreferredCodecs(t *testing.T) {
+	params := rankTorrentParams{SeasonNumber: 1, EpisodeCount: 10}
+	torrents := []TorrentSearch{
+		{Href: "x264", Title: "Dark S01 1080p x264", ScoreReasons: []string{"1080p (+25)"}},
+		{Href: "hevc", Title: "Dark S01 1080p HEVC"},
+	}
+
+	applyQualityProfile(torrents, &qualityprofile.QualityProfile{PreferredCodecs: []string{qualityprofile.CodecHEVC}}, params)
+	// Порядок по оценке сохраняется, обе раздачи подходят под профиль
+	require.Equal(t, "x264", torrents[0].Href)
+	require.True(t, torrents[0].MatchesProfile)
+	require.Empty(t, torrents[0].ProfileMismatches)
+	require.Equal(t, []string{"1080p (+25)", "кодек не из предпочитаемых: hevc"}, torrents[0].ScoreReasons)
+	require.True(t, torrents[1].MatchesProfile)
+	require.Empty(t, torrents[1].ScoreReasons)
+}
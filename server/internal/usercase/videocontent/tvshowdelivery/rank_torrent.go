@@ -0,0 +1,140 @@
+package tvshowdelivery
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/torrentrank"
+)
+
+var (
+	seasonRuRe = regexp.MustCompile(`(?i)сезон[ыа]?:?\s*(\d{1,2})(?:\s*-\s*(\d{1,2}))?`)
+	seasonEnRe = regexp.MustCompile(`(?i)\bS(\d{1,2})(?:\s*-\s*S?(\d{1,2}))?(?:E\d{1,3})?\b`)
+	episodesRe = regexp.MustCompile(`(?i)сери[ия]:?\s*(\d{1,3})(?:\s*-\s*(\d{1,3}))?\s*из\s*(\d{1,3}|\?+|x+)`)
+)
+
+// rankTorrentParams данные о сезоне, относительно которого оцениваются раздачи
+type rankTorrentParams struct {
+	// SeasonNumber номер искомого сезона
+	SeasonNumber uint8
+	// EpisodeCount количество эпизодов в сезоне (0 - неизвестно)
+	EpisodeCount uint32
+}
+
+// parseNumber число из заголовка, -1 если не удалось распознать
+func parseNumber(s string) int {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return -1
+	}
+	return v
+}
+
+// parseSeasons диапазон сезонов указанных в заголовке раздачи
+func parseSeasons(title string) (from, to int, ok bool) {
+	match := seasonRuRe.FindStringSubmatch(title)
+	if match == nil {
+		match = seasonEnRe.FindStringSubmatch(title)
+	}
+	if match == nil {
+		return 0, 0, false
+	}
+	from = parseNumber(match[1])
+	to = from
+	if match[2] != "" {
+		to = parseNumber(match[2])
+	}
+	return from, to, from >= 0 && to >= from
+}
+
+// parseEpisodes серии в раздаче "Серии: 1-10 из 10", total = 0 если общее количество неизвестно
+func parseEpisodes(title string) (from, to, total int, ok bool) {
+	match := episodesRe.FindStringSubmatch(title)
+	if match == nil {
+		return 0, 0, 0, false
+	}
+	from = parseNumber(match[1])
+	to = from
+	if match[2] != "" {
+		to = parseNumber(match[2])
+	}
+	total = max(parseNumber(match[3]), 0)
+	return from, to, total, from >= 0 && to >= from
+}
+
+// rankSeason проверяет что раздача содержит искомый сезон целиком
+func rankSeason(rank *torrentrank.Rank, title string, params rankTorrentParams) (episodes int) {
+	season := int(params.SeasonNumber)
+	if from, to, ok := parseSeasons(title); ok {
+		switch {
+		case from == season && to == season:
+			rank.Add(10, "сезон %d", season)
+		case from <= season && season <= to:
+			rank.Add(5, "сезоны %d-%d", from, to)
+		default:
+			rank.Add(-50, "другой сезон %d-%d", from, to)
+			return 0
+		}
+	}
+
+	from, to, total, ok := parseEpisodes(title)
+	if !ok {
+		return 0
+	}
+	count := to - from + 1
+	expected := int(params.EpisodeCount)
+	if expected == 0 {
+		expected = total
+	}
+	switch {
+	case expected == 0:
+		// Не знаем сколько серий в сезоне
+	case from <= 1 && to >= expected:
+		rank.Add(25, "полный сезон, серии %d-%d из %d", from, to, expected)
+	default:
+		rank.Add(25*float64(count)/float64(expected)-15, "неполный сезон, серии %d-%d из %d", from, to, expected)
+	}
+	return count
+}
+
+func rankSize(rank *torrentrank.Rank, t TorrentSearch, episodes int) {
+	if episodes <= 0 || t.SizeBytes == 0 {
+		return
+	}
+	perEpisode := float64(t.SizeBytes) / float64(episodes) / (1 << 30)
+	switch {
+	case perEpisode < 0.2:
+		rank.Add(-10, "слишком маленький размер серии %.2fGB", perEpisode)
+	case perEpisode > 20:
+		rank.Add(-5, "слишком большой размер серии %.1fGB", perEpisode)
+	}
+}
+
+// rankTorrent оценка раздачи по заголовку и статистике (0 - 100)
+func rankTorrent(t TorrentSearch, params rankTorrentParams) (float64, []string) {
+	rank := &torrentrank.Rank{}
+
+	rank.Resolution(t.Title)
+	rank.Source(t.Title)
+	rank.Codec(t.Title)
+	episodes := rankSeason(rank, t.Title, params)
+	rank.VoiceOver(t.Title)
+	rankSize(rank, t, episodes)
+	rank.Seeds(t.Seeds)
+
+	return rank.Result()
+}
+
+// rankTorrents проставляет оценки раздачам и сортирует их по убыванию оценки
+func rankTorrents(torrents []TorrentSearch, params rankTorrentParams) {
+	for i := range torrents {
+		torrents[i].Score, torrents[i].ScoreReasons = rankTorrent(torrents[i], params)
+	}
+	sort.SliceStable(torrents, func(i, j int) bool {
+		if torrents[i].Score != torrents[j].Score {
+			return torrents[i].Score > torrents[j].Score
+		}
+		return torrents[i].Downloads > torrents[j].Downloads
+	})
+}
@@ -0,0 +1,95 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankTorrent(t *testing.T) {
+	params := rankTorrentParams{SeasonNumber: 1, EpisodeCount: 10}
+
+	full := TorrentSearch{
+		Title:     "Тьма / Dark / Сезон: 1 / Серии: 1-10 из 10 (Баран бо Одар) [2017, WEB-DL 1080p] MVO (LostFilm) + Original + Sub",
+		SizeBytes: 22 << 30,
+		Seeds:     120,
+	}
+	score, reasons := rankTorrent(full, params)
+	require.Equal(t, float64(90), score)
+	require.Equal(t, []string{
+		"разрешение 1080p (+25)",
+		"WEB-DL (+12)",
+		"сезон 1 (+10)",
+		"полный сезон, серии 1-10 из 10 (+25)",
+		"озвучка lostfilm (+8)",
+		"сидов 120 (+10)",
+	}, reasons)
+
+	partial := TorrentSearch{
+		Title:     "Тьма / Dark / Сезон: 1 / Серии: 1-5 из 10 [2017, WEB-DL 1080p] MVO",
+		SizeBytes: 11 << 30,
+		Seeds:     120,
+	}
+	partialScore, _ := rankTorrent(partial, params)
+	require.Less(t, partialScore, score)
+
+	otherSeason := TorrentSearch{
+		Title:     "Тьма / Dark / Сезон: 2 / Серии: 1-8 из 8 [2019, WEB-DL 1080p] MVO (LostFilm)",
+		SizeBytes: 16 << 30,
+		Seeds:     300,
+	}
+	otherScore, otherReasons := rankTorrent(otherSeason, params)
+	require.Less(t, otherScore, float64(30))
+	require.Contains(t, otherReasons, "другой сезон 2-2 (-50)")
+
+	pack := TorrentSearch{
+		Title:     "Dark.S01-S03.2160p.NF.WEB-DL.HEVC.HDR.DDP5.1",
+		SizeBytes: 120 << 30,
+		Seeds:     15,
+	}
+	packScore, packReasons := rankTorrent(pack, params)
+	require.Contains(t, packReasons, "сезоны 1-3 (+5)")
+	require.Contains(t, packReasons, "HDR (+3)")
+	require.Greater(t, packScore, otherScore)
+
+	dead := TorrentSearch{
+		Title: "Тьма / Dark / Сезон: 1 / Серии: 1-10 из 10 [2017, CAMRip]",
+		Seeds: 0,
+	}
+	deadScore, _ := rankTorrent(dead, params)
+	require.Equal(t, float64(0), deadScore)
+}
+
+func TestRankTorrents(t *testing.T) {
+	torrents := []TorrentSearch{
+		{Href: "other", Title: "Тьма / Сезон: 2 / Серии: 1-8 из 8 [WEB-DL 1080p]", Seeds: 500, Downloads: 1000},
+		{Href: "best", Title: "Тьма / Сезон: 1 / Серии: 1-10 из 10 [WEB-DL 1080p]", Seeds: 50, Downloads: 10},
+		{Href: "partial", Title: "Тьма / Сезон: 1 / Серии: 1-3 из 10 [WEB-DL 1080p]", Seeds: 50, Downloads: 10},
+	}
+	rankTorrents(torrents, rankTorrentParams{SeasonNumber: 1, EpisodeCount: 10})
+
+	require.Equal(t, "best", torrents[0].Href)
+	require.Equal(t, "partial", torrents[1].Href)
+	require.Equal(t, "other", torrents[2].Href)
+	require.NotEmpty(t, torrents[0].ScoreReasons)
+}
+
+func TestService_AutoSelectTorrent(t *testing.T) {
+	torrents := []TorrentSearch{
+		{Href: "a", Score: 60, MatchesProfile: true},
+		{Href: "b", Score: 85, MatchesProfile: true},
+		{Href: "c", Score: 95},
+	}
+
+	disabled := &Service{}
+	require.Nil(t, disabled.AutoSelectTorrent(context.Background(), torrents))
+
+	s := &Service{config: Config{AutoSelectTorrentThreshold: 80}}
+	best := s.AutoSelectTorrent(context.Background(), torrents)
+	require.NotNil(t, best)
+	require.Equal(t, "b", best.Href)
+
+	s = &Service{config: Config{AutoSelectTorrentThreshold: 90}}
+	require.Nil(t, s.AutoSelectTorrent(context.Background(), torrents))
+}
@@ -0,0 +1,73 @@
+package tvshowdelivery
+
+import (
+	"github.com/samber/lo"
+)
+
+type RestoreFileMatchesParams struct {
+	// ContentMatches подтвержденный метч файлов предыдущей доставки
+	ContentMatches *ContentMatches
+	// PrevTorrentFiles файлы раздачи на момент предыдущей доставки
+	PrevTorrentFiles []FileInfo
+	// TorrentFiles файлы раздачи текущей доставки
+	TorrentFiles []FileInfo
+	// Episodes эпизоды сезона текущей доставки
+	Episodes []EpisodeInfo
+}
+
+// RestoreFileMatches перенос подтвержденного метча файлов предыдущей доставки на текущую
+/*
+	Метч переносится только если список файлов раздачи не изменился,
+	иначе возвращается false и метч нужно подтверждать заново.
+	Файлы и эпизоды берутся из текущей доставки: пути каталогов могли поменяться
+*/
+func (s *Service) RestoreFileMatches(params RestoreFileMatchesParams) (*ContentMatches, bool) {
+	if params.ContentMatches == nil || len(params.PrevTorrentFiles) != len(params.TorrentFiles) {
+		return nil, false
+	}
+
+	files := lo.SliceToMap(params.TorrentFiles, func(item FileInfo) (string, FileInfo) {
+		return item.RelativePath, item
+	})
+	for _, file := range params.PrevTorrentFiles {
+		if _, ok := files[file.RelativePath]; !ok {
+			return nil, false
+		}
+	}
+
+	episodes := lo.SliceToMap(params.Episodes, func(item EpisodeInfo) (string, EpisodeInfo) {
+		return episodeToString(item.SeasonNumber, item.EpisodeNumber), item
+	})
+	restoreTrack := func(track Track) Track {
+		track.File = files[track.File.RelativePath]
+		return track
+	}
+	restoreTracks := func(tracks []Track) []Track {
+		return lo.Map(tracks, func(item Track, _ int) Track {
+			return restoreTrack(item)
+		})
+	}
+
+	matches := make([]ContentMatch, 0, len(params.ContentMatches.Matches))
+	for _, match := range params.ContentMatches.Matches {
+		episode, ok := episodes[episodeToString(match.Episode.SeasonNumber, match.Episode.EpisodeNumber)]
+		if !ok {
+			return nil, false
+		}
+		restored := ContentMatch{
+			Episode:     episode,
+			AudioTracks: restoreTracks(match.AudioTracks),
+			Subtitles:   restoreTracks(match.Subtitles),
+		}
+		if match.Video != nil {
+			restored.Video = lo.ToPtr(restoreTrack(*match.Video))
+		}
+		matches = append(matches, restored)
+	}
+
+	return &ContentMatches{
+		Matches:     matches,
+		Unallocated: restoreTracks(params.ContentMatches.Unallocated),
+		Options:     params.ContentMatches.Options,
+	}, true
+}
@@ -0,0 +1,91 @@
+package tvshowdelivery
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreFileMatches(t *testing.T) {
+	s := &Service{}
+	file := func(basePath, relativePath string) FileInfo {
+		return FileInfo{RelativePath: relativePath, FullPath: basePath + "/" + relativePath}
+	}
+	prevFiles := []FileInfo{
+		file("/old/downloads", "Dark.S01E01.mkv"),
+		file("/old/downloads", "Dark.S01E02.mkv"),
+		file("/old/downloads", "Rus Sound/Dark.S01E01.mka"),
+	}
+	prevMatches := &ContentMatches{
+		Matches: []ContentMatch{
+			{
+				// Пути эпизода прошлой доставки уже с расширением файла
+				Episode:     EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 1, FullPath: "/old/tvshows/Dark/S01E01.mkv"},
+				Video:       &Track{Type: TrackTypeVideo, File: prevFiles[0]},
+				AudioTracks: []Track{{Type: TrackTypeAudio, Name: lo.ToPtr("Rus"), File: prevFiles[2]}},
+			},
+			{
+				Episode: EpisodeInfo{SeasonNumber: 1, EpisodeNumber: 2, FullPath: "/old/tvshows/Dark/S01E02.mkv"},
+				Video:   &Track{Type: TrackTypeVideo, File: prevFiles[1]},
+			},
+		},
+		Options: ContentMatchesOptions{KeepOriginalAudio: true},
+	}
+	files := []FileInfo{
+		file("/new/downloads", "Dark.S01E02.mkv"),
+		file("/new/downloads", "Dark.S01E01.mkv"),
+		file("/new/downloads", "Rus Sound/Dark.S01E01.mka"),
+	}
+	episodes := []EpisodeInfo{
+		{SeasonNumber: 1, EpisodeNumber: 1, FullPath: "/new/tvshows/Dark/S01E01"},
+		{SeasonNumber: 1, EpisodeNumber: 2, FullPath: "/new/tvshows/Dark/S01E02"},
+	}
+
+	t.Run("files not changed", func(t *testing.T) {
+		got, ok := s.RestoreFileMatches(RestoreFileMatchesParams{
+			ContentMatches:   prevMatches,
+			PrevTorrentFiles: prevFiles,
+			TorrentFiles:     files,
+			Episodes:         episodes,
+		})
+		require.True(t, ok)
+		require.Len(t, got.Matches, 2)
+		require.Equal(t, episodes[0], got.Matches[0].Episode)
+		require.Equal(t, files[1], got.Matches[0].Video.File)
+		require.Equal(t, files[2], got.Matches[0].AudioTracks[0].File)
+		require.Equal(t, "Rus", *got.Matches[0].AudioTracks[0].Name)
+		require.Equal(t, files[0], got.Matches[1].Video.File)
+		require.True(t, got.Options.KeepOriginalAudio)
+		// Метч прошлой доставки не меняется
+		require.Equal(t, prevFiles[0], prevMatches.Matches[0].Video.File)
+	})
+
+	t.Run("file list changed", func(t *testing.T) {
+		_, ok := s.RestoreFileMatches(RestoreFileMatchesParams{
+			ContentMatches:   prevMatches,
+			PrevTorrentFiles: prevFiles,
+			TorrentFiles:     append(files[:2:2], file("/new/downloads", "Dark.S01E03.mkv")),
+			Episodes:         episodes,
+		})
+		require.False(t, ok)
+
+		_, ok = s.RestoreFileMatches(RestoreFileMatchesParams{
+			ContentMatches:   prevMatches,
+			PrevTorrentFiles: prevFiles,
+			TorrentFiles:     files[:2],
+			Episodes:         episodes,
+		})
+		require.False(t, ok)
+	})
+
+	t.Run("episode not found", func(t *testing.T) {
+		_, ok := s.RestoreFileMatches(RestoreFileMatchesParams{
+			ContentMatches:   prevMatches,
+			PrevTorrentFiles: prevFiles,
+			TorrentFiles:     files,
+			Episodes:         episodes[:1],
+		})
+		require.False(t, ok)
+	})
+}
@@ -3,30 +3,53 @@ package tvshowdelivery
 import (
 	"context"
 	"fmt"
-	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
 )
 
 type SearchTorrentParams struct {
+	TVShowID    common.TVShowID
 	SearchQuery string
+	// QualityProfile профиль качества, по которому помечаются раздачи (может быть nil)
+	QualityProfile *qualityprofile.QualityProfile
 }
 
-// Функция для расчета рейтинга
-func calculateScore(t TorrentSearch) float64 {
-	// Веса можно настроить под ваши предпочтения
-	seedWeight := 0.5
-	downloadWeight := 0.3
-	ratioWeight := 0.2
+func (s *Service) getRankTorrentParams(ctx context.Context, tvShowID common.TVShowID) (rankTorrentParams, error) {
+	params := rankTorrentParams{
+		SeasonNumber: tvShowID.SeasonNumber,
+	}
 
-	ratio := float64(t.Seeds) / float64(t.Leeches+1)
+	tvShowInfo, err := s.tvShowLibrary.GetTVShowInfo(ctx, tvshowlibrary.GetTVShowParams{
+		TVShowID: tvShowID.ID,
+	})
+	if err != nil {
+		return params, fmt.Errorf("tvShowLibrary.GetTVShowInfo: %w", err)
+	}
+	if tvShowInfo == nil {
+		return params, nil
+	}
 
-	// Нормализуем значения (можно добавить нормализацию)
-	return float64(t.Seeds)*seedWeight +
-		float64(t.Downloads)*downloadWeight +
-		ratio*ratioWeight
+	season, find := lo.Find(tvShowInfo.Result.Seasons, func(item tvshowlibrary.Season) bool {
+		return item.SeasonNumber == tvShowID.SeasonNumber
+	})
+	if find {
+		params.EpisodeCount = season.EpisodeCount
+	}
+
+	return params, nil
 }
 
-// SearchTorrent Делаем запрос к торрент сайту, получаем список раздач
-func (s *Service) SearchTorrent(_ context.Context, params SearchTorrentParams) ([]TorrentSearch, error) {
+// SearchTorrent Делаем запрос к торрент сайту, получаем список раздач отсортированный по оценке
+func (s *Service) SearchTorrent(ctx context.Context, params SearchTorrentParams) ([]TorrentSearch, error) {
+	rankParams, err := s.getRankTorrentParams(ctx, params.TVShowID)
+	if err != nil {
+		return nil, fmt.Errorf("getRankTorrentParams: %w", err)
+	}
+
 	searchResult, err := s.torrentSite.SearchTorrents(params.SearchQuery)
 	if err != nil {
 		return nil, fmt.Errorf("torrentSite.SearchTorrents: %w", err)
@@ -35,6 +58,7 @@ func (s *Service) SearchTorrent(_ context.Context, params SearchTorrentParams) (
 	var result []TorrentSearch
 	for _, item := range searchResult.Results {
 		result = append(result, TorrentSearch{
+			Source:     item.Source,
 			Title:      item.Title,
 			Href:       item.Href,
 			SizeBytes:  item.SizeBytes,
@@ -47,10 +71,28 @@ func (s *Service) SearchTorrent(_ context.Context, params SearchTorrentParams) (
 		})
 	}
 
-	// Сортировка
-	sort.Slice(result, func(i, j int) bool {
-		return calculateScore(result[i]) > calculateScore(result[j])
-	})
+	rankTorrents(result, rankParams)
+	applyQualityProfile(result, params.QualityProfile, rankParams)
 
 	return result, nil
 }
+
+// AutoSelectTorrent выбор раздачи без участия пользователя
+// Возвращает nil если автоматический выбор выключен или ни одна подходящая под профиль раздача не набрала нужную оценку
+func (s *Service) AutoSelectTorrent(_ context.Context, torrents []TorrentSearch) *TorrentSearch {
+	torrents = lo.Filter(torrents, func(item TorrentSearch, _ int) bool {
+		return item.MatchesProfile
+	})
+	if s.config.AutoSelectTorrentThreshold <= 0 || len(torrents) == 0 {
+		return nil
+	}
+
+	best := lo.MaxBy(torrents, func(a, b TorrentSearch) bool {
+		return a.Score > b.Score
+	})
+	if best.Score < s.config.AutoSelectTorrentThreshold {
+		return nil
+	}
+
+	return &best
+}
@@ -0,0 +1,63 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kkiling/media-delivery/internal/common"
+	ucerr "github.com/kkiling/media-delivery/internal/usercase/err"
+	"github.com/kkiling/media-delivery/internal/usercase/tvshowlibrary"
+)
+
+type SendDeliveryNotificationParams struct {
+	TVShowID common.TVShowID
+	// EpisodesCount количество доставленных серий
+	EpisodesCount int
+	// TVShowCatalog каталоги и размеры доставленного сезона
+	TVShowCatalog *TVShowCatalog
+}
+
+// deliveryNotificationText текст уведомления о доставке сезона
+func deliveryNotificationText(tvShowName string, params SendDeliveryNotificationParams) string {
+	var sb strings.Builder
+	sb.WriteString("Сезон сериала доставлен на медиасервер\n")
+	sb.WriteString(fmt.Sprintf("%s\n", tvShowName))
+	sb.WriteString(fmt.Sprintf("Сезон %d, серий: %d\n", params.TVShowID.SeasonNumber, params.EpisodesCount))
+
+	if catalog := params.TVShowCatalog; catalog != nil {
+		sb.WriteString(fmt.Sprintf("Размер раздачи: %s\n", catalog.TorrentSizePretty))
+		sb.WriteString(fmt.Sprintf("Размер на медиасервере: %s", catalog.MediaServerSizePretty))
+		if catalog.IsCopyFilesInMediaServer {
+			sb.WriteString(" (файлы скопированы)")
+		} else {
+			sb.WriteString(" (жесткие ссылки)")
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// SendDeliveryNotification отправка уведомления о доставке сезона сериала
+func (s *Service) SendDeliveryNotification(ctx context.Context, params SendDeliveryNotificationParams) error {
+	tvShowInfo, err := s.tvShowLibrary.GetTVShowInfo(ctx, tvshowlibrary.GetTVShowParams{
+		TVShowID: params.TVShowID.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("tvShowLibrary.GetTVShowInfo: %w", err)
+	}
+	if tvShowInfo == nil || tvShowInfo.Result == nil {
+		return fmt.Errorf("tvShowInfo not found: %w", ucerr.NotFound)
+	}
+
+	name := tvShowInfo.Result.Name
+	if !tvShowInfo.Result.FirstAirDate.IsZero() {
+		name = fmt.Sprintf("%s (%d)", name, tvShowInfo.Result.FirstAirDate.Year())
+	}
+
+	if err = s.notifier.Send(ctx, deliveryNotificationText(name, params)); err != nil {
+		return fmt.Errorf("notifier.Send: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package tvshowdelivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/common"
+)
+
+func TestDeliveryNotificationText(t *testing.T) {
+	params := SendDeliveryNotificationParams{
+		TVShowID:      common.TVShowID{ID: 70523, SeasonNumber: 2},
+		EpisodesCount: 8,
+		TVShowCatalog: &TVShowCatalog{
+			TorrentSizePretty:     "21.50GB",
+			MediaServerSizePretty: "21.50GB",
+		},
+	}
+
+	require.Equal(t, "Сезон сериала доставлен на медиасервер\n"+
+		"Тьма (2017)\n"+
+		"Сезон 2, серий: 8\n"+
+		"Размер раздачи: 21.50GB\n"+
+		"Размер на медиасервере: 21.50GB (жесткие ссылки)",
+		deliveryNotificationText("Тьма (2017)", params))
+
+	params.TVShowCatalog.IsCopyFilesInMediaServer = true
+	params.TVShowCatalog.MediaServerSizePretty = "23.10GB"
+	require.Contains(t, deliveryNotificationText("Тьма (2017)", params), "Размер на медиасервере: 23.10GB (файлы скопированы)")
+
+	params.TVShowCatalog = nil
+	require.Equal(t, "Сезон сериала доставлен на медиасервер\nТьма (2017)\nСезон 2, серий: 8",
+		deliveryNotificationText("Тьма (2017)", params))
+}
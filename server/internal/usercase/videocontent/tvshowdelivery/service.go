@@ -1,5 +1,9 @@
 package tvshowdelivery
 
+import (
+	"github.com/kkiling/goplatform/log"
+)
+
 type Config struct {
 	// BasePath Базовый путь от которого расположены все файлы торрента или медиа сервера
 	// Например скачанные сериалы лежат по пути BasePath + TVShowTorrentSavePath
@@ -8,37 +12,54 @@ type Config struct {
 	TVShowTorrentSavePath string
 	// TVShowMediaSaveTvShowsPath путь сохранения сериалов относительно медиа сервера
 	TVShowMediaSaveTvShowsPath string
+	// AutoSelectTorrentThreshold минимальная оценка раздачи (0 - 100), при которой она выбирается без участия пользователя
+	// 0 - автоматический выбор выключен
+	AutoSelectTorrentThreshold float64
+	// AutoConfirmFileMatches подтверждать однозначный метч файлов без участия пользователя
+	AutoConfirmFileMatches bool
+	// AutoConfirmMinConfidence минимальная уверенность (0 - 1) сопоставления каждого видеофайла с эпизодом
+	// 0 - уверенность не проверяется
+	AutoConfirmMinConfidence float64
 }
 
 type Service struct {
+	logger        log.Logger
 	config        Config
 	tvShowLibrary TVShowLibrary
 	torrentSite   TorrentSite
 	torrentClient TorrentClient
 	embyApi       EmbyApi
 	prepareTVShow PrepareTVShow
+	mediaProbe    MediaProbe
 	mkvMerge      MkvMergePipeline
 	labels        Labels
+	notifier      Notifier
 }
 
 func NewService(
+	logger log.Logger,
 	config Config,
 	tvShowLibrary TVShowLibrary,
 	torrentSite TorrentSite,
 	torrentClient TorrentClient,
 	embyApi EmbyApi,
 	prepareTVShow PrepareTVShow,
+	mediaProbe MediaProbe,
 	mkvMerge MkvMergePipeline,
 	labels Labels,
+	notifier Notifier,
 ) *Service {
 	return &Service{
+		logger:        logger.Named("tvshowdelivery"),
 		config:        config,
 		tvShowLibrary: tvShowLibrary,
 		torrentSite:   torrentSite,
 		torrentClient: torrentClient,
 		embyApi:       embyApi,
 		prepareTVShow: prepareTVShow,
+		mediaProbe:    mediaProbe,
 		mkvMerge:      mkvMerge,
 		labels:        labels,
+		notifier:      notifier,
 	}
 }
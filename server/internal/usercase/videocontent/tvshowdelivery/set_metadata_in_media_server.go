@@ -21,8 +21,9 @@ func (s *Service) SetMediaMetaData(ctx context.Context, params SetMediaMetaDataP
 		return fmt.Errorf("failed to get relative path: %w", err)
 	}
 
-	if err = s.embyApi.Refresh(); err != nil {
-		return fmt.Errorf("failed to refresh emby api: %w", err)
+	// Сканируем только каталог доставленного сериала
+	if err = s.embyApi.ScanPath("/" + tvShowPath); err != nil {
+		return fmt.Errorf("failed to scan path: %w", err)
 	}
 
 	info, err := s.embyApi.GetCatalogInfo("/" + tvShowPath)
@@ -0,0 +1,28 @@
+package tvshowdelivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// DeleteOldTorrentFromTorrentClient удаление из торрент клиента раздачи, замененной обновленной
+// Файлы не удаляются - обновленная раздача скачивается в тот же каталог и переиспользует их
+func (s *Service) DeleteOldTorrentFromTorrentClient(_ context.Context, hash string) error {
+	if err := s.torrentClient.DeleteTorrent(hash, false); err != nil {
+		return fmt.Errorf("torrentClient.DeleteTorrent: %w", err)
+	}
+	return nil
+}
+
+// FilterNewEpisodes эпизоды сезона, которые еще не были доставлены на медиасервер
+func (s *Service) FilterNewEpisodes(delivered []ContentMatch, episodes []EpisodeInfo) []EpisodeInfo {
+	deliveredEpisodes := lo.SliceToMap(delivered, func(item ContentMatch) (string, struct{}) {
+		return episodeToString(item.Episode.SeasonNumber, item.Episode.EpisodeNumber), struct{}{}
+	})
+	return lo.Filter(episodes, func(item EpisodeInfo, _ int) bool {
+		_, ok := deliveredEpisodes[episodeToString(item.SeasonNumber, item.EpisodeNumber)]
+		return !ok
+	})
+}
@@ -0,0 +1,33 @@
+package tvshowdelivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterNewEpisodes(t *testing.T) {
+	s := &Service{}
+	episode := func(number int) EpisodeInfo {
+		return EpisodeInfo{SeasonNumber: 1, EpisodeNumber: number}
+	}
+	delivered := []ContentMatch{
+		{Episode: episode(1)},
+		{Episode: episode(2)},
+	}
+
+	t.Run("new episodes aired", func(t *testing.T) {
+		got := s.FilterNewEpisodes(delivered, []EpisodeInfo{episode(1), episode(2), episode(3), episode(4)})
+		require.Equal(t, []EpisodeInfo{episode(3), episode(4)}, got)
+	})
+
+	t.Run("nothing new", func(t *testing.T) {
+		got := s.FilterNewEpisodes(delivered, []EpisodeInfo{episode(1), episode(2)})
+		require.Empty(t, got)
+	})
+
+	t.Run("nothing delivered", func(t *testing.T) {
+		got := s.FilterNewEpisodes(nil, []EpisodeInfo{episode(1)})
+		require.Equal(t, []EpisodeInfo{episode(1)}, got)
+	})
+}
@@ -3,8 +3,14 @@ package videocontent
 import (
 	"github.com/kkiling/media-delivery/internal/common"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/content"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/moviedelivery"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/qualityprofile"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeletestate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/moviedeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowcancelstate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeletestate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowdeliverystate"
+	"github.com/kkiling/media-delivery/internal/usercase/videocontent/runners/tvshowupdatestate"
 	"github.com/kkiling/media-delivery/internal/usercase/videocontent/tvshowdelivery"
 )
 
@@ -33,16 +39,40 @@ const (
 	DeliveryStatusUpdating   = content.DeliveryStatusUpdating
 	DeliveryStatusDeleting   = content.DeliveryStatusDeleting
 	DeliveryStatusDeleted    = content.DeliveryStatusDeleted
+	DeliveryStatusCanceling  = content.DeliveryStatusCanceling
+	DeliveryStatusCanceled   = content.DeliveryStatusCanceled
 )
 
+type SeasonCheck = content.SeasonCheck
+type SeasonCheckResult = content.SeasonCheckResult
+
+const (
+	SeasonCheckUpToDate       = content.SeasonCheckUpToDate
+	SeasonCheckWaitingTorrent = content.SeasonCheckWaitingTorrent
+	SeasonCheckUpdateStarted  = content.SeasonCheckUpdateStarted
+	SeasonCheckError          = content.SeasonCheckError
+)
+
+type QualityProfile = qualityprofile.QualityProfile
+type CreateQualityProfileParams = qualityprofile.CreateQualityProfileParams
+type UpdateQualityProfileParams = qualityprofile.UpdateQualityProfileParams
+
 type CreateVideoContentParams = content.CreateVideoContentParams
 type DeliveryVideoContentParams = content.DeliveryVideoContentParams
 type CreateDeleteStateParams = content.DeleteVideoContentFilesParams
+type CancelDeliveryParams = content.CancelDeliveryParams
+type RetryDeliveryStepParams = content.RetryDeliveryStepParams
+type RetryMovieDeliveryStepParams = content.RetryMovieDeliveryStepParams
+type UpdateDeliveryParams = content.UpdateDeliveryParams
 
 type TVShowDeleteState = tvshowdeletestate.State
+type TVShowCancelState = tvshowcancelstate.State
+type TVShowUpdateState = tvshowupdatestate.State
+type MovieDeleteState = moviedeletestate.State
 
 type TVShowDeliveryState = tvshowdeliverystate.State
 type TVShowDeliveryData = tvshowdeliverystate.TVShowDeliveryData
+type RetryAttempt = tvshowdeliverystate.RetryAttempt
 type TorrentSearch = tvshowdelivery.TorrentSearch
 type ContentMatches = tvshowdelivery.ContentMatches
 type ContentMatch = tvshowdelivery.ContentMatch
@@ -57,6 +87,17 @@ const (
 	TrackTypeSubtitle = tvshowdelivery.TrackTypeSubtitle
 )
 
+type TrackMatch = tvshowdelivery.TrackMatch
+type TrackMedia = tvshowdelivery.TrackMedia
+type EmbeddedTrack = tvshowdelivery.EmbeddedTrack
+type MatchWarning = tvshowdelivery.MatchWarning
+
+const (
+	MatchWarningDuplicateEpisode         = tvshowdelivery.MatchWarningDuplicateEpisode
+	MatchWarningVideoClaimedTwice        = tvshowdelivery.MatchWarningVideoClaimedTwice
+	MatchWarningAudioTracksCountMismatch = tvshowdelivery.MatchWarningAudioTracksCountMismatch
+)
+
 type FileInfo = tvshowdelivery.FileInfo
 type ChoseTorrentOptions = tvshowdeliverystate.ChoseTorrentOptions
 type ChoseFileMatchesOptions = tvshowdeliverystate.ChoseFileMatchesOptions
@@ -72,6 +113,7 @@ const (
 	PrepareFileMatches             = tvshowdeliverystate.PrepareFileMatches
 	WaitingChoseFileMatches        = tvshowdeliverystate.WaitingChoseFileMatches
 	WaitingTorrentDownloadComplete = tvshowdeliverystate.WaitingTorrentDownloadComplete
+	ProbeMediaFiles                = tvshowdeliverystate.ProbeMediaFiles
 	CreateVideoContentCatalogs     = tvshowdeliverystate.CreateVideoContentCatalogs
 	DeterminingNeedConvertFiles    = tvshowdeliverystate.DeterminingNeedConvertFiles
 	StartMergeVideoFiles           = tvshowdeliverystate.StartMergeVideoFiles
@@ -83,3 +125,64 @@ const (
 	WaitingTorrentFiles            = tvshowdeliverystate.WaitingTorrentFiles
 	GetEpisodesData                = tvshowdeliverystate.GetEpisodesData
 )
+
+type MovieDeliveryState = moviedeliverystate.State
+type MovieDeliveryData = moviedeliverystate.MovieDeliveryData
+type MovieTorrentSearch = moviedelivery.TorrentSearch
+type MovieTrack = moviedelivery.Track
+type MovieTrackType = moviedelivery.TrackType
+type MovieTorrentState = moviedelivery.TorrentState
+type ChoseMovieTorrentOptions = moviedeliverystate.ChoseTorrentOptions
+type MovieRetryAttempt = moviedeliverystate.RetryAttempt
+
+type StepCancel = tvshowcancelstate.StepCancel
+
+const (
+	StartCancelTVShowDelivery            = tvshowcancelstate.StartCancelTVShowDelivery
+	CancelMergeVideoFiles                = tvshowcancelstate.CancelMergeVideoFiles
+	CancelDeleteTorrentFromTorrentClient = tvshowcancelstate.DeleteTorrentFromTorrentClient
+	CancelDeleteTorrentFiles             = tvshowcancelstate.DeleteTorrentFiles
+	CancelDeleteSeasonCatalog            = tvshowcancelstate.DeleteSeasonCatalog
+	CancelDeleteLabel                    = tvshowcancelstate.DeleteLabel
+)
+
+type StepUpdate = tvshowupdatestate.StepUpdate
+
+const (
+	StartUpdateTVShowSeason                 = tvshowupdatestate.StartUpdateTVShowSeason
+	UpdateGetMagnetLink                     = tvshowupdatestate.GetMagnetLink
+	UpdateAddTorrentToTorrentClient         = tvshowupdatestate.AddTorrentToTorrentClient
+	UpdateDeleteOldTorrentFromTorrentClient = tvshowupdatestate.DeleteOldTorrentFromTorrentClient
+	UpdateWaitingTorrentFiles               = tvshowupdatestate.WaitingTorrentFiles
+	UpdateGetEpisodesData                   = tvshowupdatestate.GetEpisodesData
+	UpdatePrepareNewFileMatches             = tvshowupdatestate.PrepareNewFileMatches
+	UpdateWaitingTorrentDownloadComplete    = tvshowupdatestate.WaitingTorrentDownloadComplete
+	UpdateProbeMediaFiles                   = tvshowupdatestate.ProbeMediaFiles
+	UpdateDeterminingNeedConvertFiles       = tvshowupdatestate.DeterminingNeedConvertFiles
+	UpdateStartMergeVideoFiles              = tvshowupdatestate.StartMergeVideoFiles
+	UpdateWaitingMergeVideoFiles            = tvshowupdatestate.WaitingMergeVideoFiles
+	UpdateCreateHardLinkCopy                = tvshowupdatestate.CreateHardLinkCopy
+	UpdateSetMediaMetaData                  = tvshowupdatestate.SetMediaMetaData
+)
+
+type StepMovieDelivery = moviedeliverystate.StepDelivery
+
+const (
+	MovieGenerateSearchQuery            = moviedeliverystate.GenerateSearchQuery
+	MovieSearchTorrents                 = moviedeliverystate.SearchTorrents
+	MovieWaitingUserChoseTorrent        = moviedeliverystate.WaitingUserChoseTorrent
+	MovieGetMagnetLink                  = moviedeliverystate.GetMagnetLink
+	MovieAddTorrentToTorrentClient      = moviedeliverystate.AddTorrentToTorrentClient
+	MovieWaitingTorrentFiles            = moviedeliverystate.WaitingTorrentFiles
+	MovieGetMovieData                   = moviedeliverystate.GetMovieData
+	MoviePrepareMovieMatch              = moviedeliverystate.PrepareMovieMatch
+	MovieWaitingTorrentDownloadComplete = moviedeliverystate.WaitingTorrentDownloadComplete
+	MovieCreateVideoContentCatalogs     = moviedeliverystate.CreateVideoContentCatalogs
+	MovieDeterminingNeedConvertFiles    = moviedeliverystate.DeterminingNeedConvertFiles
+	MovieStartMergeVideoFiles           = moviedeliverystate.StartMergeVideoFiles
+	MovieWaitingMergeVideoFiles         = moviedeliverystate.WaitingMergeVideoFiles
+	MovieCreateHardLinkCopy             = moviedeliverystate.CreateHardLinkCopy
+	MovieGetCatalogsSize                = moviedeliverystate.GetCatalogsSize
+	MovieSetMediaMetaData               = moviedeliverystate.SetMediaMetaData
+	MovieAddLabel                       = moviedeliverystate.AddLabel
+)
@@ -0,0 +1,29 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Storage interface {
+	SaveWebhookEvent(ctx context.Context, event *OutboxEvent) error
+	GetPendingWebhookEvents(ctx context.Context, now time.Time, limit int) ([]OutboxEvent, error)
+	UpdateWebhookEventAttempt(ctx context.Context, event *OutboxEvent) error
+}
+
+// Sender отправка события на url получателя
+type Sender interface {
+	Send(ctx context.Context, url, secret string, eventType string, eventID uuid.UUID, payload []byte) error
+}
+
+// UUIDGenerator интерфейс для генерации UUID (реальный или мок)
+type UUIDGenerator interface {
+	New() uuid.UUID
+}
+
+// Clock интерфейс для работы со временем (реальный или мок)
+type Clock interface {
+	Now() time.Time
+}
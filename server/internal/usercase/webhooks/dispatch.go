@@ -0,0 +1,97 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/samber/lo"
+)
+
+const (
+	dispatchTimeSec   = 5
+	pendingEventLimit = 20
+)
+
+// Dispatch периодически отправляет события из очереди, пока не будет отменен контекст
+func (s *Service) Dispatch(ctx context.Context) error {
+	if len(s.config.Webhooks) == 0 {
+		// Получатели не настроены - в очереди ничего не появится
+		<-ctx.Done()
+		return nil
+	}
+
+	scheduler := gocron.NewScheduler(time.UTC)
+	scheduler.SetMaxConcurrentJobs(1, gocron.WaitMode)
+
+	_, err := scheduler.Every(dispatchTimeSec).Seconds().Do(func() {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			if err := s.dispatchPending(ctx); err != nil {
+				s.logger.Errorf("dispatchPending: %v", err)
+			}
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed run cron dispatch: %w", err)
+	}
+
+	scheduler.StartAsync()
+	<-ctx.Done()
+	scheduler.Stop()
+	return nil
+}
+
+func (s *Service) dispatchPending(ctx context.Context) error {
+	events, err := s.storage.GetPendingWebhookEvents(ctx, s.clock.Now(), pendingEventLimit)
+	if err != nil {
+		return fmt.Errorf("storage.GetPendingWebhookEvents: %w", err)
+	}
+
+	for _, event := range events {
+		if err = s.dispatchEvent(ctx, event); err != nil {
+			return fmt.Errorf("dispatchEvent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) dispatchEvent(ctx context.Context, event OutboxEvent) error {
+	var sendErr error
+	webhook, find := s.findWebhook(event.WebhookName)
+	if !find {
+		// Получателя убрали из конфига, повторять нет смысла
+		sendErr = fmt.Errorf("webhook %s not configured", event.WebhookName)
+		event.Attempts = s.config.MaxAttempts - 1
+	} else {
+		sendErr = s.sender.Send(ctx, webhook.URL, webhook.Secret, string(event.EventType), event.ID, event.Payload)
+	}
+
+	now := s.clock.Now()
+	if sendErr == nil {
+		event.DeliveredAt = lo.ToPtr(now)
+		event.LastError = nil
+	} else {
+		event.Attempts++
+		event.LastError = lo.ToPtr(sendErr.Error())
+		if event.Attempts >= s.config.MaxAttempts {
+			s.logger.Warnf("webhook %s event %s dropped after %d attempts: %v",
+				event.WebhookName, event.ID, event.Attempts, sendErr)
+			event.FailedAt = lo.ToPtr(now)
+		} else {
+			s.logger.Debugf("webhook %s event %s attempt %d failed: %v",
+				event.WebhookName, event.ID, event.Attempts, sendErr)
+			event.NextAttemptAt = now.Add(retryDelay(event.Attempts))
+		}
+	}
+
+	if err := s.storage.UpdateWebhookEventAttempt(ctx, &event); err != nil {
+		return fmt.Errorf("storage.UpdateWebhookEventAttempt: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType тип события вебхука
+type EventType string
+
+const (
+	// EventStepChanged стейт перешел на следующий шаг
+	EventStepChanged EventType = "step_changed"
+	// EventWaitingUserInput стейт ждет действий пользователя (выбор раздачи, подтверждение файлов)
+	EventWaitingUserInput EventType = "waiting_user_input"
+	// EventDownloadComplete раздача скачана
+	EventDownloadComplete EventType = "download_complete"
+	// EventMergeFailed ошибка при сшивании видеофайлов
+	EventMergeFailed EventType = "merge_failed"
+	// EventStepError ошибка выполнения шага, шаг будет повторен
+	EventStepError EventType = "step_error"
+	// EventDelivered файлы доставлены до медиасервера
+	EventDelivered EventType = "delivered"
+	// EventDeleted файлы удалены
+	EventDeleted EventType = "deleted"
+	// EventFailed стейт зафейлен
+	EventFailed EventType = "failed"
+	// EventCanceled доставка отменена, ее изменения откачены
+	EventCanceled EventType = "canceled"
+	// EventUserInputReminder напоминание о стейте, который долго ждет действий пользователя
+	EventUserInputReminder EventType = "user_input_reminder"
+)
+
+// Event событие изменения стейта доставки или удаления, отправляется как тело запроса
+type Event struct {
+	ID         uuid.UUID `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// StateID идентификатор стейта
+	StateID uuid.UUID `json:"state_id"`
+	// StateType тип стейта (tv_show_delivery, movie_delete и тд)
+	StateType string `json:"state_type"`
+	// MovieID id фильма в themoviedb
+	MovieID *uint64 `json:"movie_id,omitempty"`
+	// TVShowID id сериала в themoviedb
+	TVShowID *uint64 `json:"tv_show_id,omitempty"`
+	// SeasonNumber номер сезона сериала
+	SeasonNumber *uint8 `json:"season_number,omitempty"`
+	// PreviousStep шаг до изменения
+	PreviousStep string `json:"previous_step,omitempty"`
+	// Step текущий шаг
+	Step string `json:"step,omitempty"`
+	// Status статус стейта (new, in_progress, completed, failed)
+	Status string `json:"status"`
+	// Error ошибка выполнения шага
+	Error *string `json:"error,omitempty"`
+	// Link ссылка на контент в веб интерфейсе
+	Link string `json:"link,omitempty"`
+}
+
+// OutboxEvent событие в очереди на отправку конкретному вебхуку
+type OutboxEvent struct {
+	ID          uuid.UUID
+	WebhookName string
+	EventType   EventType
+	// Payload json события
+	Payload   []byte
+	CreatedAt time.Time
+	// Attempts количество неудачных попыток отправки
+	Attempts int
+	// NextAttemptAt когда выполнить следующую попытку
+	NextAttemptAt time.Time
+	// DeliveredAt когда событие было доставлено
+	DeliveredAt *time.Time
+	// FailedAt когда попытки отправки были исчерпаны
+	FailedAt  *time.Time
+	LastError *string
+}
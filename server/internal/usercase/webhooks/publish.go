@@ -0,0 +1,49 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+func (w Webhook) subscribed(eventType EventType) bool {
+	return len(w.Events) == 0 || lo.Contains(w.Events, eventType)
+}
+
+// Publish ставит событие в очередь на отправку всем подписанным получателям
+// Сама отправка выполняется в Dispatch, поэтому недоступный получатель не тормозит вызывающего
+func (s *Service) Publish(ctx context.Context, event Event) error {
+	webhooks := lo.Filter(s.config.Webhooks, func(item Webhook, _ int) bool {
+		return item.subscribed(event.Type)
+	})
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	now := s.clock.Now()
+	event.ID = s.uuidGenerator.New()
+	event.OccurredAt = now
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	for _, webhook := range webhooks {
+		outboxEvent := OutboxEvent{
+			ID:            s.uuidGenerator.New(),
+			WebhookName:   webhook.Name,
+			EventType:     event.Type,
+			Payload:       payload,
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		}
+		if err = s.storage.SaveWebhookEvent(ctx, &outboxEvent); err != nil {
+			return fmt.Errorf("storage.SaveWebhookEvent: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package webhooks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kkiling/goplatform/log"
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/common"
+)
+
+const (
+	defaultMaxAttempts = 10
+	// retryBaseDelay задержка перед первой повторной попыткой, дальше удваивается
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// Webhook получатель событий
+type Webhook struct {
+	// Name уникальное имя, по нему события в очереди связываются с получателем
+	Name string
+	URL  string
+	// Secret ключ подписи тела запроса (HMAC-SHA256)
+	Secret string
+	// Events на какие события подписан получатель, пустой список - на все
+	Events []EventType
+}
+
+type Config struct {
+	Webhooks []Webhook
+	// MaxAttempts после стольких неудачных попыток событие больше не отправляется
+	MaxAttempts int
+}
+
+type Service struct {
+	config        Config
+	storage       Storage
+	sender        Sender
+	logger        log.Logger
+	clock         Clock
+	uuidGenerator UUIDGenerator
+}
+
+func NewService(logger log.Logger, config Config, storage Storage, sender Sender) *Service {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	return &Service{
+		config:        config,
+		storage:       storage,
+		sender:        sender,
+		logger:        logger.Named("webhooks"),
+		clock:         &common.RealClock{},
+		uuidGenerator: &common.UUIDGenerator{},
+	}
+}
+
+// retryDelay задержка перед следующей попыткой, экспоненциально растет с количеством попыток
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+func (s *Service) findWebhook(name string) (Webhook, bool) {
+	for _, webhook := range s.config.Webhooks {
+		if webhook.Name == name {
+			return webhook, true
+		}
+	}
+	return Webhook{}, false
+}
+
+var knownEvents = []EventType{
+	EventStepChanged, EventWaitingUserInput, EventDownloadComplete, EventMergeFailed,
+	EventStepError, EventDelivered, EventDeleted, EventFailed, EventCanceled, EventUserInputReminder,
+}
+
+// Validate проверка конфигурации получателей
+func (c Config) Validate() error {
+	names := make(map[string]struct{}, len(c.Webhooks))
+	for _, webhook := range c.Webhooks {
+		if webhook.Name == "" {
+			return fmt.Errorf("webhook name is required")
+		}
+		if _, ok := names[webhook.Name]; ok {
+			return fmt.Errorf("duplicate webhook name: %s", webhook.Name)
+		}
+		names[webhook.Name] = struct{}{}
+		if webhook.URL == "" {
+			return fmt.Errorf("webhook %s: url is required", webhook.Name)
+		}
+		for _, event := range webhook.Events {
+			if !lo.Contains(knownEvents, event) {
+				return fmt.Errorf("webhook %s: unknown event %s", webhook.Name, event)
+			}
+		}
+	}
+	return nil
+}
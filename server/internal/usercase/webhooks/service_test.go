@@ -0,0 +1,193 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type fakeStorage struct {
+	events []OutboxEvent
+}
+
+func (s *fakeStorage) SaveWebhookEvent(_ context.Context, event *OutboxEvent) error {
+	s.events = append(s.events, *event)
+	return nil
+}
+
+func (s *fakeStorage) GetPendingWebhookEvents(_ context.Context, now time.Time, limit int) ([]OutboxEvent, error) {
+	var result []OutboxEvent
+	for _, event := range s.events {
+		if event.DeliveredAt == nil && event.FailedAt == nil && !event.NextAttemptAt.After(now) && len(result) < limit {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeStorage) UpdateWebhookEventAttempt(_ context.Context, event *OutboxEvent) error {
+	for i := range s.events {
+		if s.events[i].ID == event.ID {
+			s.events[i] = *event
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
+type sentEvent struct {
+	url       string
+	secret    string
+	eventType string
+	payload   []byte
+}
+
+type fakeSender struct {
+	sent []sentEvent
+	err  error
+}
+
+func (f *fakeSender) Send(_ context.Context, url, secret string, eventType string, _ uuid.UUID, payload []byte) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, sentEvent{url: url, secret: secret, eventType: eventType, payload: payload})
+	return nil
+}
+
+func newTestService(webhooks []Webhook) (*Service, *fakeStorage, *fakeSender, *fakeClock) {
+	storage := &fakeStorage{}
+	sender := &fakeSender{}
+	clock := &fakeClock{now: time.Date(2025, 11, 16, 10, 0, 0, 0, time.UTC)}
+	s := NewService(log.NewLogger(log.DebugLevel), Config{Webhooks: webhooks, MaxAttempts: 3}, storage, sender)
+	s.clock = clock
+	return s, storage, sender, clock
+}
+
+func TestService_Publish(t *testing.T) {
+	ctx := context.Background()
+	s, storage, _, _ := newTestService([]Webhook{
+		{Name: "all", URL: "http://all"},
+		{Name: "delivered", URL: "http://delivered", Events: []EventType{EventDelivered, EventDeleted}},
+	})
+
+	tvShowID := uint64(70523)
+	require.NoError(t, s.Publish(ctx, Event{Type: EventWaitingUserInput, TVShowID: &tvShowID, Step: "waiting_user_chose_torrent"}))
+	require.Len(t, storage.events, 1)
+	require.Equal(t, "all", storage.events[0].WebhookName)
+
+	require.NoError(t, s.Publish(ctx, Event{Type: EventDelivered, TVShowID: &tvShowID}))
+	require.Len(t, storage.events, 3)
+	require.Equal(t, "all", storage.events[1].WebhookName)
+	require.Equal(t, "delivered", storage.events[2].WebhookName)
+	require.Equal(t, storage.events[1].Payload, storage.events[2].Payload)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(storage.events[2].Payload, &event))
+	require.Equal(t, EventDelivered, event.Type)
+	require.NotEqual(t, uuid.Nil, event.ID)
+	require.Equal(t, &tvShowID, event.TVShowID)
+}
+
+func TestService_Dispatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delivered", func(t *testing.T) {
+		s, storage, sender, _ := newTestService([]Webhook{{Name: "home", URL: "http://home", Secret: "secret"}})
+		require.NoError(t, s.Publish(ctx, Event{Type: EventDeleted}))
+
+		require.NoError(t, s.dispatchPending(ctx))
+		require.Len(t, sender.sent, 1)
+		require.Equal(t, "http://home", sender.sent[0].url)
+		require.Equal(t, "secret", sender.sent[0].secret)
+		require.Equal(t, string(EventDeleted), sender.sent[0].eventType)
+		require.NotNil(t, storage.events[0].DeliveredAt)
+
+		// Доставленное событие повторно не отправляется
+		require.NoError(t, s.dispatchPending(ctx))
+		require.Len(t, sender.sent, 1)
+	})
+
+	t.Run("retry with backoff", func(t *testing.T) {
+		s, storage, sender, clock := newTestService([]Webhook{{Name: "home", URL: "http://home"}})
+		require.NoError(t, s.Publish(ctx, Event{Type: EventDelivered}))
+
+		sender.err = errors.New("connection refused")
+		require.NoError(t, s.dispatchPending(ctx))
+		require.Equal(t, 1, storage.events[0].Attempts)
+		require.Equal(t, clock.now.Add(retryBaseDelay), storage.events[0].NextAttemptAt)
+		require.Equal(t, "connection refused", *storage.events[0].LastError)
+
+		// До следующей попытки событие не отправляется
+		sender.err = nil
+		require.NoError(t, s.dispatchPending(ctx))
+		require.Empty(t, sender.sent)
+
+		clock.now = clock.now.Add(retryBaseDelay)
+		require.NoError(t, s.dispatchPending(ctx))
+		require.Len(t, sender.sent, 1)
+		require.NotNil(t, storage.events[0].DeliveredAt)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		s, storage, sender, clock := newTestService([]Webhook{{Name: "home", URL: "http://home"}})
+		require.NoError(t, s.Publish(ctx, Event{Type: EventDelivered}))
+
+		sender.err = errors.New("connection refused")
+		for i := 0; i < 3; i++ {
+			require.NoError(t, s.dispatchPending(ctx))
+			clock.now = clock.now.Add(retryMaxDelay)
+		}
+		require.Equal(t, 3, storage.events[0].Attempts)
+		require.NotNil(t, storage.events[0].FailedAt)
+	})
+
+	t.Run("webhook removed from config", func(t *testing.T) {
+		s, storage, sender, _ := newTestService([]Webhook{{Name: "home", URL: "http://home"}})
+		require.NoError(t, s.Publish(ctx, Event{Type: EventDelivered}))
+		s.config.Webhooks = []Webhook{{Name: "other", URL: "http://other"}}
+
+		require.NoError(t, s.dispatchPending(ctx))
+		require.Empty(t, sender.sent)
+		require.NotNil(t, storage.events[0].FailedAt)
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, 10*time.Second, retryDelay(1))
+	require.Equal(t, 20*time.Second, retryDelay(2))
+	require.Equal(t, 80*time.Second, retryDelay(4))
+	require.Equal(t, time.Hour, retryDelay(20))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, Config{}.Validate())
+	require.NoError(t, Config{Webhooks: []Webhook{
+		{Name: "home", URL: "http://home", Events: []EventType{EventDelivered}},
+		{Name: "all", URL: "http://all"},
+	}}.Validate())
+
+	require.Error(t, Config{Webhooks: []Webhook{{URL: "http://home"}}}.Validate())
+	require.Error(t, Config{Webhooks: []Webhook{{Name: "home"}}}.Validate())
+	require.Error(t, Config{Webhooks: []Webhook{
+		{Name: "home", URL: "http://home"},
+		{Name: "home", URL: "http://other"},
+	}}.Validate())
+	require.Error(t, Config{Webhooks: []Webhook{
+		{Name: "home", URL: "http://home", Events: []EventType{"downloaded"}},
+	}}.Validate())
+}
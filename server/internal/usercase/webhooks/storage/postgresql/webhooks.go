@@ -0,0 +1,79 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks/storage/db"
+)
+
+func mapOutboxEvent(item db.WebhookOutbox) webhooks.OutboxEvent {
+	return webhooks.OutboxEvent{
+		ID:            item.ID,
+		WebhookName:   item.WebhookName,
+		EventType:     webhooks.EventType(item.EventType),
+		Payload:       item.Payload,
+		CreatedAt:     item.CreatedAt,
+		Attempts:      item.Attempts,
+		NextAttemptAt: item.NextAttemptAt,
+		DeliveredAt:   item.DeliveredAt,
+		FailedAt:      item.FailedAt,
+		LastError:     item.LastError,
+	}
+}
+
+func (s *Storage) SaveWebhookEvent(ctx context.Context, event *webhooks.OutboxEvent) error {
+	queries := s.getQueries(ctx)
+
+	err := queries.SaveWebhookEvent(ctx, db.SaveWebhookEventParams{
+		ID:            event.ID,
+		WebhookName:   event.WebhookName,
+		EventType:     string(event.EventType),
+		Payload:       event.Payload,
+		CreatedAt:     event.CreatedAt,
+		Attempts:      event.Attempts,
+		NextAttemptAt: event.NextAttemptAt,
+	})
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetPendingWebhookEvents(ctx context.Context, now time.Time, limit int) ([]webhooks.OutboxEvent, error) {
+	queries := s.getQueries(ctx)
+
+	res, err := queries.GetPendingWebhookEvents(ctx, db.GetPendingWebhookEventsParams{
+		NextAttemptAt: now,
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, s.base.HandleError(err)
+	}
+
+	return lo.Map(res, func(item db.WebhookOutbox, _ int) webhooks.OutboxEvent {
+		return mapOutboxEvent(item)
+	}), nil
+}
+
+func (s *Storage) UpdateWebhookEventAttempt(ctx context.Context, event *webhooks.OutboxEvent) error {
+	queries := s.getQueries(ctx)
+
+	_, err := queries.UpdateWebhookEventAttempt(ctx, db.UpdateWebhookEventAttemptParams{
+		ID:            event.ID,
+		Attempts:      event.Attempts,
+		NextAttemptAt: event.NextAttemptAt,
+		DeliveredAt:   event.DeliveredAt,
+		FailedAt:      event.FailedAt,
+		LastError:     event.LastError,
+	})
+	if err != nil {
+		return s.base.HandleError(err)
+	}
+
+	return nil
+}
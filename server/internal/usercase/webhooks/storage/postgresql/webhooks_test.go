@@ -0,0 +1,97 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kkiling/goplatform/storagebase"
+	"github.com/kkiling/goplatform/storagebase/testutils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kkiling/media-delivery/internal/usercase/webhooks"
+)
+
+func newOutboxEvent(nextAttemptAt time.Time) *webhooks.OutboxEvent {
+	return &webhooks.OutboxEvent{
+		ID:            uuid.New(),
+		WebhookName:   "home",
+		EventType:     webhooks.EventDelivered,
+		Payload:       []byte(`{"type": "delivered"}`),
+		CreatedAt:     time.Now(),
+		NextAttemptAt: nextAttemptAt,
+	}
+}
+
+func findEvent(events []webhooks.OutboxEvent, id uuid.UUID) (webhooks.OutboxEvent, bool) {
+	return lo.Find(events, func(item webhooks.OutboxEvent) bool {
+		return item.ID == id
+	})
+}
+
+func TestStorage_WebhookOutbox(t *testing.T) {
+	t.Parallel()
+
+	testStorage := NewTestStorage(testutils.SetupPostgresqlTestDB(t))
+	ctx := context.Background()
+
+	t.Run("save and get pending", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		ready := newOutboxEvent(now.Add(-time.Minute))
+		later := newOutboxEvent(now.Add(time.Hour))
+		require.NoError(t, testStorage.SaveWebhookEvent(ctx, ready))
+		require.NoError(t, testStorage.SaveWebhookEvent(ctx, later))
+
+		err := testStorage.SaveWebhookEvent(ctx, ready)
+		require.ErrorIs(t, err, storagebase.ErrAlreadyExists)
+
+		events, err := testStorage.GetPendingWebhookEvents(ctx, now, 1000)
+		require.NoError(t, err)
+
+		saved, find := findEvent(events, ready.ID)
+		require.True(t, find)
+		require.Equal(t, ready.WebhookName, saved.WebhookName)
+		require.Equal(t, ready.EventType, saved.EventType)
+		require.JSONEq(t, string(ready.Payload), string(saved.Payload))
+		require.WithinDuration(t, ready.NextAttemptAt, saved.NextAttemptAt, time.Second)
+
+		_, find = findEvent(events, later.ID)
+		require.False(t, find)
+	})
+
+	t.Run("update attempt", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		retry := newOutboxEvent(now.Add(-time.Minute))
+		delivered := newOutboxEvent(now.Add(-time.Minute))
+		require.NoError(t, testStorage.SaveWebhookEvent(ctx, retry))
+		require.NoError(t, testStorage.SaveWebhookEvent(ctx, delivered))
+
+		retry.Attempts = 1
+		retry.NextAttemptAt = now.Add(-time.Second)
+		retry.LastError = lo.ToPtr("connection refused")
+		require.NoError(t, testStorage.UpdateWebhookEventAttempt(ctx, retry))
+
+		delivered.DeliveredAt = lo.ToPtr(now)
+		require.NoError(t, testStorage.UpdateWebhookEventAttempt(ctx, delivered))
+
+		events, err := testStorage.GetPendingWebhookEvents(ctx, now, 1000)
+		require.NoError(t, err)
+
+		saved, find := findEvent(events, retry.ID)
+		require.True(t, find)
+		require.Equal(t, 1, saved.Attempts)
+		require.Equal(t, retry.LastError, saved.LastError)
+
+		_, find = findEvent(events, delivered.ID)
+		require.False(t, find)
+
+		err = testStorage.UpdateWebhookEventAttempt(ctx, newOutboxEvent(now))
+		require.ErrorIs(t, err, storagebase.ErrNotFound)
+	})
+}
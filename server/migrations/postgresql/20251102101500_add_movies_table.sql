@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Таблица для хранения фильмов (изображения хранятся в таблице images)
+CREATE TABLE movies (
+    id BIGINT PRIMARY KEY CHECK (id >= 0), -- uint64
+    title TEXT NOT NULL,
+    original_title TEXT NOT NULL,
+    overview TEXT NOT NULL,
+    poster_id TEXT,
+    release_date TIMESTAMPTZ NOT NULL,
+    vote_average DOUBLE PRECISION NOT NULL,
+    vote_count INTEGER NOT NULL,
+    popularity DOUBLE PRECISION NOT NULL,
+    backdrop_id TEXT,
+    genres TEXT[],
+    imdb_id TEXT NOT NULL,
+    origin_country TEXT[],
+    runtime INTEGER NOT NULL CHECK (runtime >= 0),
+    status TEXT NOT NULL,
+    tagline TEXT NOT NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE movies;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Профили качества, по которым фильтруются найденные раздачи
+CREATE TABLE quality_profiles (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    min_resolution INTEGER NOT NULL CHECK (min_resolution >= 0), -- 0 - без ограничений
+    max_resolution INTEGER NOT NULL CHECK (max_resolution >= 0), -- 0 - без ограничений
+    preferred_codecs TEXT[] NOT NULL,
+    forbidden_codecs TEXT[] NOT NULL,
+    required_audio TEXT[] NOT NULL,
+    max_size_per_episode BIGINT NOT NULL CHECK (max_size_per_episode >= 0) -- байты, 0 - без ограничений
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE quality_profiles;
+-- +goose StatementEnd
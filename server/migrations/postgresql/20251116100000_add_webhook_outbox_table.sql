@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Исходящие события вебхуков, отправляются отдельным процессом с ретраями
+CREATE TABLE webhook_outbox (
+    id UUID PRIMARY KEY,
+    webhook_name TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    attempts INTEGER NOT NULL CHECK (attempts >= 0),
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    delivered_at TIMESTAMPTZ, -- событие успешно доставлено
+    failed_at TIMESTAMPTZ,    -- попытки исчерпаны
+    last_error TEXT
+);
+
+CREATE INDEX idx_webhook_outbox_next_attempt_at ON webhook_outbox (next_attempt_at)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_outbox;
+-- +goose StatementEnd
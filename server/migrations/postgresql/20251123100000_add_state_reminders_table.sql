@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Напоминания о стейтах, ожидающих действий пользователя
+CREATE TABLE state_reminders (
+    state_id UUID NOT NULL,
+    step TEXT NOT NULL,
+    reminded_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (state_id, step)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE state_reminders;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Результаты последней проверки выхода новых эпизодов доставленного сезона
+CREATE TABLE season_checks (
+    video_content_id UUID NOT NULL PRIMARY KEY,
+    checked_at TIMESTAMPTZ NOT NULL,
+    result TEXT NOT NULL,
+    episodes_count INTEGER NOT NULL,
+    aired_episodes_count INTEGER NOT NULL,
+    delivered_episodes_count INTEGER NOT NULL,
+    next_episode_air_date TIMESTAMPTZ,
+    error TEXT
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE season_checks;
+-- +goose StatementEnd
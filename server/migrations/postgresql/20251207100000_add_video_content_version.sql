@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Версия видеоконтента (например 4K или озвучка), к одному сезону / фильму можно привязать несколько раздач
+ALTER TABLE video_content ADD COLUMN version TEXT NOT NULL DEFAULT '';
+
+-- Версия уникальна в рамках фильма / сезона (у фильмов tvshow_id и season_number NULL, поэтому индексы раздельные)
+CREATE UNIQUE INDEX idx_video_content_movie_id_version ON video_content (movie_id, version) WHERE movie_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_video_content_tvshow_id_season_number_version ON video_content (tvshow_id, season_number, version) WHERE tvshow_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_video_content_tvshow_id_season_number_version;
+DROP INDEX idx_video_content_movie_id_version;
+ALTER TABLE video_content DROP COLUMN version;
+-- +goose StatementEnd
@@ -134,11 +134,13 @@ func (x *TVShowID) GetSeasonNumber() uint32 {
 }
 
 type ContentID struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieId       *uint64                `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3,oneof" json:"movie_id,omitempty"`
-	TvShow        *TVShowID              `protobuf:"bytes,2,opt,name=tv_show,json=tvShow,proto3,oneof" json:"tv_show,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MovieId *uint64                `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3,oneof" json:"movie_id,omitempty"`
+	TvShow  *TVShowID              `protobuf:"bytes,2,opt,name=tv_show,json=tvShow,proto3,oneof" json:"tv_show,omitempty"`
+	// Конкретный видеоконтент фильма/сезона, обязателен если их несколько
+	VideoContentId *string `protobuf:"bytes,3,opt,name=video_content_id,json=videoContentId,proto3,oneof" json:"video_content_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ContentID) Reset() {
@@ -185,6 +187,13 @@ func (x *ContentID) GetTvShow() *TVShowID {
 	return nil
 }
 
+func (x *ContentID) GetVideoContentId() string {
+	if x != nil && x.VideoContentId != nil {
+		return *x.VideoContentId
+	}
+	return ""
+}
+
 var File_media_delivery_common_model_proto protoreflect.FileDescriptor
 
 const file_media_delivery_common_model_proto_rawDesc = "" +
@@ -192,13 +201,15 @@ const file_media_delivery_common_model_proto_rawDesc = "" +
 	"!media-delivery/common-model.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"H\n" +
 	"\bTVShowID\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x04B\a\x92A\x04\x9a\x02\x01\x03R\x02id\x12#\n" +
-	"\rseason_number\x18\x02 \x01(\rR\fseasonNumber\"\x84\x01\n" +
+	"\rseason_number\x18\x02 \x01(\rR\fseasonNumber\"\xc8\x01\n" +
 	"\tContentID\x12'\n" +
 	"\bmovie_id\x18\x01 \x01(\x04B\a\x92A\x04\x9a\x02\x01\x03H\x00R\amovieId\x88\x01\x01\x125\n" +
-	"\atv_show\x18\x02 \x01(\v2\x17.mediadelivery.TVShowIDH\x01R\x06tvShow\x88\x01\x01B\v\n" +
+	"\atv_show\x18\x02 \x01(\v2\x17.mediadelivery.TVShowIDH\x01R\x06tvShow\x88\x01\x01\x12-\n" +
+	"\x10video_content_id\x18\x03 \x01(\tH\x02R\x0evideoContentId\x88\x01\x01B\v\n" +
 	"\t_movie_idB\n" +
 	"\n" +
-	"\b_tv_show*l\n" +
+	"\b_tv_showB\x13\n" +
+	"\x11_video_content_id*l\n" +
 	"\vStateStatus\x12\x11\n" +
 	"\rStatusUnknown\x10\x00\x12\r\n" +
 	"\tNewStatus\x10\x01\x12\x14\n" +
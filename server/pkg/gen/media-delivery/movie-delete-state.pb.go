@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: media-delivery/movie-delete-state.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MovieDeleteError_ErrorType int32
+
+const (
+	MovieDeleteError_MovieDeleteError_Unknown MovieDeleteError_ErrorType = 0
+)
+
+// Enum value maps for MovieDeleteError_ErrorType.
+var (
+	MovieDeleteError_ErrorType_name = map[int32]string{
+		0: "MovieDeleteError_Unknown",
+	}
+	MovieDeleteError_ErrorType_value = map[string]int32{
+		"MovieDeleteError_Unknown": 0,
+	}
+)
+
+func (x MovieDeleteError_ErrorType) Enum() *MovieDeleteError_ErrorType {
+	p := new(MovieDeleteError_ErrorType)
+	*p = x
+	return p
+}
+
+func (x MovieDeleteError_ErrorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MovieDeleteError_ErrorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_movie_delete_state_proto_enumTypes[0].Descriptor()
+}
+
+func (MovieDeleteError_ErrorType) Type() protoreflect.EnumType {
+	return &file_media_delivery_movie_delete_state_proto_enumTypes[0]
+}
+
+func (x MovieDeleteError_ErrorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MovieDeleteError_ErrorType.Descriptor instead.
+func (MovieDeleteError_ErrorType) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delete_state_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type MovieDeleteError struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	RawError      string                     `protobuf:"bytes,1,opt,name=raw_error,json=rawError,proto3" json:"raw_error,omitempty"`
+	ErrorType     MovieDeleteError_ErrorType `protobuf:"varint,2,opt,name=error_type,json=errorType,proto3,enum=mediadelivery.MovieDeleteError_ErrorType" json:"error_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieDeleteError) Reset() {
+	*x = MovieDeleteError{}
+	mi := &file_media_delivery_movie_delete_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieDeleteError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieDeleteError) ProtoMessage() {}
+
+func (x *MovieDeleteError) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delete_state_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieDeleteError.ProtoReflect.Descriptor instead.
+func (*MovieDeleteError) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delete_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MovieDeleteError) GetRawError() string {
+	if x != nil {
+		return x.RawError
+	}
+	return ""
+}
+
+func (x *MovieDeleteError) GetErrorType() MovieDeleteError_ErrorType {
+	if x != nil {
+		return x.ErrorType
+	}
+	return MovieDeleteError_MovieDeleteError_Unknown
+}
+
+type MovieDeleteState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        StateStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=mediadelivery.StateStatus" json:"status,omitempty"`
+	Error         *MovieDeleteError      `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieDeleteState) Reset() {
+	*x = MovieDeleteState{}
+	mi := &file_media_delivery_movie_delete_state_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieDeleteState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieDeleteState) ProtoMessage() {}
+
+func (x *MovieDeleteState) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delete_state_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieDeleteState.ProtoReflect.Descriptor instead.
+func (*MovieDeleteState) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delete_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MovieDeleteState) GetStatus() StateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StateStatus_StatusUnknown
+}
+
+func (x *MovieDeleteState) GetError() *MovieDeleteError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_media_delivery_movie_delete_state_proto protoreflect.FileDescriptor
+
+const file_media_delivery_movie_delete_state_proto_rawDesc = "" +
+	"\n" +
+	"'media-delivery/movie-delete-state.proto\x12\rmediadelivery\x1a!media-delivery/common-model.proto\"\xa4\x01\n" +
+	"\x10MovieDeleteError\x12\x1b\n" +
+	"\traw_error\x18\x01 \x01(\tR\brawError\x12H\n" +
+	"\n" +
+	"error_type\x18\x02 \x01(\x0e2).mediadelivery.MovieDeleteError.ErrorTypeR\terrorType\")\n" +
+	"\tErrorType\x12\x1c\n" +
+	"\x18MovieDeleteError_Unknown\x10\x00\"\x8c\x01\n" +
+	"\x10MovieDeleteState\x122\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1a.mediadelivery.StateStatusR\x06status\x12:\n" +
+	"\x05error\x18\x02 \x01(\v2\x1f.mediadelivery.MovieDeleteErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_errorB'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+
+var (
+	file_media_delivery_movie_delete_state_proto_rawDescOnce sync.Once
+	file_media_delivery_movie_delete_state_proto_rawDescData []byte
+)
+
+func file_media_delivery_movie_delete_state_proto_rawDescGZIP() []byte {
+	file_media_delivery_movie_delete_state_proto_rawDescOnce.Do(func() {
+		file_media_delivery_movie_delete_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_media_delivery_movie_delete_state_proto_rawDesc), len(file_media_delivery_movie_delete_state_proto_rawDesc)))
+	})
+	return file_media_delivery_movie_delete_state_proto_rawDescData
+}
+
+var file_media_delivery_movie_delete_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_media_delivery_movie_delete_state_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_media_delivery_movie_delete_state_proto_goTypes = []any{
+	(MovieDeleteError_ErrorType)(0), // 0: mediadelivery.MovieDeleteError.ErrorType
+	(*MovieDeleteError)(nil),        // 1: mediadelivery.MovieDeleteError
+	(*MovieDeleteState)(nil),        // 2: mediadelivery.MovieDeleteState
+	(StateStatus)(0),                // 3: mediadelivery.StateStatus
+}
+var file_media_delivery_movie_delete_state_proto_depIdxs = []int32{
+	0, // 0: mediadelivery.MovieDeleteError.error_type:type_name -> mediadelivery.MovieDeleteError.ErrorType
+	3, // 1: mediadelivery.MovieDeleteState.status:type_name -> mediadelivery.StateStatus
+	1, // 2: mediadelivery.MovieDeleteState.error:type_name -> mediadelivery.MovieDeleteError
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_media_delivery_movie_delete_state_proto_init() }
+func file_media_delivery_movie_delete_state_proto_init() {
+	if File_media_delivery_movie_delete_state_proto != nil {
+		return
+	}
+	file_media_delivery_common_model_proto_init()
+	file_media_delivery_movie_delete_state_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_movie_delete_state_proto_rawDesc), len(file_media_delivery_movie_delete_state_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_media_delivery_movie_delete_state_proto_goTypes,
+		DependencyIndexes: file_media_delivery_movie_delete_state_proto_depIdxs,
+		EnumInfos:         file_media_delivery_movie_delete_state_proto_enumTypes,
+		MessageInfos:      file_media_delivery_movie_delete_state_proto_msgTypes,
+	}.Build()
+	File_media_delivery_movie_delete_state_proto = out.File
+	file_media_delivery_movie_delete_state_proto_goTypes = nil
+	file_media_delivery_movie_delete_state_proto_depIdxs = nil
+}
@@ -0,0 +1,881 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: media-delivery/movie-delivery-state.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MovieDeliveryStep int32
+
+const (
+	// Неизвестный статус доставки
+	MovieDeliveryStep_MovieDeliveryStepUnknown MovieDeliveryStep = 0
+	// Генерация запроса к трекеру
+	MovieDeliveryStep_MovieGenerateSearchQuery MovieDeliveryStep = 1
+	// Поиск раздач фильма
+	MovieDeliveryStep_MovieSearchTorrents MovieDeliveryStep = 2
+	// Ожидание выбора раздачи пользователем
+	MovieDeliveryStep_MovieWaitingUserChoseTorrent MovieDeliveryStep = 3
+	// Получение магнет ссылки
+	MovieDeliveryStep_MovieGetMagnetLink MovieDeliveryStep = 4
+	// Добавление раздачи для скачивания торрент клиентом
+	MovieDeliveryStep_MovieAddTorrentToTorrentClient MovieDeliveryStep = 5
+	// Ожидание когда появится информация о файлах в раздаче
+	MovieDeliveryStep_MovieWaitingTorrentFiles MovieDeliveryStep = 6
+	// Получение информации о фильме и каталоге фильма
+	MovieDeliveryStep_MovieGetMovieData MovieDeliveryStep = 7
+	// Выбор основного видеофайла раздачи
+	MovieDeliveryStep_MoviePrepareMovieMatch MovieDeliveryStep = 8
+	// Ожидание завершения окончания скачивания раздачи
+	MovieDeliveryStep_MovieWaitingTorrentDownloadComplete MovieDeliveryStep = 9
+	// Формирование каталога фильма
+	MovieDeliveryStep_MovieCreateVideoContentCatalogs MovieDeliveryStep = 10
+	// Определение необходимости конвертации файлов
+	MovieDeliveryStep_MovieDeterminingNeedConvertFiles MovieDeliveryStep = 11
+	// Запуск конвертирования видеофайла
+	MovieDeliveryStep_MovieStartMergeVideoFiles MovieDeliveryStep = 12
+	// Ожидание завершения конвертации видеофайла
+	MovieDeliveryStep_MovieWaitingMergeVideoFiles MovieDeliveryStep = 13
+	// Создание хардлинка видеофайла в каталоге медиасервера
+	MovieDeliveryStep_MovieCreateHardLinkCopy MovieDeliveryStep = 14
+	// Получение размеров каталогов фильма
+	MovieDeliveryStep_MovieGetCatalogsSize MovieDeliveryStep = 15
+	// Установка методаных фильма в медиасервере
+	MovieDeliveryStep_MovieSetMediaMetaData MovieDeliveryStep = 16
+	// Установка лейбла видеоконтента
+	MovieDeliveryStep_MovieAddLabel MovieDeliveryStep = 17
+)
+
+// Enum value maps for MovieDeliveryStep.
+var (
+	MovieDeliveryStep_name = map[int32]string{
+		0:  "MovieDeliveryStepUnknown",
+		1:  "MovieGenerateSearchQuery",
+		2:  "MovieSearchTorrents",
+		3:  "MovieWaitingUserChoseTorrent",
+		4:  "MovieGetMagnetLink",
+		5:  "MovieAddTorrentToTorrentClient",
+		6:  "MovieWaitingTorrentFiles",
+		7:  "MovieGetMovieData",
+		8:  "MoviePrepareMovieMatch",
+		9:  "MovieWaitingTorrentDownloadComplete",
+		10: "MovieCreateVideoContentCatalogs",
+		11: "MovieDeterminingNeedConvertFiles",
+		12: "MovieStartMergeVideoFiles",
+		13: "MovieWaitingMergeVideoFiles",
+		14: "MovieCreateHardLinkCopy",
+		15: "MovieGetCatalogsSize",
+		16: "MovieSetMediaMetaData",
+		17: "MovieAddLabel",
+	}
+	MovieDeliveryStep_value = map[string]int32{
+		"MovieDeliveryStepUnknown":            0,
+		"MovieGenerateSearchQuery":            1,
+		"MovieSearchTorrents":                 2,
+		"MovieWaitingUserChoseTorrent":        3,
+		"MovieGetMagnetLink":                  4,
+		"MovieAddTorrentToTorrentClient":      5,
+		"MovieWaitingTorrentFiles":            6,
+		"MovieGetMovieData":                   7,
+		"MoviePrepareMovieMatch":              8,
+		"MovieWaitingTorrentDownloadComplete": 9,
+		"MovieCreateVideoContentCatalogs":     10,
+		"MovieDeterminingNeedConvertFiles":    11,
+		"MovieStartMergeVideoFiles":           12,
+		"MovieWaitingMergeVideoFiles":         13,
+		"MovieCreateHardLinkCopy":             14,
+		"MovieGetCatalogsSize":                15,
+		"MovieSetMediaMetaData":               16,
+		"MovieAddLabel":                       17,
+	}
+)
+
+func (x MovieDeliveryStep) Enum() *MovieDeliveryStep {
+	p := new(MovieDeliveryStep)
+	*p = x
+	return p
+}
+
+func (x MovieDeliveryStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MovieDeliveryStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_movie_delivery_state_proto_enumTypes[0].Descriptor()
+}
+
+func (MovieDeliveryStep) Type() protoreflect.EnumType {
+	return &file_media_delivery_movie_delivery_state_proto_enumTypes[0]
+}
+
+func (x MovieDeliveryStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MovieDeliveryStep.Descriptor instead.
+func (MovieDeliveryStep) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{0}
+}
+
+type MovieDeliveryError_ErrorType int32
+
+const (
+	MovieDeliveryError_MovieDeliveryError_Unknown MovieDeliveryError_ErrorType = 0
+	// Торрент трекер не доступен
+	MovieDeliveryError_MovieTorrentSiteForbidden MovieDeliveryError_ErrorType = 1
+	// Файлы на медиасервере уже существуют
+	MovieDeliveryError_MovieFilesAlreadyExist MovieDeliveryError_ErrorType = 2
+)
+
+// Enum value maps for MovieDeliveryError_ErrorType.
+var (
+	MovieDeliveryError_ErrorType_name = map[int32]string{
+		0: "MovieDeliveryError_Unknown",
+		1: "MovieTorrentSiteForbidden",
+		2: "MovieFilesAlreadyExist",
+	}
+	MovieDeliveryError_ErrorType_value = map[string]int32{
+		"MovieDeliveryError_Unknown": 0,
+		"MovieTorrentSiteForbidden":  1,
+		"MovieFilesAlreadyExist":     2,
+	}
+)
+
+func (x MovieDeliveryError_ErrorType) Enum() *MovieDeliveryError_ErrorType {
+	p := new(MovieDeliveryError_ErrorType)
+	*p = x
+	return p
+}
+
+func (x MovieDeliveryError_ErrorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MovieDeliveryError_ErrorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_movie_delivery_state_proto_enumTypes[1].Descriptor()
+}
+
+func (MovieDeliveryError_ErrorType) Type() protoreflect.EnumType {
+	return &file_media_delivery_movie_delivery_state_proto_enumTypes[1]
+}
+
+func (x MovieDeliveryError_ErrorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MovieDeliveryError_ErrorType.Descriptor instead.
+func (MovieDeliveryError_ErrorType) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type MovieDeliveryError struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	RawError      string                       `protobuf:"bytes,1,opt,name=raw_error,json=rawError,proto3" json:"raw_error,omitempty"`
+	ErrorType     MovieDeliveryError_ErrorType `protobuf:"varint,2,opt,name=error_type,json=errorType,proto3,enum=mediadelivery.MovieDeliveryError_ErrorType" json:"error_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieDeliveryError) Reset() {
+	*x = MovieDeliveryError{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieDeliveryError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieDeliveryError) ProtoMessage() {}
+
+func (x *MovieDeliveryError) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieDeliveryError.ProtoReflect.Descriptor instead.
+func (*MovieDeliveryError) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MovieDeliveryError) GetRawError() string {
+	if x != nil {
+		return x.RawError
+	}
+	return ""
+}
+
+func (x *MovieDeliveryError) GetErrorType() MovieDeliveryError_ErrorType {
+	if x != nil {
+		return x.ErrorType
+	}
+	return MovieDeliveryError_MovieDeliveryError_Unknown
+}
+
+type MovieCatalogPath struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Путь до каталога фильма
+	MoviePath string `protobuf:"bytes,1,opt,name=movie_path,json=moviePath,proto3" json:"movie_path,omitempty"`
+	// Имя файла фильма без расширения
+	FileName      string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieCatalogPath) Reset() {
+	*x = MovieCatalogPath{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieCatalogPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieCatalogPath) ProtoMessage() {}
+
+func (x *MovieCatalogPath) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieCatalogPath.ProtoReflect.Descriptor instead.
+func (*MovieCatalogPath) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MovieCatalogPath) GetMoviePath() string {
+	if x != nil {
+		return x.MoviePath
+	}
+	return ""
+}
+
+func (x *MovieCatalogPath) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type MovieCatalog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Путь до раздачи фильма
+	TorrentPath string `protobuf:"bytes,1,opt,name=torrent_path,json=torrentPath,proto3" json:"torrent_path,omitempty"`
+	// Размер файлов раздачи фильма
+	TorrentSizePretty string `protobuf:"bytes,2,opt,name=torrent_size_pretty,json=torrentSizePretty,proto3" json:"torrent_size_pretty,omitempty"`
+	// Путь до фильма на медиасервере
+	MediaServerPath *MovieCatalogPath `protobuf:"bytes,3,opt,name=media_server_path,json=mediaServerPath,proto3" json:"media_server_path,omitempty"`
+	// Размер файлов фильма на медиасервере
+	MediaServerSizePretty string `protobuf:"bytes,4,opt,name=media_server_size_pretty,json=mediaServerSizePretty,proto3" json:"media_server_size_pretty,omitempty"`
+	// Файлы скопированы с раздачи или созданы ссылочная связь
+	// True - файлы скопированы
+	// False - файлы созданы через линки
+	IsCopyFilesInMediaServer bool `protobuf:"varint,5,opt,name=is_copy_files_in_media_server,json=isCopyFilesInMediaServer,proto3" json:"is_copy_files_in_media_server,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *MovieCatalog) Reset() {
+	*x = MovieCatalog{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieCatalog) ProtoMessage() {}
+
+func (x *MovieCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieCatalog.ProtoReflect.Descriptor instead.
+func (*MovieCatalog) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MovieCatalog) GetTorrentPath() string {
+	if x != nil {
+		return x.TorrentPath
+	}
+	return ""
+}
+
+func (x *MovieCatalog) GetTorrentSizePretty() string {
+	if x != nil {
+		return x.TorrentSizePretty
+	}
+	return ""
+}
+
+func (x *MovieCatalog) GetMediaServerPath() *MovieCatalogPath {
+	if x != nil {
+		return x.MediaServerPath
+	}
+	return nil
+}
+
+func (x *MovieCatalog) GetMediaServerSizePretty() string {
+	if x != nil {
+		return x.MediaServerSizePretty
+	}
+	return ""
+}
+
+func (x *MovieCatalog) GetIsCopyFilesInMediaServer() bool {
+	if x != nil {
+		return x.IsCopyFilesInMediaServer
+	}
+	return false
+}
+
+type MovieMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Файл фильма на медиасервере
+	MovieFile string `protobuf:"bytes,1,opt,name=movie_file,json=movieFile,proto3" json:"movie_file,omitempty"`
+	// Основной видеофайл раздачи
+	Video *Track `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	// Внешние аудиодорожки
+	AudioTracks []*Track `protobuf:"bytes,3,rep,name=audio_tracks,json=audioTracks,proto3" json:"audio_tracks,omitempty"`
+	// Внешние субтитры
+	Subtitles []*Track `protobuf:"bytes,4,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	// Прочие видеофайлы раздачи
+	Unallocated   []*Track `protobuf:"bytes,5,rep,name=unallocated,proto3" json:"unallocated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieMatch) Reset() {
+	*x = MovieMatch{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieMatch) ProtoMessage() {}
+
+func (x *MovieMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieMatch.ProtoReflect.Descriptor instead.
+func (*MovieMatch) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MovieMatch) GetMovieFile() string {
+	if x != nil {
+		return x.MovieFile
+	}
+	return ""
+}
+
+func (x *MovieMatch) GetVideo() *Track {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *MovieMatch) GetAudioTracks() []*Track {
+	if x != nil {
+		return x.AudioTracks
+	}
+	return nil
+}
+
+func (x *MovieMatch) GetSubtitles() []*Track {
+	if x != nil {
+		return x.Subtitles
+	}
+	return nil
+}
+
+func (x *MovieMatch) GetUnallocated() []*Track {
+	if x != nil {
+		return x.Unallocated
+	}
+	return nil
+}
+
+type MovieRetryAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Шаг, который повторили
+	Step MovieDeliveryStep `protobuf:"varint,1,opt,name=step,proto3,enum=mediadelivery.MovieDeliveryStep" json:"step,omitempty"`
+	// Ошибка, с которой завершилось последнее выполнение шага
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Время запроса повтора
+	RetriedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=retried_at,json=retriedAt,proto3" json:"retried_at,omitempty"`
+	// Новая раздача, если была указана
+	Href          *string `protobuf:"bytes,4,opt,name=href,proto3,oneof" json:"href,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieRetryAttempt) Reset() {
+	*x = MovieRetryAttempt{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieRetryAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieRetryAttempt) ProtoMessage() {}
+
+func (x *MovieRetryAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieRetryAttempt.ProtoReflect.Descriptor instead.
+func (*MovieRetryAttempt) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MovieRetryAttempt) GetStep() MovieDeliveryStep {
+	if x != nil {
+		return x.Step
+	}
+	return MovieDeliveryStep_MovieDeliveryStepUnknown
+}
+
+func (x *MovieRetryAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MovieRetryAttempt) GetRetriedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetriedAt
+	}
+	return nil
+}
+
+func (x *MovieRetryAttempt) GetHref() string {
+	if x != nil && x.Href != nil {
+		return *x.Href
+	}
+	return ""
+}
+
+type MovieDeliveryData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Поисковый запрос поиска торрент файла
+	SearchQuery *SearchQuery `protobuf:"bytes,1,opt,name=search_query,json=searchQuery,proto3,oneof" json:"search_query,omitempty"`
+	// Результат поиска торрент раздач
+	TorrentSearch []*TorrentSearch `protobuf:"bytes,2,rep,name=torrent_search,json=torrentSearch,proto3" json:"torrent_search,omitempty"`
+	// Выбранный видеофайл и внешние дорожки
+	MovieMatch *MovieMatch `protobuf:"bytes,3,opt,name=movie_match,json=movieMatch,proto3,oneof" json:"movie_match,omitempty"`
+	// статус скачивания раздачи
+	TorrentDownloadStatus *TorrentDownloadStatus `protobuf:"bytes,4,opt,name=torrent_download_status,json=torrentDownloadStatus,proto3,oneof" json:"torrent_download_status,omitempty"`
+	// статус сшивания файлов
+	MergeVideoStatus *MergeVideoStatus `protobuf:"bytes,5,opt,name=merge_video_status,json=mergeVideoStatus,proto3,oneof" json:"merge_video_status,omitempty"`
+	// информация о каталогах фильма
+	MovieCatalogInfo *MovieCatalog `protobuf:"bytes,6,opt,name=movie_catalog_info,json=movieCatalogInfo,proto3,oneof" json:"movie_catalog_info,omitempty"`
+	// Информация о раздаче
+	Torrent *Torrent `protobuf:"bytes,7,opt,name=torrent,proto3,oneof" json:"torrent,omitempty"`
+	// История ручных повторов шагов, завершившихся ошибкой
+	RetryHistory  []*MovieRetryAttempt `protobuf:"bytes,8,rep,name=retry_history,json=retryHistory,proto3" json:"retry_history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieDeliveryData) Reset() {
+	*x = MovieDeliveryData{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieDeliveryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieDeliveryData) ProtoMessage() {}
+
+func (x *MovieDeliveryData) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieDeliveryData.ProtoReflect.Descriptor instead.
+func (*MovieDeliveryData) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MovieDeliveryData) GetSearchQuery() *SearchQuery {
+	if x != nil {
+		return x.SearchQuery
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetTorrentSearch() []*TorrentSearch {
+	if x != nil {
+		return x.TorrentSearch
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetMovieMatch() *MovieMatch {
+	if x != nil {
+		return x.MovieMatch
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetTorrentDownloadStatus() *TorrentDownloadStatus {
+	if x != nil {
+		return x.TorrentDownloadStatus
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetMergeVideoStatus() *MergeVideoStatus {
+	if x != nil {
+		return x.MergeVideoStatus
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetMovieCatalogInfo() *MovieCatalog {
+	if x != nil {
+		return x.MovieCatalogInfo
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetTorrent() *Torrent {
+	if x != nil {
+		return x.Torrent
+	}
+	return nil
+}
+
+func (x *MovieDeliveryData) GetRetryHistory() []*MovieRetryAttempt {
+	if x != nil {
+		return x.RetryHistory
+	}
+	return nil
+}
+
+type MovieDeliveryState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *MovieDeliveryData     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Step          MovieDeliveryStep      `protobuf:"varint,2,opt,name=step,proto3,enum=mediadelivery.MovieDeliveryStep" json:"step,omitempty"`
+	Status        StateStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=mediadelivery.StateStatus" json:"status,omitempty"`
+	Error         *MovieDeliveryError    `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieDeliveryState) Reset() {
+	*x = MovieDeliveryState{}
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieDeliveryState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieDeliveryState) ProtoMessage() {}
+
+func (x *MovieDeliveryState) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_delivery_state_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieDeliveryState.ProtoReflect.Descriptor instead.
+func (*MovieDeliveryState) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_delivery_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MovieDeliveryState) GetData() *MovieDeliveryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MovieDeliveryState) GetStep() MovieDeliveryStep {
+	if x != nil {
+		return x.Step
+	}
+	return MovieDeliveryStep_MovieDeliveryStepUnknown
+}
+
+func (x *MovieDeliveryState) GetStatus() StateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StateStatus_StatusUnknown
+}
+
+func (x *MovieDeliveryState) GetError() *MovieDeliveryError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_media_delivery_movie_delivery_state_proto protoreflect.FileDescriptor
+
+const file_media_delivery_movie_delivery_state_proto_rawDesc = "" +
+	"\n" +
+	")media-delivery/movie-delivery-state.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a!media-delivery/common-model.proto\x1a+media-delivery/tv-show-delivery-state.proto\"\xe5\x01\n" +
+	"\x12MovieDeliveryError\x12\x1b\n" +
+	"\traw_error\x18\x01 \x01(\tR\brawError\x12J\n" +
+	"\n" +
+	"error_type\x18\x02 \x01(\x0e2+.mediadelivery.MovieDeliveryError.ErrorTypeR\terrorType\"f\n" +
+	"\tErrorType\x12\x1e\n" +
+	"\x1aMovieDeliveryError_Unknown\x10\x00\x12\x1d\n" +
+	"\x19MovieTorrentSiteForbidden\x10\x01\x12\x1a\n" +
+	"\x16MovieFilesAlreadyExist\x10\x02\"N\n" +
+	"\x10MovieCatalogPath\x12\x1d\n" +
+	"\n" +
+	"movie_path\x18\x01 \x01(\tR\tmoviePath\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\"\xa8\x02\n" +
+	"\fMovieCatalog\x12!\n" +
+	"\ftorrent_path\x18\x01 \x01(\tR\vtorrentPath\x12.\n" +
+	"\x13torrent_size_pretty\x18\x02 \x01(\tR\x11torrentSizePretty\x12K\n" +
+	"\x11media_server_path\x18\x03 \x01(\v2\x1f.mediadelivery.MovieCatalogPathR\x0fmediaServerPath\x127\n" +
+	"\x18media_server_size_pretty\x18\x04 \x01(\tR\x15mediaServerSizePretty\x12?\n" +
+	"\x1dis_copy_files_in_media_server\x18\x05 \x01(\bR\x18isCopyFilesInMediaServer\"\xfc\x01\n" +
+	"\n" +
+	"MovieMatch\x12\x1d\n" +
+	"\n" +
+	"movie_file\x18\x01 \x01(\tR\tmovieFile\x12*\n" +
+	"\x05video\x18\x02 \x01(\v2\x14.mediadelivery.TrackR\x05video\x127\n" +
+	"\faudio_tracks\x18\x03 \x03(\v2\x14.mediadelivery.TrackR\vaudioTracks\x122\n" +
+	"\tsubtitles\x18\x04 \x03(\v2\x14.mediadelivery.TrackR\tsubtitles\x126\n" +
+	"\vunallocated\x18\x05 \x03(\v2\x14.mediadelivery.TrackR\vunallocated\"\xbc\x01\n" +
+	"\x11MovieRetryAttempt\x124\n" +
+	"\x04step\x18\x01 \x01(\x0e2 .mediadelivery.MovieDeliveryStepR\x04step\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"retried_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tretriedAt\x12\x17\n" +
+	"\x04href\x18\x04 \x01(\tH\x00R\x04href\x88\x01\x01B\a\n" +
+	"\x05_href\"\xd9\x05\n" +
+	"\x11MovieDeliveryData\x12B\n" +
+	"\fsearch_query\x18\x01 \x01(\v2\x1a.mediadelivery.SearchQueryH\x00R\vsearchQuery\x88\x01\x01\x12C\n" +
+	"\x0etorrent_search\x18\x02 \x03(\v2\x1c.mediadelivery.TorrentSearchR\rtorrentSearch\x12?\n" +
+	"\vmovie_match\x18\x03 \x01(\v2\x19.mediadelivery.MovieMatchH\x01R\n" +
+	"movieMatch\x88\x01\x01\x12a\n" +
+	"\x17torrent_download_status\x18\x04 \x01(\v2$.mediadelivery.TorrentDownloadStatusH\x02R\x15torrentDownloadStatus\x88\x01\x01\x12R\n" +
+	"\x12merge_video_status\x18\x05 \x01(\v2\x1f.mediadelivery.MergeVideoStatusH\x03R\x10mergeVideoStatus\x88\x01\x01\x12N\n" +
+	"\x12movie_catalog_info\x18\x06 \x01(\v2\x1b.mediadelivery.MovieCatalogH\x04R\x10movieCatalogInfo\x88\x01\x01\x125\n" +
+	"\atorrent\x18\a \x01(\v2\x16.mediadelivery.TorrentH\x05R\atorrent\x88\x01\x01\x12E\n" +
+	"\rretry_history\x18\b \x03(\v2 .mediadelivery.MovieRetryAttemptR\fretryHistoryB\x0f\n" +
+	"\r_search_queryB\x0e\n" +
+	"\f_movie_matchB\x1a\n" +
+	"\x18_torrent_download_statusB\x15\n" +
+	"\x13_merge_video_statusB\x15\n" +
+	"\x13_movie_catalog_infoB\n" +
+	"\n" +
+	"\b_torrent\"\xfc\x01\n" +
+	"\x12MovieDeliveryState\x124\n" +
+	"\x04data\x18\x01 \x01(\v2 .mediadelivery.MovieDeliveryDataR\x04data\x124\n" +
+	"\x04step\x18\x02 \x01(\x0e2 .mediadelivery.MovieDeliveryStepR\x04step\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.mediadelivery.StateStatusR\x06status\x12<\n" +
+	"\x05error\x18\x04 \x01(\v2!.mediadelivery.MovieDeliveryErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error*\xb0\x04\n" +
+	"\x11MovieDeliveryStep\x12\x1c\n" +
+	"\x18MovieDeliveryStepUnknown\x10\x00\x12\x1c\n" +
+	"\x18MovieGenerateSearchQuery\x10\x01\x12\x17\n" +
+	"\x13MovieSearchTorrents\x10\x02\x12 \n" +
+	"\x1cMovieWaitingUserChoseTorrent\x10\x03\x12\x16\n" +
+	"\x12MovieGetMagnetLink\x10\x04\x12\"\n" +
+	"\x1eMovieAddTorrentToTorrentClient\x10\x05\x12\x1c\n" +
+	"\x18MovieWaitingTorrentFiles\x10\x06\x12\x15\n" +
+	"\x11MovieGetMovieData\x10\a\x12\x1a\n" +
+	"\x16MoviePrepareMovieMatch\x10\b\x12'\n" +
+	"#MovieWaitingTorrentDownloadComplete\x10\t\x12#\n" +
+	"\x1fMovieCreateVideoContentCatalogs\x10\n" +
+	"\x12$\n" +
+	" MovieDeterminingNeedConvertFiles\x10\v\x12\x1d\n" +
+	"\x19MovieStartMergeVideoFiles\x10\f\x12\x1f\n" +
+	"\x1bMovieWaitingMergeVideoFiles\x10\r\x12\x1b\n" +
+	"\x17MovieCreateHardLinkCopy\x10\x0e\x12\x18\n" +
+	"\x14MovieGetCatalogsSize\x10\x0f\x12\x19\n" +
+	"\x15MovieSetMediaMetaData\x10\x10\x12\x11\n" +
+	"\rMovieAddLabel\x10\x11B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+
+var (
+	file_media_delivery_movie_delivery_state_proto_rawDescOnce sync.Once
+	file_media_delivery_movie_delivery_state_proto_rawDescData []byte
+)
+
+func file_media_delivery_movie_delivery_state_proto_rawDescGZIP() []byte {
+	file_media_delivery_movie_delivery_state_proto_rawDescOnce.Do(func() {
+		file_media_delivery_movie_delivery_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_media_delivery_movie_delivery_state_proto_rawDesc), len(file_media_delivery_movie_delivery_state_proto_rawDesc)))
+	})
+	return file_media_delivery_movie_delivery_state_proto_rawDescData
+}
+
+var file_media_delivery_movie_delivery_state_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_media_delivery_movie_delivery_state_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_media_delivery_movie_delivery_state_proto_goTypes = []any{
+	(MovieDeliveryStep)(0),            // 0: mediadelivery.MovieDeliveryStep
+	(MovieDeliveryError_ErrorType)(0), // 1: mediadelivery.MovieDeliveryError.ErrorType
+	(*MovieDeliveryError)(nil),        // 2: mediadelivery.MovieDeliveryError
+	(*MovieCatalogPath)(nil),          // 3: mediadelivery.MovieCatalogPath
+	(*MovieCatalog)(nil),              // 4: mediadelivery.MovieCatalog
+	(*MovieMatch)(nil),                // 5: mediadelivery.MovieMatch
+	(*MovieRetryAttempt)(nil),         // 6: mediadelivery.MovieRetryAttempt
+	(*MovieDeliveryData)(nil),         // 7: mediadelivery.MovieDeliveryData
+	(*MovieDeliveryState)(nil),        // 8: mediadelivery.MovieDeliveryState
+	(*Track)(nil),                     // 9: mediadelivery.Track
+	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
+	(*SearchQuery)(nil),               // 11: mediadelivery.SearchQuery
+	(*TorrentSearch)(nil),             // 12: mediadelivery.TorrentSearch
+	(*TorrentDownloadStatus)(nil),     // 13: mediadelivery.TorrentDownloadStatus
+	(*MergeVideoStatus)(nil),          // 14: mediadelivery.MergeVideoStatus
+	(*Torrent)(nil),                   // 15: mediadelivery.Torrent
+	(StateStatus)(0),                  // 16: mediadelivery.StateStatus
+}
+var file_media_delivery_movie_delivery_state_proto_depIdxs = []int32{
+	1,  // 0: mediadelivery.MovieDeliveryError.error_type:type_name -> mediadelivery.MovieDeliveryError.ErrorType
+	3,  // 1: mediadelivery.MovieCatalog.media_server_path:type_name -> mediadelivery.MovieCatalogPath
+	9,  // 2: mediadelivery.MovieMatch.video:type_name -> mediadelivery.Track
+	9,  // 3: mediadelivery.MovieMatch.audio_tracks:type_name -> mediadelivery.Track
+	9,  // 4: mediadelivery.MovieMatch.subtitles:type_name -> mediadelivery.Track
+	9,  // 5: mediadelivery.MovieMatch.unallocated:type_name -> mediadelivery.Track
+	0,  // 6: mediadelivery.MovieRetryAttempt.step:type_name -> mediadelivery.MovieDeliveryStep
+	10, // 7: mediadelivery.MovieRetryAttempt.retried_at:type_name -> google.protobuf.Timestamp
+	11, // 8: mediadelivery.MovieDeliveryData.search_query:type_name -> mediadelivery.SearchQuery
+	12, // 9: mediadelivery.MovieDeliveryData.torrent_search:type_name -> mediadelivery.TorrentSearch
+	5,  // 10: mediadelivery.MovieDeliveryData.movie_match:type_name -> mediadelivery.MovieMatch
+	13, // 11: mediadelivery.MovieDeliveryData.torrent_download_status:type_name -> mediadelivery.TorrentDownloadStatus
+	14, // 12: mediadelivery.MovieDeliveryData.merge_video_status:type_name -> mediadelivery.MergeVideoStatus
+	4,  // 13: mediadelivery.MovieDeliveryData.movie_catalog_info:type_name -> mediadelivery.MovieCatalog
+	15, // 14: mediadelivery.MovieDeliveryData.torrent:type_name -> mediadelivery.Torrent
+	6,  // 15: mediadelivery.MovieDeliveryData.retry_history:type_name -> mediadelivery.MovieRetryAttempt
+	7,  // 16: mediadelivery.MovieDeliveryState.data:type_name -> mediadelivery.MovieDeliveryData
+	0,  // 17: mediadelivery.MovieDeliveryState.step:type_name -> mediadelivery.MovieDeliveryStep
+	16, // 18: mediadelivery.MovieDeliveryState.status:type_name -> mediadelivery.StateStatus
+	2,  // 19: mediadelivery.MovieDeliveryState.error:type_name -> mediadelivery.MovieDeliveryError
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_media_delivery_movie_delivery_state_proto_init() }
+func file_media_delivery_movie_delivery_state_proto_init() {
+	if File_media_delivery_movie_delivery_state_proto != nil {
+		return
+	}
+	file_media_delivery_common_model_proto_init()
+	file_media_delivery_tv_show_delivery_state_proto_init()
+	file_media_delivery_movie_delivery_state_proto_msgTypes[4].OneofWrappers = []any{}
+	file_media_delivery_movie_delivery_state_proto_msgTypes[5].OneofWrappers = []any{}
+	file_media_delivery_movie_delivery_state_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_movie_delivery_state_proto_rawDesc), len(file_media_delivery_movie_delivery_state_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_media_delivery_movie_delivery_state_proto_goTypes,
+		DependencyIndexes: file_media_delivery_movie_delivery_state_proto_depIdxs,
+		EnumInfos:         file_media_delivery_movie_delivery_state_proto_enumTypes,
+		MessageInfos:      file_media_delivery_movie_delivery_state_proto_msgTypes,
+	}.Build()
+	File_media_delivery_movie_delivery_state_proto = out.File
+	file_media_delivery_movie_delivery_state_proto_goTypes = nil
+	file_media_delivery_movie_delivery_state_proto_depIdxs = nil
+}
@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: media-delivery/movie.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MovieShort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OriginalTitle string                 `protobuf:"bytes,3,opt,name=original_title,json=originalTitle,proto3" json:"original_title,omitempty"`
+	Overview      string                 `protobuf:"bytes,4,opt,name=overview,proto3" json:"overview,omitempty"`
+	Poster        *Image                 `protobuf:"bytes,5,opt,name=poster,proto3,oneof" json:"poster,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	VoteAverage   float64                `protobuf:"fixed64,7,opt,name=vote_average,json=voteAverage,proto3" json:"vote_average,omitempty"`
+	VoteCount     uint32                 `protobuf:"varint,8,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	Popularity    float64                `protobuf:"fixed64,9,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieShort) Reset() {
+	*x = MovieShort{}
+	mi := &file_media_delivery_movie_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieShort) ProtoMessage() {}
+
+func (x *MovieShort) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieShort.ProtoReflect.Descriptor instead.
+func (*MovieShort) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MovieShort) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MovieShort) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MovieShort) GetOriginalTitle() string {
+	if x != nil {
+		return x.OriginalTitle
+	}
+	return ""
+}
+
+func (x *MovieShort) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *MovieShort) GetPoster() *Image {
+	if x != nil {
+		return x.Poster
+	}
+	return nil
+}
+
+func (x *MovieShort) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *MovieShort) GetVoteAverage() float64 {
+	if x != nil {
+		return x.VoteAverage
+	}
+	return 0
+}
+
+func (x *MovieShort) GetVoteCount() uint32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *MovieShort) GetPopularity() float64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+type Movie struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OriginalTitle string                 `protobuf:"bytes,3,opt,name=original_title,json=originalTitle,proto3" json:"original_title,omitempty"`
+	Overview      string                 `protobuf:"bytes,4,opt,name=overview,proto3" json:"overview,omitempty"`
+	Poster        *Image                 `protobuf:"bytes,5,opt,name=poster,proto3,oneof" json:"poster,omitempty"`
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	VoteAverage   float64                `protobuf:"fixed64,7,opt,name=vote_average,json=voteAverage,proto3" json:"vote_average,omitempty"`
+	VoteCount     uint32                 `protobuf:"varint,8,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	Popularity    float64                `protobuf:"fixed64,9,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	Backdrop      *Image                 `protobuf:"bytes,10,opt,name=backdrop,proto3,oneof" json:"backdrop,omitempty"`
+	Genres        []string               `protobuf:"bytes,11,rep,name=genres,proto3" json:"genres,omitempty"`
+	ImdbId        string                 `protobuf:"bytes,12,opt,name=imdb_id,json=imdbId,proto3" json:"imdb_id,omitempty"`
+	OriginCountry []string               `protobuf:"bytes,13,rep,name=origin_country,json=originCountry,proto3" json:"origin_country,omitempty"`
+	Runtime       uint32                 `protobuf:"varint,14,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Status        string                 `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	Tagline       string                 `protobuf:"bytes,16,opt,name=tagline,proto3" json:"tagline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Movie) Reset() {
+	*x = Movie{}
+	mi := &file_media_delivery_movie_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Movie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Movie) ProtoMessage() {}
+
+func (x *Movie) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
+func (*Movie) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Movie) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Movie) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Movie) GetOriginalTitle() string {
+	if x != nil {
+		return x.OriginalTitle
+	}
+	return ""
+}
+
+func (x *Movie) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *Movie) GetPoster() *Image {
+	if x != nil {
+		return x.Poster
+	}
+	return nil
+}
+
+func (x *Movie) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *Movie) GetVoteAverage() float64 {
+	if x != nil {
+		return x.VoteAverage
+	}
+	return 0
+}
+
+func (x *Movie) GetVoteCount() uint32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *Movie) GetPopularity() float64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *Movie) GetBackdrop() *Image {
+	if x != nil {
+		return x.Backdrop
+	}
+	return nil
+}
+
+func (x *Movie) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Movie) GetImdbId() string {
+	if x != nil {
+		return x.ImdbId
+	}
+	return ""
+}
+
+func (x *Movie) GetOriginCountry() []string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return nil
+}
+
+func (x *Movie) GetRuntime() uint32 {
+	if x != nil {
+		return x.Runtime
+	}
+	return 0
+}
+
+func (x *Movie) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Movie) GetTagline() string {
+	if x != nil {
+		return x.Tagline
+	}
+	return ""
+}
+
+type SearchMovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMovieRequest) Reset() {
+	*x = SearchMovieRequest{}
+	mi := &file_media_delivery_movie_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMovieRequest) ProtoMessage() {}
+
+func (x *SearchMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMovieRequest.ProtoReflect.Descriptor instead.
+func (*SearchMovieRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchMovieRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MovieShort          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMovieResponse) Reset() {
+	*x = SearchMovieResponse{}
+	mi := &file_media_delivery_movie_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMovieResponse) ProtoMessage() {}
+
+func (x *SearchMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMovieResponse.ProtoReflect.Descriptor instead.
+func (*SearchMovieResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchMovieResponse) GetItems() []*MovieShort {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetMovieInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieId       uint64                 `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieInfoRequest) Reset() {
+	*x = GetMovieInfoRequest{}
+	mi := &file_media_delivery_movie_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieInfoRequest) ProtoMessage() {}
+
+func (x *GetMovieInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieInfoRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMovieInfoRequest) GetMovieId() uint64 {
+	if x != nil {
+		return x.MovieId
+	}
+	return 0
+}
+
+type GetMovieInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *Movie                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieInfoResponse) Reset() {
+	*x = GetMovieInfoResponse{}
+	mi := &file_media_delivery_movie_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieInfoResponse) ProtoMessage() {}
+
+func (x *GetMovieInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetMovieInfoResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMovieInfoResponse) GetResult() *Movie {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetMoviesFromLibraryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoviesFromLibraryRequest) Reset() {
+	*x = GetMoviesFromLibraryRequest{}
+	mi := &file_media_delivery_movie_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoviesFromLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoviesFromLibraryRequest) ProtoMessage() {}
+
+func (x *GetMoviesFromLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoviesFromLibraryRequest.ProtoReflect.Descriptor instead.
+func (*GetMoviesFromLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{6}
+}
+
+type GetMoviesFromLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MovieShort          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoviesFromLibraryResponse) Reset() {
+	*x = GetMoviesFromLibraryResponse{}
+	mi := &file_media_delivery_movie_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoviesFromLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoviesFromLibraryResponse) ProtoMessage() {}
+
+func (x *GetMoviesFromLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_movie_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoviesFromLibraryResponse.ProtoReflect.Descriptor instead.
+func (*GetMoviesFromLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_movie_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMoviesFromLibraryResponse) GetItems() []*MovieShort {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_media_delivery_movie_proto protoreflect.FileDescriptor
+
+const file_media_delivery_movie_proto_rawDesc = "" +
+	"\n" +
+	"\x1amedia-delivery/movie.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bmedia-delivery/tvshow.proto\"\xdd\x02\n" +
+	"\n" +
+	"MovieShort\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\x92A\x04\x9a\x02\x01\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0eoriginal_title\x18\x03 \x01(\tR\roriginalTitle\x12\x1a\n" +
+	"\boverview\x18\x04 \x01(\tR\boverview\x121\n" +
+	"\x06poster\x18\x05 \x01(\v2\x14.mediadelivery.ImageH\x00R\x06poster\x88\x01\x01\x12=\n" +
+	"\frelease_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12!\n" +
+	"\fvote_average\x18\a \x01(\x01R\vvoteAverage\x12\x1d\n" +
+	"\n" +
+	"vote_count\x18\b \x01(\rR\tvoteCount\x12\x1e\n" +
+	"\n" +
+	"popularity\x18\t \x01(\x01R\n" +
+	"popularityB\t\n" +
+	"\a_poster\"\xc0\x04\n" +
+	"\x05Movie\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\x92A\x04\x9a\x02\x01\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0eoriginal_title\x18\x03 \x01(\tR\roriginalTitle\x12\x1a\n" +
+	"\boverview\x18\x04 \x01(\tR\boverview\x121\n" +
+	"\x06poster\x18\x05 \x01(\v2\x14.mediadelivery.ImageH\x00R\x06poster\x88\x01\x01\x12=\n" +
+	"\frelease_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12!\n" +
+	"\fvote_average\x18\a \x01(\x01R\vvoteAverage\x12\x1d\n" +
+	"\n" +
+	"vote_count\x18\b \x01(\rR\tvoteCount\x12\x1e\n" +
+	"\n" +
+	"popularity\x18\t \x01(\x01R\n" +
+	"popularity\x125\n" +
+	"\bbackdrop\x18\n" +
+	" \x01(\v2\x14.mediadelivery.ImageH\x01R\bbackdrop\x88\x01\x01\x12\x16\n" +
+	"\x06genres\x18\v \x03(\tR\x06genres\x12\x17\n" +
+	"\aimdb_id\x18\f \x01(\tR\x06imdbId\x12%\n" +
+	"\x0eorigin_country\x18\r \x03(\tR\roriginCountry\x12\x18\n" +
+	"\aruntime\x18\x0e \x01(\rR\aruntime\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\tR\x06status\x12\x18\n" +
+	"\atagline\x18\x10 \x01(\tR\ataglineB\t\n" +
+	"\a_posterB\v\n" +
+	"\t_backdrop\"*\n" +
+	"\x12SearchMovieRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"F\n" +
+	"\x13SearchMovieResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.mediadelivery.MovieShortR\x05items\"9\n" +
+	"\x13GetMovieInfoRequest\x12\"\n" +
+	"\bmovie_id\x18\x01 \x01(\x04B\a\x92A\x04\x9a\x02\x01\x03R\amovieId\"D\n" +
+	"\x14GetMovieInfoResponse\x12,\n" +
+	"\x06result\x18\x01 \x01(\v2\x14.mediadelivery.MovieR\x06result\"\x1d\n" +
+	"\x1bGetMoviesFromLibraryRequest\"O\n" +
+	"\x1cGetMoviesFromLibraryResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.mediadelivery.MovieShortR\x05items2\xe0\x04\n" +
+	"\x13MovieLibraryService\x12\xa2\x01\n" +
+	"\vSearchMovie\x12!.mediadelivery.SearchMovieRequest\x1a\".mediadelivery.SearchMovieResponse\"L\x92A1\x12/Поиск фильмов по названию\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/movie/search\x12\xd7\x01\n" +
+	"\x14GetMoviesFromLibrary\x12*.mediadelivery.GetMoviesFromLibraryRequest\x1a+.mediadelivery.GetMoviesFromLibraryResponse\"f\x92AJ\x12HПолучение списка фильмов из библиотеки\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/movie/library\x12\xc9\x01\n" +
+	"\fGetMovieInfo\x12\".mediadelivery.GetMovieInfoRequest\x1a#.mediadelivery.GetMovieInfoResponse\"p\x92AL\x12JПолучение подробной информации о фильме\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/movie/info/{movie_id}B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+
+var (
+	file_media_delivery_movie_proto_rawDescOnce sync.Once
+	file_media_delivery_movie_proto_rawDescData []byte
+)
+
+func file_media_delivery_movie_proto_rawDescGZIP() []byte {
+	file_media_delivery_movie_proto_rawDescOnce.Do(func() {
+		file_media_delivery_movie_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_media_delivery_movie_proto_rawDesc), len(file_media_delivery_movie_proto_rawDesc)))
+	})
+	return file_media_delivery_movie_proto_rawDescData
+}
+
+var file_media_delivery_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_media_delivery_movie_proto_goTypes = []any{
+	(*MovieShort)(nil),                   // 0: mediadelivery.MovieShort
+	(*Movie)(nil),                        // 1: mediadelivery.Movie
+	(*SearchMovieRequest)(nil),           // 2: mediadelivery.SearchMovieRequest
+	(*SearchMovieResponse)(nil),          // 3: mediadelivery.SearchMovieResponse
+	(*GetMovieInfoRequest)(nil),          // 4: mediadelivery.GetMovieInfoRequest
+	(*GetMovieInfoResponse)(nil),         // 5: mediadelivery.GetMovieInfoResponse
+	(*GetMoviesFromLibraryRequest)(nil),  // 6: mediadelivery.GetMoviesFromLibraryRequest
+	(*GetMoviesFromLibraryResponse)(nil), // 7: mediadelivery.GetMoviesFromLibraryResponse
+	(*Image)(nil),                        // 8: mediadelivery.Image
+	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
+}
+var file_media_delivery_movie_proto_depIdxs = []int32{
+	8,  // 0: mediadelivery.MovieShort.poster:type_name -> mediadelivery.Image
+	9,  // 1: mediadelivery.MovieShort.release_date:type_name -> google.protobuf.Timestamp
+	8,  // 2: mediadelivery.Movie.poster:type_name -> mediadelivery.Image
+	9,  // 3: mediadelivery.Movie.release_date:type_name -> google.protobuf.Timestamp
+	8,  // 4: mediadelivery.Movie.backdrop:type_name -> mediadelivery.Image
+	0,  // 5: mediadelivery.SearchMovieResponse.items:type_name -> mediadelivery.MovieShort
+	1,  // 6: mediadelivery.GetMovieInfoResponse.result:type_name -> mediadelivery.Movie
+	0,  // 7: mediadelivery.GetMoviesFromLibraryResponse.items:type_name -> mediadelivery.MovieShort
+	2,  // 8: mediadelivery.MovieLibraryService.SearchMovie:input_type -> mediadelivery.SearchMovieRequest
+	6,  // 9: mediadelivery.MovieLibraryService.GetMoviesFromLibrary:input_type -> mediadelivery.GetMoviesFromLibraryRequest
+	4,  // 10: mediadelivery.MovieLibraryService.GetMovieInfo:input_type -> mediadelivery.GetMovieInfoRequest
+	3,  // 11: mediadelivery.MovieLibraryService.SearchMovie:output_type -> mediadelivery.SearchMovieResponse
+	7,  // 12: mediadelivery.MovieLibraryService.GetMoviesFromLibrary:output_type -> mediadelivery.GetMoviesFromLibraryResponse
+	5,  // 13: mediadelivery.MovieLibraryService.GetMovieInfo:output_type -> mediadelivery.GetMovieInfoResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_media_delivery_movie_proto_init() }
+func file_media_delivery_movie_proto_init() {
+	if File_media_delivery_movie_proto != nil {
+		return
+	}
+	file_media_delivery_tvshow_proto_init()
+	file_media_delivery_movie_proto_msgTypes[0].OneofWrappers = []any{}
+	file_media_delivery_movie_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_movie_proto_rawDesc), len(file_media_delivery_movie_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_media_delivery_movie_proto_goTypes,
+		DependencyIndexes: file_media_delivery_movie_proto_depIdxs,
+		MessageInfos:      file_media_delivery_movie_proto_msgTypes,
+	}.Build()
+	File_media_delivery_movie_proto = out.File
+	file_media_delivery_movie_proto_goTypes = nil
+	file_media_delivery_movie_proto_depIdxs = nil
+}
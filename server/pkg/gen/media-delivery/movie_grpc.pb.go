@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: media-delivery/movie.proto
+
+package api
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MovieLibraryService_SearchMovie_FullMethodName          = "/mediadelivery.MovieLibraryService/SearchMovie"
+	MovieLibraryService_GetMoviesFromLibrary_FullMethodName = "/mediadelivery.MovieLibraryService/GetMoviesFromLibrary"
+	MovieLibraryService_GetMovieInfo_FullMethodName         = "/mediadelivery.MovieLibraryService/GetMovieInfo"
+)
+
+// MovieLibraryServiceClient is the client API for MovieLibraryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MovieLibraryServiceClient interface {
+	SearchMovie(ctx context.Context, in *SearchMovieRequest, opts ...grpc.CallOption) (*SearchMovieResponse, error)
+	GetMoviesFromLibrary(ctx context.Context, in *GetMoviesFromLibraryRequest, opts ...grpc.CallOption) (*GetMoviesFromLibraryResponse, error)
+	GetMovieInfo(ctx context.Context, in *GetMovieInfoRequest, opts ...grpc.CallOption) (*GetMovieInfoResponse, error)
+}
+
+type movieLibraryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMovieLibraryServiceClient(cc grpc.ClientConnInterface) MovieLibraryServiceClient {
+	return &movieLibraryServiceClient{cc}
+}
+
+func (c *movieLibraryServiceClient) SearchMovie(ctx context.Context, in *SearchMovieRequest, opts ...grpc.CallOption) (*SearchMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMovieResponse)
+	err := c.cc.Invoke(ctx, MovieLibraryService_SearchMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieLibraryServiceClient) GetMoviesFromLibrary(ctx context.Context, in *GetMoviesFromLibraryRequest, opts ...grpc.CallOption) (*GetMoviesFromLibraryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMoviesFromLibraryResponse)
+	err := c.cc.Invoke(ctx, MovieLibraryService_GetMoviesFromLibrary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieLibraryServiceClient) GetMovieInfo(ctx context.Context, in *GetMovieInfoRequest, opts ...grpc.CallOption) (*GetMovieInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMovieInfoResponse)
+	err := c.cc.Invoke(ctx, MovieLibraryService_GetMovieInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MovieLibraryServiceServer is the server API for MovieLibraryService service.
+// All implementations must embed UnimplementedMovieLibraryServiceServer
+// for forward compatibility.
+type MovieLibraryServiceServer interface {
+	SearchMovie(context.Context, *SearchMovieRequest) (*SearchMovieResponse, error)
+	GetMoviesFromLibrary(context.Context, *GetMoviesFromLibraryRequest) (*GetMoviesFromLibraryResponse, error)
+	GetMovieInfo(context.Context, *GetMovieInfoRequest) (*GetMovieInfoResponse, error)
+	mustEmbedUnimplementedMovieLibraryServiceServer()
+}
+
+// UnimplementedMovieLibraryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMovieLibraryServiceServer struct{}
+
+func (UnimplementedMovieLibraryServiceServer) SearchMovie(context.Context, *SearchMovieRequest) (*SearchMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMovie not implemented")
+}
+func (UnimplementedMovieLibraryServiceServer) GetMoviesFromLibrary(context.Context, *GetMoviesFromLibraryRequest) (*GetMoviesFromLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoviesFromLibrary not implemented")
+}
+func (UnimplementedMovieLibraryServiceServer) GetMovieInfo(context.Context, *GetMovieInfoRequest) (*GetMovieInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovieInfo not implemented")
+}
+func (UnimplementedMovieLibraryServiceServer) mustEmbedUnimplementedMovieLibraryServiceServer() {}
+func (UnimplementedMovieLibraryServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeMovieLibraryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MovieLibraryServiceServer will
+// result in compilation errors.
+type UnsafeMovieLibraryServiceServer interface {
+	mustEmbedUnimplementedMovieLibraryServiceServer()
+}
+
+func RegisterMovieLibraryServiceServer(s grpc.ServiceRegistrar, srv MovieLibraryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMovieLibraryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MovieLibraryService_ServiceDesc, srv)
+}
+
+func _MovieLibraryService_SearchMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieLibraryServiceServer).SearchMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieLibraryService_SearchMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieLibraryServiceServer).SearchMovie(ctx, req.(*SearchMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieLibraryService_GetMoviesFromLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMoviesFromLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieLibraryServiceServer).GetMoviesFromLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieLibraryService_GetMoviesFromLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieLibraryServiceServer).GetMoviesFromLibrary(ctx, req.(*GetMoviesFromLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieLibraryService_GetMovieInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieLibraryServiceServer).GetMovieInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieLibraryService_GetMovieInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieLibraryServiceServer).GetMovieInfo(ctx, req.(*GetMovieInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MovieLibraryService_ServiceDesc is the grpc.ServiceDesc for MovieLibraryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MovieLibraryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediadelivery.MovieLibraryService",
+	HandlerType: (*MovieLibraryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchMovie",
+			Handler:    _MovieLibraryService_SearchMovie_Handler,
+		},
+		{
+			MethodName: "GetMoviesFromLibrary",
+			Handler:    _MovieLibraryService_GetMoviesFromLibrary_Handler,
+		},
+		{
+			MethodName: "GetMovieInfo",
+			Handler:    _MovieLibraryService_GetMovieInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "media-delivery/movie.proto",
+}
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: media-delivery/tv-show-cancel-state.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TVShowCancelStep int32
+
+const (
+	// Неизвестный шаг отмены
+	TVShowCancelStep_TVShowCancelStepUnknown TVShowCancelStep = 0
+	// Начало отмены доставки
+	TVShowCancelStep_StartCancelTVShowDelivery TVShowCancelStep = 1
+	// Отмена обработки видеофайлов
+	TVShowCancelStep_CancelMergeVideoFiles TVShowCancelStep = 2
+	// Удаление раздачи из торрент клиента
+	TVShowCancelStep_CancelDeleteTorrentFromTorrentClient TVShowCancelStep = 3
+	// Удаление файлов раздачи с диска
+	TVShowCancelStep_CancelDeleteTorrentFiles TVShowCancelStep = 4
+	// Удаление созданного каталога сезона
+	TVShowCancelStep_CancelDeleteSeasonCatalog TVShowCancelStep = 5
+	// Удаление лейбла
+	TVShowCancelStep_CancelDeleteLabel TVShowCancelStep = 6
+)
+
+// Enum value maps for TVShowCancelStep.
+var (
+	TVShowCancelStep_name = map[int32]string{
+		0: "TVShowCancelStepUnknown",
+		1: "StartCancelTVShowDelivery",
+		2: "CancelMergeVideoFiles",
+		3: "CancelDeleteTorrentFromTorrentClient",
+		4: "CancelDeleteTorrentFiles",
+		5: "CancelDeleteSeasonCatalog",
+		6: "CancelDeleteLabel",
+	}
+	TVShowCancelStep_value = map[string]int32{
+		"TVShowCancelStepUnknown":              0,
+		"StartCancelTVShowDelivery":            1,
+		"CancelMergeVideoFiles":                2,
+		"CancelDeleteTorrentFromTorrentClient": 3,
+		"CancelDeleteTorrentFiles":             4,
+		"CancelDeleteSeasonCatalog":            5,
+		"CancelDeleteLabel":                    6,
+	}
+)
+
+func (x TVShowCancelStep) Enum() *TVShowCancelStep {
+	p := new(TVShowCancelStep)
+	*p = x
+	return p
+}
+
+func (x TVShowCancelStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TVShowCancelStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_tv_show_cancel_state_proto_enumTypes[0].Descriptor()
+}
+
+func (TVShowCancelStep) Type() protoreflect.EnumType {
+	return &file_media_delivery_tv_show_cancel_state_proto_enumTypes[0]
+}
+
+func (x TVShowCancelStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TVShowCancelStep.Descriptor instead.
+func (TVShowCancelStep) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_cancel_state_proto_rawDescGZIP(), []int{0}
+}
+
+type TVShowCancelError_ErrorType int32
+
+const (
+	TVShowCancelError_TVShowCancelError_Unknown TVShowCancelError_ErrorType = 0
+)
+
+// Enum value maps for TVShowCancelError_ErrorType.
+var (
+	TVShowCancelError_ErrorType_name = map[int32]string{
+		0: "TVShowCancelError_Unknown",
+	}
+	TVShowCancelError_ErrorType_value = map[string]int32{
+		"TVShowCancelError_Unknown": 0,
+	}
+)
+
+func (x TVShowCancelError_ErrorType) Enum() *TVShowCancelError_ErrorType {
+	p := new(TVShowCancelError_ErrorType)
+	*p = x
+	return p
+}
+
+func (x TVShowCancelError_ErrorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TVShowCancelError_ErrorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_tv_show_cancel_state_proto_enumTypes[1].Descriptor()
+}
+
+func (TVShowCancelError_ErrorType) Type() protoreflect.EnumType {
+	return &file_media_delivery_tv_show_cancel_state_proto_enumTypes[1]
+}
+
+func (x TVShowCancelError_ErrorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TVShowCancelError_ErrorType.Descriptor instead.
+func (TVShowCancelError_ErrorType) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_cancel_state_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type TVShowCancelError struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	RawError      string                      `protobuf:"bytes,1,opt,name=raw_error,json=rawError,proto3" json:"raw_error,omitempty"`
+	ErrorType     TVShowCancelError_ErrorType `protobuf:"varint,2,opt,name=error_type,json=errorType,proto3,enum=mediadelivery.TVShowCancelError_ErrorType" json:"error_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TVShowCancelError) Reset() {
+	*x = TVShowCancelError{}
+	mi := &file_media_delivery_tv_show_cancel_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TVShowCancelError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TVShowCancelError) ProtoMessage() {}
+
+func (x *TVShowCancelError) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_cancel_state_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TVShowCancelError.ProtoReflect.Descriptor instead.
+func (*TVShowCancelError) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_cancel_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TVShowCancelError) GetRawError() string {
+	if x != nil {
+		return x.RawError
+	}
+	return ""
+}
+
+func (x *TVShowCancelError) GetErrorType() TVShowCancelError_ErrorType {
+	if x != nil {
+		return x.ErrorType
+	}
+	return TVShowCancelError_TVShowCancelError_Unknown
+}
+
+type TVShowCancelState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          TVShowCancelStep       `protobuf:"varint,1,opt,name=step,proto3,enum=mediadelivery.TVShowCancelStep" json:"step,omitempty"`
+	Status        StateStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=mediadelivery.StateStatus" json:"status,omitempty"`
+	Error         *TVShowCancelError     `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TVShowCancelState) Reset() {
+	*x = TVShowCancelState{}
+	mi := &file_media_delivery_tv_show_cancel_state_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TVShowCancelState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TVShowCancelState) ProtoMessage() {}
+
+func (x *TVShowCancelState) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_cancel_state_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TVShowCancelState.ProtoReflect.Descriptor instead.
+func (*TVShowCancelState) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_cancel_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TVShowCancelState) GetStep() TVShowCancelStep {
+	if x != nil {
+		return x.Step
+	}
+	return TVShowCancelStep_TVShowCancelStepUnknown
+}
+
+func (x *TVShowCancelState) GetStatus() StateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StateStatus_StatusUnknown
+}
+
+func (x *TVShowCancelState) GetError() *TVShowCancelError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_media_delivery_tv_show_cancel_state_proto protoreflect.FileDescriptor
+
+const file_media_delivery_tv_show_cancel_state_proto_rawDesc = "" +
+	"\n" +
+	")media-delivery/tv-show-cancel-state.proto\x12\rmediadelivery\x1a!media-delivery/common-model.proto\"\xa7\x01\n" +
+	"\x11TVShowCancelError\x12\x1b\n" +
+	"\traw_error\x18\x01 \x01(\tR\brawError\x12I\n" +
+	"\n" +
+	"error_type\x18\x02 \x01(\x0e2*.mediadelivery.TVShowCancelError.ErrorTypeR\terrorType\"*\n" +
+	"\tErrorType\x12\x1d\n" +
+	"\x19TVShowCancelError_Unknown\x10\x00\"\xc3\x01\n" +
+	"\x11TVShowCancelState\x123\n" +
+	"\x04step\x18\x01 \x01(\x0e2\x1f.mediadelivery.TVShowCancelStepR\x04step\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.mediadelivery.StateStatusR\x06status\x12;\n" +
+	"\x05error\x18\x03 \x01(\v2 .mediadelivery.TVShowCancelErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error*\xe7\x01\n" +
+	"\x10TVShowCancelStep\x12\x1b\n" +
+	"\x17TVShowCancelStepUnknown\x10\x00\x12\x1d\n" +
+	"\x19StartCancelTVShowDelivery\x10\x01\x12\x19\n" +
+	"\x15CancelMergeVideoFiles\x10\x02\x12(\n" +
+	"$CancelDeleteTorrentFromTorrentClient\x10\x03\x12\x1c\n" +
+	"\x18CancelDeleteTorrentFiles\x10\x04\x12\x1d\n" +
+	"\x19CancelDeleteSeasonCatalog\x10\x05\x12\x15\n" +
+	"\x11CancelDeleteLabel\x10\x06B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+
+var (
+	file_media_delivery_tv_show_cancel_state_proto_rawDescOnce sync.Once
+	file_media_delivery_tv_show_cancel_state_proto_rawDescData []byte
+)
+
+func file_media_delivery_tv_show_cancel_state_proto_rawDescGZIP() []byte {
+	file_media_delivery_tv_show_cancel_state_proto_rawDescOnce.Do(func() {
+		file_media_delivery_tv_show_cancel_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_media_delivery_tv_show_cancel_state_proto_rawDesc), len(file_media_delivery_tv_show_cancel_state_proto_rawDesc)))
+	})
+	return file_media_delivery_tv_show_cancel_state_proto_rawDescData
+}
+
+var file_media_delivery_tv_show_cancel_state_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_media_delivery_tv_show_cancel_state_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_media_delivery_tv_show_cancel_state_proto_goTypes = []any{
+	(TVShowCancelStep)(0),            // 0: mediadelivery.TVShowCancelStep
+	(TVShowCancelError_ErrorType)(0), // 1: mediadelivery.TVShowCancelError.ErrorType
+	(*TVShowCancelError)(nil),        // 2: mediadelivery.TVShowCancelError
+	(*TVShowCancelState)(nil),        // 3: mediadelivery.TVShowCancelState
+	(StateStatus)(0),                 // 4: mediadelivery.StateStatus
+}
+var file_media_delivery_tv_show_cancel_state_proto_depIdxs = []int32{
+	1, // 0: mediadelivery.TVShowCancelError.error_type:type_name -> mediadelivery.TVShowCancelError.ErrorType
+	0, // 1: mediadelivery.TVShowCancelState.step:type_name -> mediadelivery.TVShowCancelStep
+	4, // 2: mediadelivery.TVShowCancelState.status:type_name -> mediadelivery.StateStatus
+	2, // 3: mediadelivery.TVShowCancelState.error:type_name -> mediadelivery.TVShowCancelError
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_media_delivery_tv_show_cancel_state_proto_init() }
+func file_media_delivery_tv_show_cancel_state_proto_init() {
+	if File_media_delivery_tv_show_cancel_state_proto != nil {
+		return
+	}
+	file_media_delivery_common_model_proto_init()
+	file_media_delivery_tv_show_cancel_state_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_tv_show_cancel_state_proto_rawDesc), len(file_media_delivery_tv_show_cancel_state_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_media_delivery_tv_show_cancel_state_proto_goTypes,
+		DependencyIndexes: file_media_delivery_tv_show_cancel_state_proto_depIdxs,
+		EnumInfos:         file_media_delivery_tv_show_cancel_state_proto_enumTypes,
+		MessageInfos:      file_media_delivery_tv_show_cancel_state_proto_msgTypes,
+	}.Build()
+	File_media_delivery_tv_show_cancel_state_proto = out.File
+	file_media_delivery_tv_show_cancel_state_proto_goTypes = nil
+	file_media_delivery_tv_show_cancel_state_proto_depIdxs = nil
+}
@@ -16,7 +16,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/emptypb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -67,6 +67,8 @@ const (
 	TVShowDeliveryStep_WaitingTorrentFiles TVShowDeliveryStep = 18
 	// получение информации о эпизодах и каталоге сезона
 	TVShowDeliveryStep_GetEpisodesData TVShowDeliveryStep = 19
+	// Получение информации о дорожках скачанных файлов (кодек, каналы, длительность, язык)
+	TVShowDeliveryStep_ProbeMediaFiles TVShowDeliveryStep = 20
 )
 
 // Enum value maps for TVShowDeliveryStep.
@@ -91,6 +93,7 @@ var (
 		17: "SendDeliveryNotification",
 		18: "WaitingTorrentFiles",
 		19: "GetEpisodesData",
+		20: "ProbeMediaFiles",
 	}
 	TVShowDeliveryStep_value = map[string]int32{
 		"TVShowDeliveryStepUnknown":      0,
@@ -112,6 +115,7 @@ var (
 		"SendDeliveryNotification":       17,
 		"WaitingTorrentFiles":            18,
 		"GetEpisodesData":                19,
+		"ProbeMediaFiles":                20,
 	}
 )
 
@@ -245,6 +249,61 @@ func (Track_TrackType) EnumDescriptor() ([]byte, []int) {
 	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type ContentMatch_Warning int32
+
+const (
+	ContentMatch_WARNING_UNKNOWN ContentMatch_Warning = 0
+	// В раздаче несколько видеофайлов с номером этой серии
+	ContentMatch_WARNING_DUPLICATE_EPISODE ContentMatch_Warning = 1
+	// Видеофайл сопоставлен сразу с несколькими эпизодами
+	ContentMatch_WARNING_VIDEO_CLAIMED_TWICE ContentMatch_Warning = 2
+	// Количество аудиодорожек отличается от большинства эпизодов
+	ContentMatch_WARNING_AUDIO_TRACKS_COUNT_MISMATCH ContentMatch_Warning = 3
+)
+
+// Enum value maps for ContentMatch_Warning.
+var (
+	ContentMatch_Warning_name = map[int32]string{
+		0: "WARNING_UNKNOWN",
+		1: "WARNING_DUPLICATE_EPISODE",
+		2: "WARNING_VIDEO_CLAIMED_TWICE",
+		3: "WARNING_AUDIO_TRACKS_COUNT_MISMATCH",
+	}
+	ContentMatch_Warning_value = map[string]int32{
+		"WARNING_UNKNOWN":                     0,
+		"WARNING_DUPLICATE_EPISODE":           1,
+		"WARNING_VIDEO_CLAIMED_TWICE":         2,
+		"WARNING_AUDIO_TRACKS_COUNT_MISMATCH": 3,
+	}
+)
+
+func (x ContentMatch_Warning) Enum() *ContentMatch_Warning {
+	p := new(ContentMatch_Warning)
+	*p = x
+	return p
+}
+
+func (x ContentMatch_Warning) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentMatch_Warning) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_tv_show_delivery_state_proto_enumTypes[3].Descriptor()
+}
+
+func (ContentMatch_Warning) Type() protoreflect.EnumType {
+	return &file_media_delivery_tv_show_delivery_state_proto_enumTypes[3]
+}
+
+func (x ContentMatch_Warning) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentMatch_Warning.Descriptor instead.
+func (ContentMatch_Warning) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{9, 0}
+}
+
 type TorrentDownloadStatus_TorrentState int32
 
 const (
@@ -287,11 +346,11 @@ func (x TorrentDownloadStatus_TorrentState) String() string {
 }
 
 func (TorrentDownloadStatus_TorrentState) Descriptor() protoreflect.EnumDescriptor {
-	return file_media_delivery_tv_show_delivery_state_proto_enumTypes[3].Descriptor()
+	return file_media_delivery_tv_show_delivery_state_proto_enumTypes[4].Descriptor()
 }
 
 func (TorrentDownloadStatus_TorrentState) Type() protoreflect.EnumType {
-	return &file_media_delivery_tv_show_delivery_state_proto_enumTypes[3]
+	return &file_media_delivery_tv_show_delivery_state_proto_enumTypes[4]
 }
 
 func (x TorrentDownloadStatus_TorrentState) Number() protoreflect.EnumNumber {
@@ -300,7 +359,7 @@ func (x TorrentDownloadStatus_TorrentState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TorrentDownloadStatus_TorrentState.Descriptor instead.
 func (TorrentDownloadStatus_TorrentState) EnumDescriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{9, 0}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type TVShowDeliveryError struct {
@@ -424,12 +483,17 @@ func (x *TVShowDeliveryState) GetError() *TVShowDeliveryError {
 }
 
 type Track struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RelativePath  string                 `protobuf:"bytes,1,opt,name=relative_path,json=relativePath,proto3" json:"relative_path,omitempty"`
-	FullPath      string                 `protobuf:"bytes,2,opt,name=full_path,json=fullPath,proto3" json:"full_path,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Language      *string                `protobuf:"bytes,4,opt,name=language,proto3,oneof" json:"language,omitempty"`
-	Type          Track_TrackType        `protobuf:"varint,5,opt,name=type,proto3,enum=mediadelivery.Track_TrackType" json:"type,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RelativePath string                 `protobuf:"bytes,1,opt,name=relative_path,json=relativePath,proto3" json:"relative_path,omitempty"`
+	FullPath     string                 `protobuf:"bytes,2,opt,name=full_path,json=fullPath,proto3" json:"full_path,omitempty"`
+	Name         *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Language     *string                `protobuf:"bytes,4,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Type         Track_TrackType        `protobuf:"varint,5,opt,name=type,proto3,enum=mediadelivery.Track_TrackType" json:"type,omitempty"`
+	// Как файл был автоматически сопоставлен с эпизодом (нет если не сопоставлен)
+	Match *TrackMatch `protobuf:"bytes,6,opt,name=match,proto3,oneof" json:"match,omitempty"`
+	// Информация из контейнера файла (нет пока файл не скачан или формат не распознан)
+	// Заполняется после скачивания, когда метч уже подтвержден, и не влияет на сопоставление файлов
+	Media         *TrackMedia `protobuf:"bytes,7,opt,name=media,proto3,oneof" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -499,6 +563,262 @@ func (x *Track) GetType() Track_TrackType {
 	return Track_TRACK_TYPE_UNKNOWN
 }
 
+func (x *Track) GetMatch() *TrackMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Track) GetMedia() *TrackMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type EmbeddedTrack struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Type     Track_TrackType        `protobuf:"varint,1,opt,name=type,proto3,enum=mediadelivery.Track_TrackType" json:"type,omitempty"`
+	Codec    string                 `protobuf:"bytes,2,opt,name=codec,proto3" json:"codec,omitempty"`
+	Language *string                `protobuf:"bytes,3,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Name     *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Количество каналов (только для аудио)
+	Channels      uint32 `protobuf:"varint,5,opt,name=channels,proto3" json:"channels,omitempty"`
+	DefaultTrack  bool   `protobuf:"varint,6,opt,name=default_track,json=defaultTrack,proto3" json:"default_track,omitempty"`
+	ForcedTrack   bool   `protobuf:"varint,7,opt,name=forced_track,json=forcedTrack,proto3" json:"forced_track,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddedTrack) Reset() {
+	*x = EmbeddedTrack{}
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddedTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddedTrack) ProtoMessage() {}
+
+func (x *EmbeddedTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddedTrack.ProtoReflect.Descriptor instead.
+func (*EmbeddedTrack) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EmbeddedTrack) GetType() Track_TrackType {
+	if x != nil {
+		return x.Type
+	}
+	return Track_TRACK_TYPE_UNKNOWN
+}
+
+func (x *EmbeddedTrack) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *EmbeddedTrack) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *EmbeddedTrack) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *EmbeddedTrack) GetChannels() uint32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *EmbeddedTrack) GetDefaultTrack() bool {
+	if x != nil {
+		return x.DefaultTrack
+	}
+	return false
+}
+
+func (x *EmbeddedTrack) GetForcedTrack() bool {
+	if x != nil {
+		return x.ForcedTrack
+	}
+	return false
+}
+
+type TrackMedia struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Codec string                 `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	// Количество каналов (только для аудио)
+	Channels uint32 `protobuf:"varint,2,opt,name=channels,proto3" json:"channels,omitempty"`
+	// Длительность в секундах
+	DurationSeconds float64 `protobuf:"fixed64,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Language        *string `protobuf:"bytes,4,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	// Дорожки внутри видеофайла (только для видео)
+	EmbeddedTracks []*EmbeddedTrack `protobuf:"bytes,5,rep,name=embedded_tracks,json=embeddedTracks,proto3" json:"embedded_tracks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TrackMedia) Reset() {
+	*x = TrackMedia{}
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMedia) ProtoMessage() {}
+
+func (x *TrackMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMedia.ProtoReflect.Descriptor instead.
+func (*TrackMedia) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TrackMedia) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *TrackMedia) GetChannels() uint32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *TrackMedia) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *TrackMedia) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *TrackMedia) GetEmbeddedTracks() []*EmbeddedTrack {
+	if x != nil {
+		return x.EmbeddedTracks
+	}
+	return nil
+}
+
+type TrackMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonNumber  uint32                 `protobuf:"varint,1,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	EpisodeNumber uint32                 `protobuf:"varint,2,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	// Паттерн имени файла, по которому определены сезон и серия
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Уверенность сопоставления (0 - 1)
+	Confidence    float64 `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackMatch) Reset() {
+	*x = TrackMatch{}
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMatch) ProtoMessage() {}
+
+func (x *TrackMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMatch.ProtoReflect.Descriptor instead.
+func (*TrackMatch) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TrackMatch) GetSeasonNumber() uint32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *TrackMatch) GetEpisodeNumber() uint32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *TrackMatch) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TrackMatch) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
 type SearchQuery struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
@@ -508,7 +828,7 @@ type SearchQuery struct {
 
 func (x *SearchQuery) Reset() {
 	*x = SearchQuery{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[3]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +840,7 @@ func (x *SearchQuery) String() string {
 func (*SearchQuery) ProtoMessage() {}
 
 func (x *SearchQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[3]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +853,7 @@ func (x *SearchQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchQuery.ProtoReflect.Descriptor instead.
 func (*SearchQuery) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{3}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SearchQuery) GetQuery() string {
@@ -544,22 +864,32 @@ func (x *SearchQuery) GetQuery() string {
 }
 
 type TorrentSearch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Href          string                 `protobuf:"bytes,2,opt,name=href,proto3" json:"href,omitempty"`
-	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
-	Size          string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
-	Seeds         int64                  `protobuf:"varint,4,opt,name=seeds,proto3" json:"seeds,omitempty"`
-	Leeches       int64                  `protobuf:"varint,5,opt,name=leeches,proto3" json:"leeches,omitempty"`
-	Downloads     int64                  `protobuf:"varint,6,opt,name=downloads,proto3" json:"downloads,omitempty"`
-	AddedDate     string                 `protobuf:"bytes,7,opt,name=added_date,json=addedDate,proto3" json:"added_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Title     string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Href      string                 `protobuf:"bytes,2,opt,name=href,proto3" json:"href,omitempty"`
+	Category  string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Size      string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	Seeds     int64                  `protobuf:"varint,4,opt,name=seeds,proto3" json:"seeds,omitempty"`
+	Leeches   int64                  `protobuf:"varint,5,opt,name=leeches,proto3" json:"leeches,omitempty"`
+	Downloads int64                  `protobuf:"varint,6,opt,name=downloads,proto3" json:"downloads,omitempty"`
+	AddedDate string                 `protobuf:"bytes,7,opt,name=added_date,json=addedDate,proto3" json:"added_date,omitempty"`
+	// Трекер на котором найдена раздача
+	Source string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	// Оценка раздачи (0 - 100)
+	Score float64 `protobuf:"fixed64,10,opt,name=score,proto3" json:"score,omitempty"`
+	// Из чего сложилась оценка
+	ScoreReasons []string `protobuf:"bytes,11,rep,name=score_reasons,json=scoreReasons,proto3" json:"score_reasons,omitempty"`
+	// Раздача подходит под профиль качества (true если профиль не задан)
+	MatchesProfile bool `protobuf:"varint,12,opt,name=matches_profile,json=matchesProfile,proto3" json:"matches_profile,omitempty"`
+	// Причины несоответствия профилю качества
+	ProfileMismatches []string `protobuf:"bytes,13,rep,name=profile_mismatches,json=profileMismatches,proto3" json:"profile_mismatches,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TorrentSearch) Reset() {
 	*x = TorrentSearch{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[4]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +901,7 @@ func (x *TorrentSearch) String() string {
 func (*TorrentSearch) ProtoMessage() {}
 
 func (x *TorrentSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[4]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +914,7 @@ func (x *TorrentSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TorrentSearch.ProtoReflect.Descriptor instead.
 func (*TorrentSearch) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{4}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TorrentSearch) GetTitle() string {
@@ -643,6 +973,41 @@ func (x *TorrentSearch) GetAddedDate() string {
 	return ""
 }
 
+func (x *TorrentSearch) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TorrentSearch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TorrentSearch) GetScoreReasons() []string {
+	if x != nil {
+		return x.ScoreReasons
+	}
+	return nil
+}
+
+func (x *TorrentSearch) GetMatchesProfile() bool {
+	if x != nil {
+		return x.MatchesProfile
+	}
+	return false
+}
+
+func (x *TorrentSearch) GetProfileMismatches() []string {
+	if x != nil {
+		return x.ProfileMismatches
+	}
+	return nil
+}
+
 type EpisodeInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SeasonNumber  uint32                 `protobuf:"varint,1,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
@@ -655,7 +1020,7 @@ type EpisodeInfo struct {
 
 func (x *EpisodeInfo) Reset() {
 	*x = EpisodeInfo{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[5]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +1032,7 @@ func (x *EpisodeInfo) String() string {
 func (*EpisodeInfo) ProtoMessage() {}
 
 func (x *EpisodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[5]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +1045,7 @@ func (x *EpisodeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpisodeInfo.ProtoReflect.Descriptor instead.
 func (*EpisodeInfo) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{5}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EpisodeInfo) GetSeasonNumber() uint32 {
@@ -720,14 +1085,16 @@ type ContentMatch struct {
 	// Аудиодорожки
 	AudioTracks []*Track `protobuf:"bytes,3,rep,name=audio_tracks,json=audioTracks,proto3" json:"audio_tracks,omitempty"`
 	// Субтитры
-	Subtitles     []*Track `protobuf:"bytes,4,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	Subtitles []*Track `protobuf:"bytes,4,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	// На что стоит обратить внимание при подтверждении сопоставления
+	Warnings      []ContentMatch_Warning `protobuf:"varint,5,rep,packed,name=warnings,proto3,enum=mediadelivery.ContentMatch_Warning" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ContentMatch) Reset() {
 	*x = ContentMatch{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[6]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -739,7 +1106,7 @@ func (x *ContentMatch) String() string {
 func (*ContentMatch) ProtoMessage() {}
 
 func (x *ContentMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[6]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,7 +1119,7 @@ func (x *ContentMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContentMatch.ProtoReflect.Descriptor instead.
 func (*ContentMatch) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{6}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ContentMatch) GetEpisode() *EpisodeInfo {
@@ -783,6 +1150,13 @@ func (x *ContentMatch) GetSubtitles() []*Track {
 	return nil
 }
 
+func (x *ContentMatch) GetWarnings() []ContentMatch_Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type ContentMatches struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Matches []*ContentMatch        `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
@@ -796,7 +1170,7 @@ type ContentMatches struct {
 
 func (x *ContentMatches) Reset() {
 	*x = ContentMatches{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[7]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +1182,7 @@ func (x *ContentMatches) String() string {
 func (*ContentMatches) ProtoMessage() {}
 
 func (x *ContentMatches) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[7]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1195,7 @@ func (x *ContentMatches) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContentMatches.ProtoReflect.Descriptor instead.
 func (*ContentMatches) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{7}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ContentMatches) GetMatches() []*ContentMatch {
@@ -858,7 +1232,7 @@ type ChoseFileMatchesOptionsRequest struct {
 
 func (x *ChoseFileMatchesOptionsRequest) Reset() {
 	*x = ChoseFileMatchesOptionsRequest{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[8]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -870,7 +1244,7 @@ func (x *ChoseFileMatchesOptionsRequest) String() string {
 func (*ChoseFileMatchesOptionsRequest) ProtoMessage() {}
 
 func (x *ChoseFileMatchesOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[8]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1257,7 @@ func (x *ChoseFileMatchesOptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChoseFileMatchesOptionsRequest.ProtoReflect.Descriptor instead.
 func (*ChoseFileMatchesOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{8}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChoseFileMatchesOptionsRequest) GetContentId() *ContentID {
@@ -918,7 +1292,7 @@ type TorrentDownloadStatus struct {
 
 func (x *TorrentDownloadStatus) Reset() {
 	*x = TorrentDownloadStatus{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[9]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -930,7 +1304,7 @@ func (x *TorrentDownloadStatus) String() string {
 func (*TorrentDownloadStatus) ProtoMessage() {}
 
 func (x *TorrentDownloadStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[9]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1317,7 @@ func (x *TorrentDownloadStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TorrentDownloadStatus.ProtoReflect.Descriptor instead.
 func (*TorrentDownloadStatus) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{9}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TorrentDownloadStatus) GetState() TorrentDownloadStatus_TorrentState {
@@ -977,7 +1351,7 @@ type MergeVideoStatus struct {
 
 func (x *MergeVideoStatus) Reset() {
 	*x = MergeVideoStatus{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[10]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1363,7 @@ func (x *MergeVideoStatus) String() string {
 func (*MergeVideoStatus) ProtoMessage() {}
 
 func (x *MergeVideoStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[10]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1376,7 @@ func (x *MergeVideoStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MergeVideoStatus.ProtoReflect.Descriptor instead.
 func (*MergeVideoStatus) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{10}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MergeVideoStatus) GetProgress() float32 {
@@ -1031,7 +1405,7 @@ type TVShowCatalogPath struct {
 
 func (x *TVShowCatalogPath) Reset() {
 	*x = TVShowCatalogPath{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[11]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1043,7 +1417,7 @@ func (x *TVShowCatalogPath) String() string {
 func (*TVShowCatalogPath) ProtoMessage() {}
 
 func (x *TVShowCatalogPath) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[11]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,7 +1430,7 @@ func (x *TVShowCatalogPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TVShowCatalogPath.ProtoReflect.Descriptor instead.
 func (*TVShowCatalogPath) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{11}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TVShowCatalogPath) GetTvShowPath() string {
@@ -1093,7 +1467,7 @@ type TVShowCatalog struct {
 
 func (x *TVShowCatalog) Reset() {
 	*x = TVShowCatalog{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[12]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1105,7 +1479,7 @@ func (x *TVShowCatalog) String() string {
 func (*TVShowCatalog) ProtoMessage() {}
 
 func (x *TVShowCatalog) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[12]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1492,7 @@ func (x *TVShowCatalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TVShowCatalog.ProtoReflect.Descriptor instead.
 func (*TVShowCatalog) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{12}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TVShowCatalog) GetTorrentPath() string {
@@ -1166,7 +1540,7 @@ type Torrent struct {
 
 func (x *Torrent) Reset() {
 	*x = Torrent{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[13]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1178,7 +1552,7 @@ func (x *Torrent) String() string {
 func (*Torrent) ProtoMessage() {}
 
 func (x *Torrent) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[13]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,7 +1565,7 @@ func (x *Torrent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Torrent.ProtoReflect.Descriptor instead.
 func (*Torrent) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{13}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Torrent) GetHref() string {
@@ -1201,6 +1575,87 @@ func (x *Torrent) GetHref() string {
 	return ""
 }
 
+type RetryAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Шаг, который повторили
+	Step TVShowDeliveryStep `protobuf:"varint,1,opt,name=step,proto3,enum=mediadelivery.TVShowDeliveryStep" json:"step,omitempty"`
+	// Ошибка, с которой завершилось последнее выполнение шага
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Время запроса повтора
+	RetriedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=retried_at,json=retriedAt,proto3" json:"retried_at,omitempty"`
+	// Новая раздача, если была указана
+	Href *string `protobuf:"bytes,4,opt,name=href,proto3,oneof" json:"href,omitempty"`
+	// Был передан новый метч файлов
+	ContentMatchesChanged bool `protobuf:"varint,5,opt,name=content_matches_changed,json=contentMatchesChanged,proto3" json:"content_matches_changed,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RetryAttempt) Reset() {
+	*x = RetryAttempt{}
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryAttempt) ProtoMessage() {}
+
+func (x *RetryAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryAttempt.ProtoReflect.Descriptor instead.
+func (*RetryAttempt) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RetryAttempt) GetStep() TVShowDeliveryStep {
+	if x != nil {
+		return x.Step
+	}
+	return TVShowDeliveryStep_TVShowDeliveryStepUnknown
+}
+
+func (x *RetryAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RetryAttempt) GetRetriedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetriedAt
+	}
+	return nil
+}
+
+func (x *RetryAttempt) GetHref() string {
+	if x != nil && x.Href != nil {
+		return *x.Href
+	}
+	return ""
+}
+
+func (x *RetryAttempt) GetContentMatchesChanged() bool {
+	if x != nil {
+		return x.ContentMatchesChanged
+	}
+	return false
+}
+
 type TVShowDeliveryData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Поисковый запрос поиска торрент файла
@@ -1216,14 +1671,24 @@ type TVShowDeliveryData struct {
 	// TVShowCatalogInfo информация о каталогах сериала
 	TvShowCatalogInfo *TVShowCatalog `protobuf:"bytes,6,opt,name=tv_show_catalog_info,json=tvShowCatalogInfo,proto3,oneof" json:"tv_show_catalog_info,omitempty"`
 	// Информация о раздаче
-	Torrent       *Torrent `protobuf:"bytes,7,opt,name=torrent,proto3,oneof" json:"torrent,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Torrent *Torrent `protobuf:"bytes,7,opt,name=torrent,proto3,oneof" json:"torrent,omitempty"`
+	// Профиль качества, выбранный при создании доставки
+	QualityProfile *QualityProfile `protobuf:"bytes,8,opt,name=quality_profile,json=qualityProfile,proto3,oneof" json:"quality_profile,omitempty"`
+	// История ручных повторов шагов, завершившихся ошибкой
+	RetryHistory []*RetryAttempt `protobuf:"bytes,9,rep,name=retry_history,json=retryHistory,proto3" json:"retry_history,omitempty"`
+	// Сезон восстанавливается по данным предыдущей доставки
+	Restored bool `protobuf:"varint,10,opt,name=restored,proto3" json:"restored,omitempty"`
+	// Сезон, доставка которого выбрала раздачу-пак (если сезон доставляется из пака)
+	PackSourceSeasonNumber *uint32 `protobuf:"varint,11,opt,name=pack_source_season_number,json=packSourceSeasonNumber,proto3,oneof" json:"pack_source_season_number,omitempty"`
+	// Метч файлов подтвержден автоматически, без участия пользователя
+	FileMatchesAutoConfirmed bool `protobuf:"varint,12,opt,name=file_matches_auto_confirmed,json=fileMatchesAutoConfirmed,proto3" json:"file_matches_auto_confirmed,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *TVShowDeliveryData) Reset() {
 	*x = TVShowDeliveryData{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[14]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1235,7 +1700,7 @@ func (x *TVShowDeliveryData) String() string {
 func (*TVShowDeliveryData) ProtoMessage() {}
 
 func (x *TVShowDeliveryData) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[14]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1713,7 @@ func (x *TVShowDeliveryData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TVShowDeliveryData.ProtoReflect.Descriptor instead.
 func (*TVShowDeliveryData) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{14}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TVShowDeliveryData) GetSearchQuery() *SearchQuery {
@@ -1300,6 +1765,41 @@ func (x *TVShowDeliveryData) GetTorrent() *Torrent {
 	return nil
 }
 
+func (x *TVShowDeliveryData) GetQualityProfile() *QualityProfile {
+	if x != nil {
+		return x.QualityProfile
+	}
+	return nil
+}
+
+func (x *TVShowDeliveryData) GetRetryHistory() []*RetryAttempt {
+	if x != nil {
+		return x.RetryHistory
+	}
+	return nil
+}
+
+func (x *TVShowDeliveryData) GetRestored() bool {
+	if x != nil {
+		return x.Restored
+	}
+	return false
+}
+
+func (x *TVShowDeliveryData) GetPackSourceSeasonNumber() uint32 {
+	if x != nil && x.PackSourceSeasonNumber != nil {
+		return *x.PackSourceSeasonNumber
+	}
+	return 0
+}
+
+func (x *TVShowDeliveryData) GetFileMatchesAutoConfirmed() bool {
+	if x != nil {
+		return x.FileMatchesAutoConfirmed
+	}
+	return false
+}
+
 type ContentMatches_Options struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Оставлять оригинальные аудиодорожки (если они есть)
@@ -1316,7 +1816,7 @@ type ContentMatches_Options struct {
 
 func (x *ContentMatches_Options) Reset() {
 	*x = ContentMatches_Options{}
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[15]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +1828,7 @@ func (x *ContentMatches_Options) String() string {
 func (*ContentMatches_Options) ProtoMessage() {}
 
 func (x *ContentMatches_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[15]
+	mi := &file_media_delivery_tv_show_delivery_state_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +1841,7 @@ func (x *ContentMatches_Options) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContentMatches_Options.ProtoReflect.Descriptor instead.
 func (*ContentMatches_Options) Descriptor() ([]byte, []int) {
-	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{7, 0}
+	return file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *ContentMatches_Options) GetKeepOriginalAudio() bool {
@@ -1376,7 +1876,7 @@ var File_media_delivery_tv_show_delivery_state_proto protoreflect.FileDescriptor
 
 const file_media_delivery_tv_show_delivery_state_proto_rawDesc = "" +
 	"\n" +
-	"+media-delivery/tv-show-delivery-state.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a!media-delivery/common-model.proto\"\xde\x01\n" +
+	"+media-delivery/tv-show-delivery-state.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a!media-delivery/common-model.proto\x1a(media-delivery/video-content-model.proto\"\xde\x01\n" +
 	"\x13TVShowDeliveryError\x12\x1b\n" +
 	"\traw_error\x18\x01 \x01(\tR\brawError\x12K\n" +
 	"\n" +
@@ -1390,22 +1890,52 @@ const file_media_delivery_tv_show_delivery_state_proto_rawDesc = "" +
 	"\x04step\x18\x02 \x01(\x0e2!.mediadelivery.TVShowDeliveryStepR\x04step\x122\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x1a.mediadelivery.StateStatusR\x06status\x12=\n" +
 	"\x05error\x18\x04 \x01(\v2\".mediadelivery.TVShowDeliveryErrorH\x00R\x05error\x88\x01\x01B\b\n" +
-	"\x06_error\"\xb7\x02\n" +
+	"\x06_error\"\xb7\x03\n" +
 	"\x05Track\x12#\n" +
 	"\rrelative_path\x18\x01 \x01(\tR\frelativePath\x12\x1b\n" +
 	"\tfull_path\x18\x02 \x01(\tR\bfullPath\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
 	"\blanguage\x18\x04 \x01(\tH\x01R\blanguage\x88\x01\x01\x122\n" +
-	"\x04type\x18\x05 \x01(\x0e2\x1e.mediadelivery.Track.TrackTypeR\x04type\"h\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x1e.mediadelivery.Track.TrackTypeR\x04type\x124\n" +
+	"\x05match\x18\x06 \x01(\v2\x19.mediadelivery.TrackMatchH\x02R\x05match\x88\x01\x01\x124\n" +
+	"\x05media\x18\a \x01(\v2\x19.mediadelivery.TrackMediaH\x03R\x05media\x88\x01\x01\"h\n" +
 	"\tTrackType\x12\x16\n" +
 	"\x12TRACK_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10TRACK_TYPE_VIDEO\x10\x01\x12\x14\n" +
 	"\x10TRACK_TYPE_AUDIO\x10\x02\x12\x17\n" +
 	"\x13TRACK_TYPE_SUBTITLE\x10\x03B\a\n" +
 	"\x05_nameB\v\n" +
-	"\t_language\"#\n" +
+	"\t_languageB\b\n" +
+	"\x06_matchB\b\n" +
+	"\x06_media\"\x8d\x02\n" +
+	"\rEmbeddedTrack\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.mediadelivery.Track.TrackTypeR\x04type\x12\x14\n" +
+	"\x05codec\x18\x02 \x01(\tR\x05codec\x12\x1f\n" +
+	"\blanguage\x18\x03 \x01(\tH\x00R\blanguage\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1a\n" +
+	"\bchannels\x18\x05 \x01(\rR\bchannels\x12#\n" +
+	"\rdefault_track\x18\x06 \x01(\bR\fdefaultTrack\x12!\n" +
+	"\fforced_track\x18\a \x01(\bR\vforcedTrackB\v\n" +
+	"\t_languageB\a\n" +
+	"\x05_name\"\xde\x01\n" +
+	"\n" +
+	"TrackMedia\x12\x14\n" +
+	"\x05codec\x18\x01 \x01(\tR\x05codec\x12\x1a\n" +
+	"\bchannels\x18\x02 \x01(\rR\bchannels\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x01R\x0fdurationSeconds\x12\x1f\n" +
+	"\blanguage\x18\x04 \x01(\tH\x00R\blanguage\x88\x01\x01\x12E\n" +
+	"\x0fembedded_tracks\x18\x05 \x03(\v2\x1c.mediadelivery.EmbeddedTrackR\x0eembeddedTracksB\v\n" +
+	"\t_language\"\x92\x01\n" +
+	"\n" +
+	"TrackMatch\x12#\n" +
+	"\rseason_number\x18\x01 \x01(\rR\fseasonNumber\x12%\n" +
+	"\x0eepisode_number\x18\x02 \x01(\rR\repisodeNumber\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\"#\n" +
 	"\vSearchQuery\x12\x14\n" +
-	"\x05Query\x18\x01 \x01(\tR\x05Query\"\xd6\x01\n" +
+	"\x05Query\x18\x01 \x01(\tR\x05Query\"\x81\x03\n" +
 	"\rTorrentSearch\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04href\x18\x02 \x01(\tR\x04href\x12\x1a\n" +
@@ -1415,17 +1945,29 @@ const file_media_delivery_tv_show_delivery_state_proto_rawDesc = "" +
 	"\aleeches\x18\x05 \x01(\x03R\aleeches\x12\x1c\n" +
 	"\tdownloads\x18\x06 \x01(\x03R\tdownloads\x12\x1d\n" +
 	"\n" +
-	"added_date\x18\a \x01(\tR\taddedDate\"\x9b\x01\n" +
+	"added_date\x18\a \x01(\tR\taddedDate\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x01R\x05score\x12#\n" +
+	"\rscore_reasons\x18\v \x03(\tR\fscoreReasons\x12'\n" +
+	"\x0fmatches_profile\x18\f \x01(\bR\x0ematchesProfile\x12-\n" +
+	"\x12profile_mismatches\x18\r \x03(\tR\x11profileMismatches\"\x9b\x01\n" +
 	"\vEpisodeInfo\x12#\n" +
 	"\rseason_number\x18\x01 \x01(\rR\fseasonNumber\x12%\n" +
 	"\x0eepisode_number\x18\x02 \x01(\rR\repisodeNumber\x12\x1b\n" +
 	"\tfull_path\x18\x03 \x01(\tR\bfullPath\x12#\n" +
-	"\rrelative_path\x18\x04 \x01(\tR\frelativePath\"\xdd\x01\n" +
+	"\rrelative_path\x18\x04 \x01(\tR\frelativePath\"\xa8\x03\n" +
 	"\fContentMatch\x124\n" +
 	"\aepisode\x18\x01 \x01(\v2\x1a.mediadelivery.EpisodeInfoR\aepisode\x12*\n" +
 	"\x05video\x18\x02 \x01(\v2\x14.mediadelivery.TrackR\x05video\x127\n" +
 	"\faudio_tracks\x18\x03 \x03(\v2\x14.mediadelivery.TrackR\vaudioTracks\x122\n" +
-	"\tsubtitles\x18\x04 \x03(\v2\x14.mediadelivery.TrackR\tsubtitles\"\xe5\x03\n" +
+	"\tsubtitles\x18\x04 \x03(\v2\x14.mediadelivery.TrackR\tsubtitles\x12?\n" +
+	"\bwarnings\x18\x05 \x03(\x0e2#.mediadelivery.ContentMatch.WarningR\bwarnings\"\x87\x01\n" +
+	"\aWarning\x12\x13\n" +
+	"\x0fWARNING_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19WARNING_DUPLICATE_EPISODE\x10\x01\x12\x1f\n" +
+	"\x1bWARNING_VIDEO_CLAIMED_TWICE\x10\x02\x12'\n" +
+	"#WARNING_AUDIO_TRACKS_COUNT_MISMATCH\x10\x03\"\xe5\x03\n" +
 	"\x0eContentMatches\x125\n" +
 	"\amatches\x18\x01 \x03(\v2\x1b.mediadelivery.ContentMatchR\amatches\x126\n" +
 	"\vunallocated\x18\x02 \x03(\v2\x14.mediadelivery.TrackR\vunallocated\x12?\n" +
@@ -1471,7 +2013,15 @@ const file_media_delivery_tv_show_delivery_state_proto_rawDesc = "" +
 	"\x18media_server_size_pretty\x18\x04 \x01(\tR\x15mediaServerSizePretty\x12?\n" +
 	"\x1dis_copy_files_in_media_server\x18\x05 \x01(\bR\x18isCopyFilesInMediaServer\"\x1d\n" +
 	"\aTorrent\x12\x12\n" +
-	"\x04href\x18\x01 \x01(\tR\x04href\"\xa9\x05\n" +
+	"\x04href\x18\x01 \x01(\tR\x04href\"\xf0\x01\n" +
+	"\fRetryAttempt\x125\n" +
+	"\x04step\x18\x01 \x01(\x0e2!.mediadelivery.TVShowDeliveryStepR\x04step\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"retried_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tretriedAt\x12\x17\n" +
+	"\x04href\x18\x04 \x01(\tH\x00R\x04href\x88\x01\x01\x126\n" +
+	"\x17content_matches_changed\x18\x05 \x01(\bR\x15contentMatchesChangedB\a\n" +
+	"\x05_href\"\x85\b\n" +
 	"\x12TVShowDeliveryData\x12B\n" +
 	"\fsearch_query\x18\x01 \x01(\v2\x1a.mediadelivery.SearchQueryH\x00R\vsearchQuery\x88\x01\x01\x12C\n" +
 	"\x0etorrent_search\x18\x02 \x03(\v2\x1c.mediadelivery.TorrentSearchR\rtorrentSearch\x12K\n" +
@@ -1479,14 +2029,22 @@ const file_media_delivery_tv_show_delivery_state_proto_rawDesc = "" +
 	"\x17torrent_download_status\x18\x04 \x01(\v2$.mediadelivery.TorrentDownloadStatusH\x02R\x15torrentDownloadStatus\x88\x01\x01\x12R\n" +
 	"\x12merge_video_status\x18\x05 \x01(\v2\x1f.mediadelivery.MergeVideoStatusH\x03R\x10mergeVideoStatus\x88\x01\x01\x12R\n" +
 	"\x14tv_show_catalog_info\x18\x06 \x01(\v2\x1c.mediadelivery.TVShowCatalogH\x04R\x11tvShowCatalogInfo\x88\x01\x01\x125\n" +
-	"\atorrent\x18\a \x01(\v2\x16.mediadelivery.TorrentH\x05R\atorrent\x88\x01\x01B\x0f\n" +
+	"\atorrent\x18\a \x01(\v2\x16.mediadelivery.TorrentH\x05R\atorrent\x88\x01\x01\x12K\n" +
+	"\x0fquality_profile\x18\b \x01(\v2\x1d.mediadelivery.QualityProfileH\x06R\x0equalityProfile\x88\x01\x01\x12@\n" +
+	"\rretry_history\x18\t \x03(\v2\x1b.mediadelivery.RetryAttemptR\fretryHistory\x12\x1a\n" +
+	"\brestored\x18\n" +
+	" \x01(\bR\brestored\x12>\n" +
+	"\x19pack_source_season_number\x18\v \x01(\rH\aR\x16packSourceSeasonNumber\x88\x01\x01\x12=\n" +
+	"\x1bfile_matches_auto_confirmed\x18\f \x01(\bR\x18fileMatchesAutoConfirmedB\x0f\n" +
 	"\r_search_queryB\x12\n" +
 	"\x10_content_matchesB\x1a\n" +
 	"\x18_torrent_download_statusB\x15\n" +
 	"\x13_merge_video_statusB\x17\n" +
 	"\x15_tv_show_catalog_infoB\n" +
 	"\n" +
-	"\b_torrent*\x8e\x04\n" +
+	"\b_torrentB\x12\n" +
+	"\x10_quality_profileB\x1c\n" +
+	"\x1a_pack_source_season_number*\xa3\x04\n" +
 	"\x12TVShowDeliveryStep\x12\x1d\n" +
 	"\x19TVShowDeliveryStepUnknown\x10\x00\x12\x17\n" +
 	"\x13GenerateSearchQuery\x10\x01\x12\x12\n" +
@@ -1507,7 +2065,8 @@ const file_media_delivery_tv_show_delivery_state_proto_rawDesc = "" +
 	"\x10SetMediaMetaData\x10\x10\x12\x1c\n" +
 	"\x18SendDeliveryNotification\x10\x11\x12\x17\n" +
 	"\x13WaitingTorrentFiles\x10\x12\x12\x13\n" +
-	"\x0fGetEpisodesData\x10\x13B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+	"\x0fGetEpisodesData\x10\x13\x12\x13\n" +
+	"\x0fProbeMediaFiles\x10\x14B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
 
 var (
 	file_media_delivery_tv_show_delivery_state_proto_rawDescOnce sync.Once
@@ -1521,62 +2080,78 @@ func file_media_delivery_tv_show_delivery_state_proto_rawDescGZIP() []byte {
 	return file_media_delivery_tv_show_delivery_state_proto_rawDescData
 }
 
-var file_media_delivery_tv_show_delivery_state_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_media_delivery_tv_show_delivery_state_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_media_delivery_tv_show_delivery_state_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_media_delivery_tv_show_delivery_state_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_media_delivery_tv_show_delivery_state_proto_goTypes = []any{
 	(TVShowDeliveryStep)(0),                 // 0: mediadelivery.TVShowDeliveryStep
 	(TVShowDeliveryError_ErrorType)(0),      // 1: mediadelivery.TVShowDeliveryError.ErrorType
 	(Track_TrackType)(0),                    // 2: mediadelivery.Track.TrackType
-	(TorrentDownloadStatus_TorrentState)(0), // 3: mediadelivery.TorrentDownloadStatus.TorrentState
-	(*TVShowDeliveryError)(nil),             // 4: mediadelivery.TVShowDeliveryError
-	(*TVShowDeliveryState)(nil),             // 5: mediadelivery.TVShowDeliveryState
-	(*Track)(nil),                           // 6: mediadelivery.Track
-	(*SearchQuery)(nil),                     // 7: mediadelivery.SearchQuery
-	(*TorrentSearch)(nil),                   // 8: mediadelivery.TorrentSearch
-	(*EpisodeInfo)(nil),                     // 9: mediadelivery.EpisodeInfo
-	(*ContentMatch)(nil),                    // 10: mediadelivery.ContentMatch
-	(*ContentMatches)(nil),                  // 11: mediadelivery.ContentMatches
-	(*ChoseFileMatchesOptionsRequest)(nil),  // 12: mediadelivery.ChoseFileMatchesOptionsRequest
-	(*TorrentDownloadStatus)(nil),           // 13: mediadelivery.TorrentDownloadStatus
-	(*MergeVideoStatus)(nil),                // 14: mediadelivery.MergeVideoStatus
-	(*TVShowCatalogPath)(nil),               // 15: mediadelivery.TVShowCatalogPath
-	(*TVShowCatalog)(nil),                   // 16: mediadelivery.TVShowCatalog
-	(*Torrent)(nil),                         // 17: mediadelivery.Torrent
-	(*TVShowDeliveryData)(nil),              // 18: mediadelivery.TVShowDeliveryData
-	(*ContentMatches_Options)(nil),          // 19: mediadelivery.ContentMatches.Options
-	(StateStatus)(0),                        // 20: mediadelivery.StateStatus
-	(*ContentID)(nil),                       // 21: mediadelivery.ContentID
+	(ContentMatch_Warning)(0),               // 3: mediadelivery.ContentMatch.Warning
+	(TorrentDownloadStatus_TorrentState)(0), // 4: mediadelivery.TorrentDownloadStatus.TorrentState
+	(*TVShowDeliveryError)(nil),             // 5: mediadelivery.TVShowDeliveryError
+	(*TVShowDeliveryState)(nil),             // 6: mediadelivery.TVShowDeliveryState
+	(*Track)(nil),                           // 7: mediadelivery.Track
+	(*EmbeddedTrack)(nil),                   // 8: mediadelivery.EmbeddedTrack
+	(*TrackMedia)(nil),                      // 9: mediadelivery.TrackMedia
+	(*TrackMatch)(nil),                      // 10: mediadelivery.TrackMatch
+	(*SearchQuery)(nil),                     // 11: mediadelivery.SearchQuery
+	(*TorrentSearch)(nil),                   // 12: mediadelivery.TorrentSearch
+	(*EpisodeInfo)(nil),                     // 13: mediadelivery.EpisodeInfo
+	(*ContentMatch)(nil),                    // 14: mediadelivery.ContentMatch
+	(*ContentMatches)(nil),                  // 15: mediadelivery.ContentMatches
+	(*ChoseFileMatchesOptionsRequest)(nil),  // 16: mediadelivery.ChoseFileMatchesOptionsRequest
+	(*TorrentDownloadStatus)(nil),           // 17: mediadelivery.TorrentDownloadStatus
+	(*MergeVideoStatus)(nil),                // 18: mediadelivery.MergeVideoStatus
+	(*TVShowCatalogPath)(nil),               // 19: mediadelivery.TVShowCatalogPath
+	(*TVShowCatalog)(nil),                   // 20: mediadelivery.TVShowCatalog
+	(*Torrent)(nil),                         // 21: mediadelivery.Torrent
+	(*RetryAttempt)(nil),                    // 22: mediadelivery.RetryAttempt
+	(*TVShowDeliveryData)(nil),              // 23: mediadelivery.TVShowDeliveryData
+	(*ContentMatches_Options)(nil),          // 24: mediadelivery.ContentMatches.Options
+	(StateStatus)(0),                        // 25: mediadelivery.StateStatus
+	(*ContentID)(nil),                       // 26: mediadelivery.ContentID
+	(*timestamppb.Timestamp)(nil),           // 27: google.protobuf.Timestamp
+	(*QualityProfile)(nil),                  // 28: mediadelivery.QualityProfile
 }
 var file_media_delivery_tv_show_delivery_state_proto_depIdxs = []int32{
 	1,  // 0: mediadelivery.TVShowDeliveryError.error_type:type_name -> mediadelivery.TVShowDeliveryError.ErrorType
-	18, // 1: mediadelivery.TVShowDeliveryState.data:type_name -> mediadelivery.TVShowDeliveryData
+	23, // 1: mediadelivery.TVShowDeliveryState.data:type_name -> mediadelivery.TVShowDeliveryData
 	0,  // 2: mediadelivery.TVShowDeliveryState.step:type_name -> mediadelivery.TVShowDeliveryStep
-	20, // 3: mediadelivery.TVShowDeliveryState.status:type_name -> mediadelivery.StateStatus
-	4,  // 4: mediadelivery.TVShowDeliveryState.error:type_name -> mediadelivery.TVShowDeliveryError
+	25, // 3: mediadelivery.TVShowDeliveryState.status:type_name -> mediadelivery.StateStatus
+	5,  // 4: mediadelivery.TVShowDeliveryState.error:type_name -> mediadelivery.TVShowDeliveryError
 	2,  // 5: mediadelivery.Track.type:type_name -> mediadelivery.Track.TrackType
-	9,  // 6: mediadelivery.ContentMatch.episode:type_name -> mediadelivery.EpisodeInfo
-	6,  // 7: mediadelivery.ContentMatch.video:type_name -> mediadelivery.Track
-	6,  // 8: mediadelivery.ContentMatch.audio_tracks:type_name -> mediadelivery.Track
-	6,  // 9: mediadelivery.ContentMatch.subtitles:type_name -> mediadelivery.Track
-	10, // 10: mediadelivery.ContentMatches.matches:type_name -> mediadelivery.ContentMatch
-	6,  // 11: mediadelivery.ContentMatches.unallocated:type_name -> mediadelivery.Track
-	19, // 12: mediadelivery.ContentMatches.options:type_name -> mediadelivery.ContentMatches.Options
-	21, // 13: mediadelivery.ChoseFileMatchesOptionsRequest.content_id:type_name -> mediadelivery.ContentID
-	11, // 14: mediadelivery.ChoseFileMatchesOptionsRequest.content_matches:type_name -> mediadelivery.ContentMatches
-	3,  // 15: mediadelivery.TorrentDownloadStatus.state:type_name -> mediadelivery.TorrentDownloadStatus.TorrentState
-	15, // 16: mediadelivery.TVShowCatalog.media_server_path:type_name -> mediadelivery.TVShowCatalogPath
-	7,  // 17: mediadelivery.TVShowDeliveryData.search_query:type_name -> mediadelivery.SearchQuery
-	8,  // 18: mediadelivery.TVShowDeliveryData.torrent_search:type_name -> mediadelivery.TorrentSearch
-	11, // 19: mediadelivery.TVShowDeliveryData.content_matches:type_name -> mediadelivery.ContentMatches
-	13, // 20: mediadelivery.TVShowDeliveryData.torrent_download_status:type_name -> mediadelivery.TorrentDownloadStatus
-	14, // 21: mediadelivery.TVShowDeliveryData.merge_video_status:type_name -> mediadelivery.MergeVideoStatus
-	16, // 22: mediadelivery.TVShowDeliveryData.tv_show_catalog_info:type_name -> mediadelivery.TVShowCatalog
-	17, // 23: mediadelivery.TVShowDeliveryData.torrent:type_name -> mediadelivery.Torrent
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	10, // 6: mediadelivery.Track.match:type_name -> mediadelivery.TrackMatch
+	9,  // 7: mediadelivery.Track.media:type_name -> mediadelivery.TrackMedia
+	2,  // 8: mediadelivery.EmbeddedTrack.type:type_name -> mediadelivery.Track.TrackType
+	8,  // 9: mediadelivery.TrackMedia.embedded_tracks:type_name -> mediadelivery.EmbeddedTrack
+	13, // 10: mediadelivery.ContentMatch.episode:type_name -> mediadelivery.EpisodeInfo
+	7,  // 11: mediadelivery.ContentMatch.video:type_name -> mediadelivery.Track
+	7,  // 12: mediadelivery.ContentMatch.audio_tracks:type_name -> mediadelivery.Track
+	7,  // 13: mediadelivery.ContentMatch.subtitles:type_name -> mediadelivery.Track
+	3,  // 14: mediadelivery.ContentMatch.warnings:type_name -> mediadelivery.ContentMatch.Warning
+	14, // 15: mediadelivery.ContentMatches.matches:type_name -> mediadelivery.ContentMatch
+	7,  // 16: mediadelivery.ContentMatches.unallocated:type_name -> mediadelivery.Track
+	24, // 17: mediadelivery.ContentMatches.options:type_name -> mediadelivery.ContentMatches.Options
+	26, // 18: mediadelivery.ChoseFileMatchesOptionsRequest.content_id:type_name -> mediadelivery.ContentID
+	15, // 19: mediadelivery.ChoseFileMatchesOptionsRequest.content_matches:type_name -> mediadelivery.ContentMatches
+	4,  // 20: mediadelivery.TorrentDownloadStatus.state:type_name -> mediadelivery.TorrentDownloadStatus.TorrentState
+	19, // 21: mediadelivery.TVShowCatalog.media_server_path:type_name -> mediadelivery.TVShowCatalogPath
+	0,  // 22: mediadelivery.RetryAttempt.step:type_name -> mediadelivery.TVShowDeliveryStep
+	27, // 23: mediadelivery.RetryAttempt.retried_at:type_name -> google.protobuf.Timestamp
+	11, // 24: mediadelivery.TVShowDeliveryData.search_query:type_name -> mediadelivery.SearchQuery
+	12, // 25: mediadelivery.TVShowDeliveryData.torrent_search:type_name -> mediadelivery.TorrentSearch
+	15, // 26: mediadelivery.TVShowDeliveryData.content_matches:type_name -> mediadelivery.ContentMatches
+	17, // 27: mediadelivery.TVShowDeliveryData.torrent_download_status:type_name -> mediadelivery.TorrentDownloadStatus
+	18, // 28: mediadelivery.TVShowDeliveryData.merge_video_status:type_name -> mediadelivery.MergeVideoStatus
+	20, // 29: mediadelivery.TVShowDeliveryData.tv_show_catalog_info:type_name -> mediadelivery.TVShowCatalog
+	21, // 30: mediadelivery.TVShowDeliveryData.torrent:type_name -> mediadelivery.Torrent
+	28, // 31: mediadelivery.TVShowDeliveryData.quality_profile:type_name -> mediadelivery.QualityProfile
+	22, // 32: mediadelivery.TVShowDeliveryData.retry_history:type_name -> mediadelivery.RetryAttempt
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_media_delivery_tv_show_delivery_state_proto_init() }
@@ -1585,18 +2160,22 @@ func file_media_delivery_tv_show_delivery_state_proto_init() {
 		return
 	}
 	file_media_delivery_common_model_proto_init()
+	file_media_delivery_video_content_model_proto_init()
 	file_media_delivery_tv_show_delivery_state_proto_msgTypes[1].OneofWrappers = []any{}
 	file_media_delivery_tv_show_delivery_state_proto_msgTypes[2].OneofWrappers = []any{}
-	file_media_delivery_tv_show_delivery_state_proto_msgTypes[8].OneofWrappers = []any{}
-	file_media_delivery_tv_show_delivery_state_proto_msgTypes[14].OneofWrappers = []any{}
-	file_media_delivery_tv_show_delivery_state_proto_msgTypes[15].OneofWrappers = []any{}
+	file_media_delivery_tv_show_delivery_state_proto_msgTypes[3].OneofWrappers = []any{}
+	file_media_delivery_tv_show_delivery_state_proto_msgTypes[4].OneofWrappers = []any{}
+	file_media_delivery_tv_show_delivery_state_proto_msgTypes[11].OneofWrappers = []any{}
+	file_media_delivery_tv_show_delivery_state_proto_msgTypes[17].OneofWrappers = []any{}
+	file_media_delivery_tv_show_delivery_state_proto_msgTypes[18].OneofWrappers = []any{}
+	file_media_delivery_tv_show_delivery_state_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_tv_show_delivery_state_proto_rawDesc), len(file_media_delivery_tv_show_delivery_state_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   16,
+			NumEnums:      5,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: media-delivery/tv-show-update-state.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TVShowUpdateStep int32
+
+const (
+	// Неизвестный шаг обновления
+	TVShowUpdateStep_TVShowUpdateStepUnknown TVShowUpdateStep = 0
+	// Начало обновления раздачи сезона
+	TVShowUpdateStep_StartUpdateTVShowSeason TVShowUpdateStep = 1
+	// Повторное получение магнет ссылки раздачи
+	TVShowUpdateStep_UpdateGetMagnetLink TVShowUpdateStep = 2
+	// Добавление обновленной раздачи в торрент клиент
+	TVShowUpdateStep_UpdateAddTorrentToTorrentClient TVShowUpdateStep = 3
+	// Удаление старой раздачи из торрент клиента (без файлов)
+	TVShowUpdateStep_UpdateDeleteOldTorrentFromTorrentClient TVShowUpdateStep = 4
+	// Ожидание получения файлов обновленной раздачи
+	TVShowUpdateStep_UpdateWaitingTorrentFiles TVShowUpdateStep = 5
+	// Получение информации об эпизодах сезона
+	TVShowUpdateStep_UpdateGetEpisodesData TVShowUpdateStep = 6
+	// Формирование метча файлов для новых эпизодов
+	TVShowUpdateStep_UpdatePrepareNewFileMatches TVShowUpdateStep = 7
+	// Ожидание окончания скачивания раздачи
+	TVShowUpdateStep_UpdateWaitingTorrentDownloadComplete TVShowUpdateStep = 8
+	// Определение необходимости конвертации файлов
+	TVShowUpdateStep_UpdateDeterminingNeedConvertFiles TVShowUpdateStep = 9
+	// Запуск обработки видеофайлов
+	TVShowUpdateStep_UpdateStartMergeVideoFiles TVShowUpdateStep = 10
+	// Ожидание окончания обработки видеофайлов
+	TVShowUpdateStep_UpdateWaitingMergeVideoFiles TVShowUpdateStep = 11
+	// Создание жестких ссылок на файлы новых эпизодов
+	TVShowUpdateStep_UpdateCreateHardLinkCopy TVShowUpdateStep = 12
+	// Установка метаданных на медиасервере
+	TVShowUpdateStep_UpdateSetMediaMetaData TVShowUpdateStep = 13
+	// Получение информации о дорожках скачанных файлов новых эпизодов
+	TVShowUpdateStep_UpdateProbeMediaFiles TVShowUpdateStep = 14
+)
+
+// Enum value maps for TVShowUpdateStep.
+var (
+	TVShowUpdateStep_name = map[int32]string{
+		0:  "TVShowUpdateStepUnknown",
+		1:  "StartUpdateTVShowSeason",
+		2:  "UpdateGetMagnetLink",
+		3:  "UpdateAddTorrentToTorrentClient",
+		4:  "UpdateDeleteOldTorrentFromTorrentClient",
+		5:  "UpdateWaitingTorrentFiles",
+		6:  "UpdateGetEpisodesData",
+		7:  "UpdatePrepareNewFileMatches",
+		8:  "UpdateWaitingTorrentDownloadComplete",
+		9:  "UpdateDeterminingNeedConvertFiles",
+		10: "UpdateStartMergeVideoFiles",
+		11: "UpdateWaitingMergeVideoFiles",
+		12: "UpdateCreateHardLinkCopy",
+		13: "UpdateSetMediaMetaData",
+		14: "UpdateProbeMediaFiles",
+	}
+	TVShowUpdateStep_value = map[string]int32{
+		"TVShowUpdateStepUnknown":                 0,
+		"StartUpdateTVShowSeason":                 1,
+		"UpdateGetMagnetLink":                     2,
+		"UpdateAddTorrentToTorrentClient":         3,
+		"UpdateDeleteOldTorrentFromTorrentClient": 4,
+		"UpdateWaitingTorrentFiles":               5,
+		"UpdateGetEpisodesData":                   6,
+		"UpdatePrepareNewFileMatches":             7,
+		"UpdateWaitingTorrentDownloadComplete":    8,
+		"UpdateDeterminingNeedConvertFiles":       9,
+		"UpdateStartMergeVideoFiles":              10,
+		"UpdateWaitingMergeVideoFiles":            11,
+		"UpdateCreateHardLinkCopy":                12,
+		"UpdateSetMediaMetaData":                  13,
+		"UpdateProbeMediaFiles":                   14,
+	}
+)
+
+func (x TVShowUpdateStep) Enum() *TVShowUpdateStep {
+	p := new(TVShowUpdateStep)
+	*p = x
+	return p
+}
+
+func (x TVShowUpdateStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TVShowUpdateStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_tv_show_update_state_proto_enumTypes[0].Descriptor()
+}
+
+func (TVShowUpdateStep) Type() protoreflect.EnumType {
+	return &file_media_delivery_tv_show_update_state_proto_enumTypes[0]
+}
+
+func (x TVShowUpdateStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TVShowUpdateStep.Descriptor instead.
+func (TVShowUpdateStep) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_update_state_proto_rawDescGZIP(), []int{0}
+}
+
+type TVShowUpdateError_ErrorType int32
+
+const (
+	TVShowUpdateError_TVShowUpdateError_Unknown TVShowUpdateError_ErrorType = 0
+)
+
+// Enum value maps for TVShowUpdateError_ErrorType.
+var (
+	TVShowUpdateError_ErrorType_name = map[int32]string{
+		0: "TVShowUpdateError_Unknown",
+	}
+	TVShowUpdateError_ErrorType_value = map[string]int32{
+		"TVShowUpdateError_Unknown": 0,
+	}
+)
+
+func (x TVShowUpdateError_ErrorType) Enum() *TVShowUpdateError_ErrorType {
+	p := new(TVShowUpdateError_ErrorType)
+	*p = x
+	return p
+}
+
+func (x TVShowUpdateError_ErrorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TVShowUpdateError_ErrorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_tv_show_update_state_proto_enumTypes[1].Descriptor()
+}
+
+func (TVShowUpdateError_ErrorType) Type() protoreflect.EnumType {
+	return &file_media_delivery_tv_show_update_state_proto_enumTypes[1]
+}
+
+func (x TVShowUpdateError_ErrorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TVShowUpdateError_ErrorType.Descriptor instead.
+func (TVShowUpdateError_ErrorType) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_update_state_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type TVShowUpdateError struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	RawError      string                      `protobuf:"bytes,1,opt,name=raw_error,json=rawError,proto3" json:"raw_error,omitempty"`
+	ErrorType     TVShowUpdateError_ErrorType `protobuf:"varint,2,opt,name=error_type,json=errorType,proto3,enum=mediadelivery.TVShowUpdateError_ErrorType" json:"error_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TVShowUpdateError) Reset() {
+	*x = TVShowUpdateError{}
+	mi := &file_media_delivery_tv_show_update_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TVShowUpdateError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TVShowUpdateError) ProtoMessage() {}
+
+func (x *TVShowUpdateError) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_update_state_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TVShowUpdateError.ProtoReflect.Descriptor instead.
+func (*TVShowUpdateError) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_update_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TVShowUpdateError) GetRawError() string {
+	if x != nil {
+		return x.RawError
+	}
+	return ""
+}
+
+func (x *TVShowUpdateError) GetErrorType() TVShowUpdateError_ErrorType {
+	if x != nil {
+		return x.ErrorType
+	}
+	return TVShowUpdateError_TVShowUpdateError_Unknown
+}
+
+type TVShowUpdateState struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Step   TVShowUpdateStep       `protobuf:"varint,1,opt,name=step,proto3,enum=mediadelivery.TVShowUpdateStep" json:"step,omitempty"`
+	Status StateStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=mediadelivery.StateStatus" json:"status,omitempty"`
+	Error  *TVShowUpdateError     `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Раздача перезалита с новой магнет ссылкой
+	TorrentChanged bool `protobuf:"varint,4,opt,name=torrent_changed,json=torrentChanged,proto3" json:"torrent_changed,omitempty"`
+	// Количество новых эпизодов, добавленных в каталог сезона
+	NewEpisodesCount int32 `protobuf:"varint,5,opt,name=new_episodes_count,json=newEpisodesCount,proto3" json:"new_episodes_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TVShowUpdateState) Reset() {
+	*x = TVShowUpdateState{}
+	mi := &file_media_delivery_tv_show_update_state_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TVShowUpdateState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TVShowUpdateState) ProtoMessage() {}
+
+func (x *TVShowUpdateState) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_tv_show_update_state_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TVShowUpdateState.ProtoReflect.Descriptor instead.
+func (*TVShowUpdateState) Descriptor() ([]byte, []int) {
+	return file_media_delivery_tv_show_update_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TVShowUpdateState) GetStep() TVShowUpdateStep {
+	if x != nil {
+		return x.Step
+	}
+	return TVShowUpdateStep_TVShowUpdateStepUnknown
+}
+
+func (x *TVShowUpdateState) GetStatus() StateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StateStatus_StatusUnknown
+}
+
+func (x *TVShowUpdateState) GetError() *TVShowUpdateError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *TVShowUpdateState) GetTorrentChanged() bool {
+	if x != nil {
+		return x.TorrentChanged
+	}
+	return false
+}
+
+func (x *TVShowUpdateState) GetNewEpisodesCount() int32 {
+	if x != nil {
+		return x.NewEpisodesCount
+	}
+	return 0
+}
+
+var File_media_delivery_tv_show_update_state_proto protoreflect.FileDescriptor
+
+const file_media_delivery_tv_show_update_state_proto_rawDesc = "" +
+	"\n" +
+	")media-delivery/tv-show-update-state.proto\x12\rmediadelivery\x1a!media-delivery/common-model.proto\"\xa7\x01\n" +
+	"\x11TVShowUpdateError\x12\x1b\n" +
+	"\traw_error\x18\x01 \x01(\tR\brawError\x12I\n" +
+	"\n" +
+	"error_type\x18\x02 \x01(\x0e2*.mediadelivery.TVShowUpdateError.ErrorTypeR\terrorType\"*\n" +
+	"\tErrorType\x12\x1d\n" +
+	"\x19TVShowUpdateError_Unknown\x10\x00\"\x9a\x02\n" +
+	"\x11TVShowUpdateState\x123\n" +
+	"\x04step\x18\x01 \x01(\x0e2\x1f.mediadelivery.TVShowUpdateStepR\x04step\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.mediadelivery.StateStatusR\x06status\x12;\n" +
+	"\x05error\x18\x03 \x01(\v2 .mediadelivery.TVShowUpdateErrorH\x00R\x05error\x88\x01\x01\x12'\n" +
+	"\x0ftorrent_changed\x18\x04 \x01(\bR\x0etorrentChanged\x12,\n" +
+	"\x12new_episodes_count\x18\x05 \x01(\x05R\x10newEpisodesCountB\b\n" +
+	"\x06_error*\xfa\x03\n" +
+	"\x10TVShowUpdateStep\x12\x1b\n" +
+	"\x17TVShowUpdateStepUnknown\x10\x00\x12\x1b\n" +
+	"\x17StartUpdateTVShowSeason\x10\x01\x12\x17\n" +
+	"\x13UpdateGetMagnetLink\x10\x02\x12#\n" +
+	"\x1fUpdateAddTorrentToTorrentClient\x10\x03\x12+\n" +
+	"'UpdateDeleteOldTorrentFromTorrentClient\x10\x04\x12\x1d\n" +
+	"\x19UpdateWaitingTorrentFiles\x10\x05\x12\x19\n" +
+	"\x15UpdateGetEpisodesData\x10\x06\x12\x1f\n" +
+	"\x1bUpdatePrepareNewFileMatches\x10\a\x12(\n" +
+	"$UpdateWaitingTorrentDownloadComplete\x10\b\x12%\n" +
+	"!UpdateDeterminingNeedConvertFiles\x10\t\x12\x1e\n" +
+	"\x1aUpdateStartMergeVideoFiles\x10\n" +
+	"\x12 \n" +
+	"\x1cUpdateWaitingMergeVideoFiles\x10\v\x12\x1c\n" +
+	"\x18UpdateCreateHardLinkCopy\x10\f\x12\x1a\n" +
+	"\x16UpdateSetMediaMetaData\x10\r\x12\x19\n" +
+	"\x15UpdateProbeMediaFiles\x10\x0eB'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+
+var (
+	file_media_delivery_tv_show_update_state_proto_rawDescOnce sync.Once
+	file_media_delivery_tv_show_update_state_proto_rawDescData []byte
+)
+
+func file_media_delivery_tv_show_update_state_proto_rawDescGZIP() []byte {
+	file_media_delivery_tv_show_update_state_proto_rawDescOnce.Do(func() {
+		file_media_delivery_tv_show_update_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_media_delivery_tv_show_update_state_proto_rawDesc), len(file_media_delivery_tv_show_update_state_proto_rawDesc)))
+	})
+	return file_media_delivery_tv_show_update_state_proto_rawDescData
+}
+
+var file_media_delivery_tv_show_update_state_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_media_delivery_tv_show_update_state_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_media_delivery_tv_show_update_state_proto_goTypes = []any{
+	(TVShowUpdateStep)(0),            // 0: mediadelivery.TVShowUpdateStep
+	(TVShowUpdateError_ErrorType)(0), // 1: mediadelivery.TVShowUpdateError.ErrorType
+	(*TVShowUpdateError)(nil),        // 2: mediadelivery.TVShowUpdateError
+	(*TVShowUpdateState)(nil),        // 3: mediadelivery.TVShowUpdateState
+	(StateStatus)(0),                 // 4: mediadelivery.StateStatus
+}
+var file_media_delivery_tv_show_update_state_proto_depIdxs = []int32{
+	1, // 0: mediadelivery.TVShowUpdateError.error_type:type_name -> mediadelivery.TVShowUpdateError.ErrorType
+	0, // 1: mediadelivery.TVShowUpdateState.step:type_name -> mediadelivery.TVShowUpdateStep
+	4, // 2: mediadelivery.TVShowUpdateState.status:type_name -> mediadelivery.StateStatus
+	2, // 3: mediadelivery.TVShowUpdateState.error:type_name -> mediadelivery.TVShowUpdateError
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_media_delivery_tv_show_update_state_proto_init() }
+func file_media_delivery_tv_show_update_state_proto_init() {
+	if File_media_delivery_tv_show_update_state_proto != nil {
+		return
+	}
+	file_media_delivery_common_model_proto_init()
+	file_media_delivery_tv_show_update_state_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_tv_show_update_state_proto_rawDesc), len(file_media_delivery_tv_show_update_state_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_media_delivery_tv_show_update_state_proto_goTypes,
+		DependencyIndexes: file_media_delivery_tv_show_update_state_proto_depIdxs,
+		EnumInfos:         file_media_delivery_tv_show_update_state_proto_enumTypes,
+		MessageInfos:      file_media_delivery_tv_show_update_state_proto_msgTypes,
+	}.Build()
+	File_media_delivery_tv_show_update_state_proto = out.File
+	file_media_delivery_tv_show_update_state_proto_goTypes = nil
+	file_media_delivery_tv_show_update_state_proto_depIdxs = nil
+}
@@ -37,6 +37,8 @@ const (
 	DeliveryStatus_DeliveryStatusUpdating   DeliveryStatus = 5
 	DeliveryStatus_DeliveryStatusDeleting   DeliveryStatus = 6
 	DeliveryStatus_DeliveryStatusDeleted    DeliveryStatus = 7
+	DeliveryStatus_DeliveryStatusCanceling  DeliveryStatus = 8
+	DeliveryStatus_DeliveryStatusCanceled   DeliveryStatus = 9
 )
 
 // Enum value maps for DeliveryStatus.
@@ -50,6 +52,8 @@ var (
 		5: "DeliveryStatusUpdating",
 		6: "DeliveryStatusDeleting",
 		7: "DeliveryStatusDeleted",
+		8: "DeliveryStatusCanceling",
+		9: "DeliveryStatusCanceled",
 	}
 	DeliveryStatus_value = map[string]int32{
 		"DeliveryStatusUnknown":    0,
@@ -60,6 +64,8 @@ var (
 		"DeliveryStatusUpdating":   5,
 		"DeliveryStatusDeleting":   6,
 		"DeliveryStatusDeleted":    7,
+		"DeliveryStatusCanceling":  8,
+		"DeliveryStatusCanceled":   9,
 	}
 )
 
@@ -90,13 +96,74 @@ func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
 	return file_media_delivery_video_content_model_proto_rawDescGZIP(), []int{0}
 }
 
+type SeasonCheckResult int32
+
+const (
+	SeasonCheckResult_SeasonCheckResultUnknown SeasonCheckResult = 0
+	// Все вышедшие эпизоды сезона доставлены
+	SeasonCheckResult_SeasonCheckResultUpToDate SeasonCheckResult = 1
+	// Эпизоды вышли, но раздача на трекере еще не обновлена
+	SeasonCheckResult_SeasonCheckResultWaitingTorrent SeasonCheckResult = 2
+	// Раздача обновлена, запущено обновление сезона
+	SeasonCheckResult_SeasonCheckResultUpdateStarted SeasonCheckResult = 3
+	// Проверку не удалось выполнить
+	SeasonCheckResult_SeasonCheckResultError SeasonCheckResult = 4
+)
+
+// Enum value maps for SeasonCheckResult.
+var (
+	SeasonCheckResult_name = map[int32]string{
+		0: "SeasonCheckResultUnknown",
+		1: "SeasonCheckResultUpToDate",
+		2: "SeasonCheckResultWaitingTorrent",
+		3: "SeasonCheckResultUpdateStarted",
+		4: "SeasonCheckResultError",
+	}
+	SeasonCheckResult_value = map[string]int32{
+		"SeasonCheckResultUnknown":        0,
+		"SeasonCheckResultUpToDate":       1,
+		"SeasonCheckResultWaitingTorrent": 2,
+		"SeasonCheckResultUpdateStarted":  3,
+		"SeasonCheckResultError":          4,
+	}
+)
+
+func (x SeasonCheckResult) Enum() *SeasonCheckResult {
+	p := new(SeasonCheckResult)
+	*p = x
+	return p
+}
+
+func (x SeasonCheckResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeasonCheckResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_delivery_video_content_model_proto_enumTypes[1].Descriptor()
+}
+
+func (SeasonCheckResult) Type() protoreflect.EnumType {
+	return &file_media_delivery_video_content_model_proto_enumTypes[1]
+}
+
+func (x SeasonCheckResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeasonCheckResult.Descriptor instead.
+func (SeasonCheckResult) EnumDescriptor() ([]byte, []int) {
+	return file_media_delivery_video_content_model_proto_rawDescGZIP(), []int{1}
+}
+
 type VideoContent struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	DeliveryStatus DeliveryStatus         `protobuf:"varint,3,opt,name=delivery_status,json=deliveryStatus,proto3,enum=mediadelivery.DeliveryStatus" json:"delivery_status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Версия видеоконтента (например 4K или озвучка), пустая у основного
+	Version       string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VideoContent) Reset() {
@@ -150,16 +217,269 @@ func (x *VideoContent) GetDeliveryStatus() DeliveryStatus {
 	return DeliveryStatus_DeliveryStatusUnknown
 }
 
+func (x *VideoContent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Результат последней проверки выхода новых эпизодов доставленного сезона
+type SeasonCheck struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	Result    SeasonCheckResult      `protobuf:"varint,2,opt,name=result,proto3,enum=mediadelivery.SeasonCheckResult" json:"result,omitempty"`
+	// Количество эпизодов в сезоне
+	EpisodesCount int32 `protobuf:"varint,3,opt,name=episodes_count,json=episodesCount,proto3" json:"episodes_count,omitempty"`
+	// Количество уже вышедших эпизодов
+	AiredEpisodesCount int32 `protobuf:"varint,4,opt,name=aired_episodes_count,json=airedEpisodesCount,proto3" json:"aired_episodes_count,omitempty"`
+	// Количество доставленных эпизодов
+	DeliveredEpisodesCount int32 `protobuf:"varint,5,opt,name=delivered_episodes_count,json=deliveredEpisodesCount,proto3" json:"delivered_episodes_count,omitempty"`
+	// Дата выхода следующего эпизода
+	NextEpisodeAirDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_episode_air_date,json=nextEpisodeAirDate,proto3,oneof" json:"next_episode_air_date,omitempty"`
+	Error              *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SeasonCheck) Reset() {
+	*x = SeasonCheck{}
+	mi := &file_media_delivery_video_content_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonCheck) ProtoMessage() {}
+
+func (x *SeasonCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_video_content_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonCheck.ProtoReflect.Descriptor instead.
+func (*SeasonCheck) Descriptor() ([]byte, []int) {
+	return file_media_delivery_video_content_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeasonCheck) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *SeasonCheck) GetResult() SeasonCheckResult {
+	if x != nil {
+		return x.Result
+	}
+	return SeasonCheckResult_SeasonCheckResultUnknown
+}
+
+func (x *SeasonCheck) GetEpisodesCount() int32 {
+	if x != nil {
+		return x.EpisodesCount
+	}
+	return 0
+}
+
+func (x *SeasonCheck) GetAiredEpisodesCount() int32 {
+	if x != nil {
+		return x.AiredEpisodesCount
+	}
+	return 0
+}
+
+func (x *SeasonCheck) GetDeliveredEpisodesCount() int32 {
+	if x != nil {
+		return x.DeliveredEpisodesCount
+	}
+	return 0
+}
+
+func (x *SeasonCheck) GetNextEpisodeAirDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextEpisodeAirDate
+	}
+	return nil
+}
+
+func (x *SeasonCheck) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// Профиль качества, по которому отбираются раздачи
+type QualityProfile struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Минимальное разрешение (высота кадра: 480, 720, 1080, 2160), 0 - без ограничений
+	MinResolution int32 `protobuf:"varint,5,opt,name=min_resolution,json=minResolution,proto3" json:"min_resolution,omitempty"`
+	// Максимальное разрешение, 0 - без ограничений
+	MaxResolution int32 `protobuf:"varint,6,opt,name=max_resolution,json=maxResolution,proto3" json:"max_resolution,omitempty"`
+	// Предпочитаемые кодеки (avc, hevc, av1, xvid)
+	PreferredCodecs []string `protobuf:"bytes,7,rep,name=preferred_codecs,json=preferredCodecs,proto3" json:"preferred_codecs,omitempty"`
+	// Запрещенные кодеки
+	ForbiddenCodecs []string `protobuf:"bytes,8,rep,name=forbidden_codecs,json=forbiddenCodecs,proto3" json:"forbidden_codecs,omitempty"`
+	// Языки или названия озвучек, которые должны быть в заголовке раздачи
+	RequiredAudio []string `protobuf:"bytes,9,rep,name=required_audio,json=requiredAudio,proto3" json:"required_audio,omitempty"`
+	// Максимальный размер одной серии в байтах, 0 - без ограничений
+	MaxSizePerEpisode uint64 `protobuf:"varint,10,opt,name=max_size_per_episode,json=maxSizePerEpisode,proto3" json:"max_size_per_episode,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QualityProfile) Reset() {
+	*x = QualityProfile{}
+	mi := &file_media_delivery_video_content_model_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityProfile) ProtoMessage() {}
+
+func (x *QualityProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_video_content_model_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityProfile.ProtoReflect.Descriptor instead.
+func (*QualityProfile) Descriptor() ([]byte, []int) {
+	return file_media_delivery_video_content_model_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QualityProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QualityProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QualityProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QualityProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *QualityProfile) GetMinResolution() int32 {
+	if x != nil {
+		return x.MinResolution
+	}
+	return 0
+}
+
+func (x *QualityProfile) GetMaxResolution() int32 {
+	if x != nil {
+		return x.MaxResolution
+	}
+	return 0
+}
+
+func (x *QualityProfile) GetPreferredCodecs() []string {
+	if x != nil {
+		return x.PreferredCodecs
+	}
+	return nil
+}
+
+func (x *QualityProfile) GetForbiddenCodecs() []string {
+	if x != nil {
+		return x.ForbiddenCodecs
+	}
+	return nil
+}
+
+func (x *QualityProfile) GetRequiredAudio() []string {
+	if x != nil {
+		return x.RequiredAudio
+	}
+	return nil
+}
+
+func (x *QualityProfile) GetMaxSizePerEpisode() uint64 {
+	if x != nil {
+		return x.MaxSizePerEpisode
+	}
+	return 0
+}
+
 var File_media_delivery_video_content_model_proto protoreflect.FileDescriptor
 
 const file_media_delivery_video_content_model_proto_rawDesc = "" +
 	"\n" +
-	"(media-delivery/video-content-model.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xa1\x01\n" +
+	"(media-delivery/video-content-model.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xbb\x01\n" +
 	"\fVideoContent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
-	"\x0fdelivery_status\x18\x03 \x01(\x0e2\x1d.mediadelivery.DeliveryStatusR\x0edeliveryStatus*\xea\x01\n" +
+	"\x0fdelivery_status\x18\x03 \x01(\x0e2\x1d.mediadelivery.DeliveryStatusR\x0edeliveryStatus\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\"\xa8\x03\n" +
+	"\vSeasonCheck\x129\n" +
+	"\n" +
+	"checked_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x128\n" +
+	"\x06result\x18\x02 \x01(\x0e2 .mediadelivery.SeasonCheckResultR\x06result\x12%\n" +
+	"\x0eepisodes_count\x18\x03 \x01(\x05R\repisodesCount\x120\n" +
+	"\x14aired_episodes_count\x18\x04 \x01(\x05R\x12airedEpisodesCount\x128\n" +
+	"\x18delivered_episodes_count\x18\x05 \x01(\x05R\x16deliveredEpisodesCount\x12R\n" +
+	"\x15next_episode_air_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x12nextEpisodeAirDate\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x01R\x05error\x88\x01\x01B\x18\n" +
+	"\x16_next_episode_air_dateB\b\n" +
+	"\x06_error\"\xa6\x03\n" +
+	"\x0eQualityProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0emin_resolution\x18\x05 \x01(\x05R\rminResolution\x12%\n" +
+	"\x0emax_resolution\x18\x06 \x01(\x05R\rmaxResolution\x12)\n" +
+	"\x10preferred_codecs\x18\a \x03(\tR\x0fpreferredCodecs\x12)\n" +
+	"\x10forbidden_codecs\x18\b \x03(\tR\x0fforbiddenCodecs\x12%\n" +
+	"\x0erequired_audio\x18\t \x03(\tR\rrequiredAudio\x12/\n" +
+	"\x14max_size_per_episode\x18\n" +
+	" \x01(\x04R\x11maxSizePerEpisode*\xa3\x02\n" +
 	"\x0eDeliveryStatus\x12\x19\n" +
 	"\x15DeliveryStatusUnknown\x10\x00\x12\x18\n" +
 	"\x14DeliveryStatusFailed\x10\x01\x12\x15\n" +
@@ -168,7 +488,15 @@ const file_media_delivery_video_content_model_proto_rawDesc = "" +
 	"\x17DeliveryStatusDelivered\x10\x04\x12\x1a\n" +
 	"\x16DeliveryStatusUpdating\x10\x05\x12\x1a\n" +
 	"\x16DeliveryStatusDeleting\x10\x06\x12\x19\n" +
-	"\x15DeliveryStatusDeleted\x10\aB'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+	"\x15DeliveryStatusDeleted\x10\a\x12\x1b\n" +
+	"\x17DeliveryStatusCanceling\x10\b\x12\x1a\n" +
+	"\x16DeliveryStatusCanceled\x10\t*\xb5\x01\n" +
+	"\x11SeasonCheckResult\x12\x1c\n" +
+	"\x18SeasonCheckResultUnknown\x10\x00\x12\x1d\n" +
+	"\x19SeasonCheckResultUpToDate\x10\x01\x12#\n" +
+	"\x1fSeasonCheckResultWaitingTorrent\x10\x02\x12\"\n" +
+	"\x1eSeasonCheckResultUpdateStarted\x10\x03\x12\x1a\n" +
+	"\x16SeasonCheckResultError\x10\x04B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
 
 var (
 	file_media_delivery_video_content_model_proto_rawDescOnce sync.Once
@@ -182,21 +510,29 @@ func file_media_delivery_video_content_model_proto_rawDescGZIP() []byte {
 	return file_media_delivery_video_content_model_proto_rawDescData
 }
 
-var file_media_delivery_video_content_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_media_delivery_video_content_model_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_media_delivery_video_content_model_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_media_delivery_video_content_model_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_media_delivery_video_content_model_proto_goTypes = []any{
 	(DeliveryStatus)(0),           // 0: mediadelivery.DeliveryStatus
-	(*VideoContent)(nil),          // 1: mediadelivery.VideoContent
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(SeasonCheckResult)(0),        // 1: mediadelivery.SeasonCheckResult
+	(*VideoContent)(nil),          // 2: mediadelivery.VideoContent
+	(*SeasonCheck)(nil),           // 3: mediadelivery.SeasonCheck
+	(*QualityProfile)(nil),        // 4: mediadelivery.QualityProfile
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_media_delivery_video_content_model_proto_depIdxs = []int32{
-	2, // 0: mediadelivery.VideoContent.created_at:type_name -> google.protobuf.Timestamp
+	5, // 0: mediadelivery.VideoContent.created_at:type_name -> google.protobuf.Timestamp
 	0, // 1: mediadelivery.VideoContent.delivery_status:type_name -> mediadelivery.DeliveryStatus
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: mediadelivery.SeasonCheck.checked_at:type_name -> google.protobuf.Timestamp
+	1, // 3: mediadelivery.SeasonCheck.result:type_name -> mediadelivery.SeasonCheckResult
+	5, // 4: mediadelivery.SeasonCheck.next_episode_air_date:type_name -> google.protobuf.Timestamp
+	5, // 5: mediadelivery.QualityProfile.created_at:type_name -> google.protobuf.Timestamp
+	5, // 6: mediadelivery.QualityProfile.updated_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_media_delivery_video_content_model_proto_init() }
@@ -204,13 +540,14 @@ func file_media_delivery_video_content_model_proto_init() {
 	if File_media_delivery_video_content_model_proto != nil {
 		return
 	}
+	file_media_delivery_video_content_model_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_video_content_model_proto_rawDesc), len(file_media_delivery_video_content_model_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,7 +15,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/emptypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,8 +27,10 @@ const (
 )
 
 type CreateVideoContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Версия видеоконтента, обязательна если у фильма/сезона уже есть видеоконтент
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -70,6 +72,13 @@ func (x *CreateVideoContentRequest) GetContentId() *ContentID {
 	return nil
 }
 
+func (x *CreateVideoContentRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 type CreateVideoContentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        *VideoContent          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -202,16 +211,111 @@ func (x *GetVideoContentResponse) GetItems() []*VideoContent {
 	return nil
 }
 
-type CreateDeliveryStateRequest struct {
+type GetSeasonCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *GetSeasonCheckRequest) Reset() {
+	*x = GetSeasonCheckRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonCheckRequest) ProtoMessage() {}
+
+func (x *GetSeasonCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonCheckRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonCheckRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSeasonCheckRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type GetSeasonCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *SeasonCheck           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonCheckResponse) Reset() {
+	*x = GetSeasonCheckResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonCheckResponse) ProtoMessage() {}
+
+func (x *GetSeasonCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonCheckResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonCheckResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSeasonCheckResponse) GetResult() *SeasonCheck {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateDeliveryStateRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Профиль качества, по которому отбираются раздачи
+	QualityProfileId *string `protobuf:"bytes,2,opt,name=quality_profile_id,json=qualityProfileId,proto3,oneof" json:"quality_profile_id,omitempty"`
+	// Восстановление удаленного сезона по раздаче и метчу файлов предыдущей доставки
+	Restore bool `protobuf:"varint,3,opt,name=restore,proto3" json:"restore,omitempty"`
+	// Сезон того же сериала, доставка которого уже выбрала раздачу с несколькими сезонами.
+	// Раздача скачивается один раз, из нее берутся только файлы доставляемого сезона
+	PackSource    *ContentID `protobuf:"bytes,4,opt,name=pack_source,json=packSource,proto3,oneof" json:"pack_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
 func (x *CreateDeliveryStateRequest) Reset() {
 	*x = CreateDeliveryStateRequest{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[4]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +327,7 @@ func (x *CreateDeliveryStateRequest) String() string {
 func (*CreateDeliveryStateRequest) ProtoMessage() {}
 
 func (x *CreateDeliveryStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[4]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +340,7 @@ func (x *CreateDeliveryStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDeliveryStateRequest.ProtoReflect.Descriptor instead.
 func (*CreateDeliveryStateRequest) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{4}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateDeliveryStateRequest) GetContentId() *ContentID {
@@ -246,6 +350,27 @@ func (x *CreateDeliveryStateRequest) GetContentId() *ContentID {
 	return nil
 }
 
+func (x *CreateDeliveryStateRequest) GetQualityProfileId() string {
+	if x != nil && x.QualityProfileId != nil {
+		return *x.QualityProfileId
+	}
+	return ""
+}
+
+func (x *CreateDeliveryStateRequest) GetRestore() bool {
+	if x != nil {
+		return x.Restore
+	}
+	return false
+}
+
+func (x *CreateDeliveryStateRequest) GetPackSource() *ContentID {
+	if x != nil {
+		return x.PackSource
+	}
+	return nil
+}
+
 type CreateDeliveryStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        *TVShowDeliveryState   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -255,7 +380,7 @@ type CreateDeliveryStateResponse struct {
 
 func (x *CreateDeliveryStateResponse) Reset() {
 	*x = CreateDeliveryStateResponse{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[5]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +392,7 @@ func (x *CreateDeliveryStateResponse) String() string {
 func (*CreateDeliveryStateResponse) ProtoMessage() {}
 
 func (x *CreateDeliveryStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[5]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +405,7 @@ func (x *CreateDeliveryStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDeliveryStateResponse.ProtoReflect.Descriptor instead.
 func (*CreateDeliveryStateResponse) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{5}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateDeliveryStateResponse) GetResult() *TVShowDeliveryState {
@@ -299,7 +424,7 @@ type GetDeliveryDataRequest struct {
 
 func (x *GetDeliveryDataRequest) Reset() {
 	*x = GetDeliveryDataRequest{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[6]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +436,7 @@ func (x *GetDeliveryDataRequest) String() string {
 func (*GetDeliveryDataRequest) ProtoMessage() {}
 
 func (x *GetDeliveryDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[6]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +449,7 @@ func (x *GetDeliveryDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeliveryDataRequest.ProtoReflect.Descriptor instead.
 func (*GetDeliveryDataRequest) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{6}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetDeliveryDataRequest) GetContentId() *ContentID {
@@ -343,7 +468,7 @@ type GetDeliveryDataResponse struct {
 
 func (x *GetDeliveryDataResponse) Reset() {
 	*x = GetDeliveryDataResponse{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[7]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +480,7 @@ func (x *GetDeliveryDataResponse) String() string {
 func (*GetDeliveryDataResponse) ProtoMessage() {}
 
 func (x *GetDeliveryDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[7]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +493,7 @@ func (x *GetDeliveryDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeliveryDataResponse.ProtoReflect.Descriptor instead.
 func (*GetDeliveryDataResponse) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{7}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetDeliveryDataResponse) GetResult() *TVShowDeliveryState {
@@ -391,7 +516,7 @@ type ChoseTorrentOptionsRequest struct {
 
 func (x *ChoseTorrentOptionsRequest) Reset() {
 	*x = ChoseTorrentOptionsRequest{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[8]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +528,7 @@ func (x *ChoseTorrentOptionsRequest) String() string {
 func (*ChoseTorrentOptionsRequest) ProtoMessage() {}
 
 func (x *ChoseTorrentOptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[8]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +541,7 @@ func (x *ChoseTorrentOptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChoseTorrentOptionsRequest.ProtoReflect.Descriptor instead.
 func (*ChoseTorrentOptionsRequest) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{8}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ChoseTorrentOptionsRequest) GetContentId() *ContentID {
@@ -449,7 +574,7 @@ type ChoseTorrentOptionsResponse struct {
 
 func (x *ChoseTorrentOptionsResponse) Reset() {
 	*x = ChoseTorrentOptionsResponse{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[9]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +586,7 @@ func (x *ChoseTorrentOptionsResponse) String() string {
 func (*ChoseTorrentOptionsResponse) ProtoMessage() {}
 
 func (x *ChoseTorrentOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[9]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +599,7 @@ func (x *ChoseTorrentOptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChoseTorrentOptionsResponse.ProtoReflect.Descriptor instead.
 func (*ChoseTorrentOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{9}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChoseTorrentOptionsResponse) GetResult() *TVShowDeliveryState {
@@ -493,7 +618,7 @@ type ChoseFileMatchesOptionsResponse struct {
 
 func (x *ChoseFileMatchesOptionsResponse) Reset() {
 	*x = ChoseFileMatchesOptionsResponse{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[10]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +630,7 @@ func (x *ChoseFileMatchesOptionsResponse) String() string {
 func (*ChoseFileMatchesOptionsResponse) ProtoMessage() {}
 
 func (x *ChoseFileMatchesOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[10]
+	mi := &file_media_delivery_videocontent_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +643,7 @@ func (x *ChoseFileMatchesOptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChoseFileMatchesOptionsResponse.ProtoReflect.Descriptor instead.
 func (*ChoseFileMatchesOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{10}
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ChoseFileMatchesOptionsResponse) GetResult() *TVShowDeliveryState {
@@ -528,28 +653,28 @@ func (x *ChoseFileMatchesOptionsResponse) GetResult() *TVShowDeliveryState {
 	return nil
 }
 
-type CreateDeleteStateRequest struct {
+type CreateMovieDeliveryStateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDeleteStateRequest) Reset() {
-	*x = CreateDeleteStateRequest{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[11]
+func (x *CreateMovieDeliveryStateRequest) Reset() {
+	*x = CreateMovieDeliveryStateRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDeleteStateRequest) String() string {
+func (x *CreateMovieDeliveryStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeleteStateRequest) ProtoMessage() {}
+func (*CreateMovieDeliveryStateRequest) ProtoMessage() {}
 
-func (x *CreateDeleteStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[11]
+func (x *CreateMovieDeliveryStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,40 +685,40 @@ func (x *CreateDeleteStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeleteStateRequest.ProtoReflect.Descriptor instead.
-func (*CreateDeleteStateRequest) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateMovieDeliveryStateRequest.ProtoReflect.Descriptor instead.
+func (*CreateMovieDeliveryStateRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateDeleteStateRequest) GetContentId() *ContentID {
+func (x *CreateMovieDeliveryStateRequest) GetContentId() *ContentID {
 	if x != nil {
 		return x.ContentId
 	}
 	return nil
 }
 
-type CreateDeleteStateResponse struct {
+type CreateMovieDeliveryStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *TVShowDeleteState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Result        *MovieDeliveryState    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDeleteStateResponse) Reset() {
-	*x = CreateDeleteStateResponse{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[12]
+func (x *CreateMovieDeliveryStateResponse) Reset() {
+	*x = CreateMovieDeliveryStateResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDeleteStateResponse) String() string {
+func (x *CreateMovieDeliveryStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDeleteStateResponse) ProtoMessage() {}
+func (*CreateMovieDeliveryStateResponse) ProtoMessage() {}
 
-func (x *CreateDeleteStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[12]
+func (x *CreateMovieDeliveryStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,40 +729,40 @@ func (x *CreateDeleteStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDeleteStateResponse.ProtoReflect.Descriptor instead.
-func (*CreateDeleteStateResponse) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateMovieDeliveryStateResponse.ProtoReflect.Descriptor instead.
+func (*CreateMovieDeliveryStateResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateDeleteStateResponse) GetResult() *TVShowDeleteState {
+func (x *CreateMovieDeliveryStateResponse) GetResult() *MovieDeliveryState {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type GetDeleteDataRequest struct {
+type GetMovieDeliveryDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeleteDataRequest) Reset() {
-	*x = GetDeleteDataRequest{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[13]
+func (x *GetMovieDeliveryDataRequest) Reset() {
+	*x = GetMovieDeliveryDataRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeleteDataRequest) String() string {
+func (x *GetMovieDeliveryDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeleteDataRequest) ProtoMessage() {}
+func (*GetMovieDeliveryDataRequest) ProtoMessage() {}
 
-func (x *GetDeleteDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[13]
+func (x *GetMovieDeliveryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,40 +773,40 @@ func (x *GetDeleteDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeleteDataRequest.ProtoReflect.Descriptor instead.
-func (*GetDeleteDataRequest) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetMovieDeliveryDataRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieDeliveryDataRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetDeleteDataRequest) GetContentId() *ContentID {
+func (x *GetMovieDeliveryDataRequest) GetContentId() *ContentID {
 	if x != nil {
 		return x.ContentId
 	}
 	return nil
 }
 
-type GetDeleteDataResponse struct {
+type GetMovieDeliveryDataResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *TVShowDeleteState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Result        *MovieDeliveryState    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeleteDataResponse) Reset() {
-	*x = GetDeleteDataResponse{}
-	mi := &file_media_delivery_videocontent_proto_msgTypes[14]
+func (x *GetMovieDeliveryDataResponse) Reset() {
+	*x = GetMovieDeliveryDataResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeleteDataResponse) String() string {
+func (x *GetMovieDeliveryDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeleteDataResponse) ProtoMessage() {}
+func (*GetMovieDeliveryDataResponse) ProtoMessage() {}
 
-func (x *GetDeleteDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_delivery_videocontent_proto_msgTypes[14]
+func (x *GetMovieDeliveryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,73 +817,1694 @@ func (x *GetDeleteDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeleteDataResponse.ProtoReflect.Descriptor instead.
-func (*GetDeleteDataResponse) Descriptor() ([]byte, []int) {
-	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetMovieDeliveryDataResponse.ProtoReflect.Descriptor instead.
+func (*GetMovieDeliveryDataResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetDeleteDataResponse) GetResult() *TVShowDeleteState {
+func (x *GetMovieDeliveryDataResponse) GetResult() *MovieDeliveryState {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-var File_media_delivery_videocontent_proto protoreflect.FileDescriptor
+type ChoseMovieTorrentOptionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Пользователь выбрал конкретный торрента файл
+	Href *string `protobuf:"bytes,2,opt,name=href,proto3,oneof" json:"href,omitempty"`
+	// Пользователь поменял поисковый запрос
+	NewSearchQuery *string `protobuf:"bytes,3,opt,name=new_search_query,json=newSearchQuery,proto3,oneof" json:"new_search_query,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_media_delivery_videocontent_proto_rawDesc = "" +
-	"\n" +
-	"!media-delivery/videocontent.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a!media-delivery/common-model.proto\x1a(media-delivery/video-content-model.proto\x1a+media-delivery/tv-show-delivery-state.proto\x1a)media-delivery/tv-show-delete-state.proto\"T\n" +
-	"\x19CreateVideoContentRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Q\n" +
-	"\x1aCreateVideoContentResponse\x123\n" +
-	"\x06result\x18\x01 \x01(\v2\x1b.mediadelivery.VideoContentR\x06result\"Q\n" +
-	"\x16GetVideoContentRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"L\n" +
-	"\x17GetVideoContentResponse\x121\n" +
-	"\x05items\x18\x01 \x03(\v2\x1b.mediadelivery.VideoContentR\x05items\"U\n" +
-	"\x1aCreateDeliveryStateRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Y\n" +
-	"\x1bCreateDeliveryStateResponse\x12:\n" +
-	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"Q\n" +
-	"\x16GetDeliveryDataRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"U\n" +
-	"\x17GetDeliveryDataResponse\x12:\n" +
-	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"\xbb\x01\n" +
-	"\x1aChoseTorrentOptionsRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x12\x17\n" +
-	"\x04href\x18\x02 \x01(\tH\x00R\x04href\x88\x01\x01\x12-\n" +
-	"\x10new_search_query\x18\x03 \x01(\tH\x01R\x0enewSearchQuery\x88\x01\x01B\a\n" +
-	"\x05_hrefB\x13\n" +
-	"\x11_new_search_query\"Y\n" +
-	"\x1bChoseTorrentOptionsResponse\x12:\n" +
-	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"]\n" +
-	"\x1fChoseFileMatchesOptionsResponse\x12:\n" +
-	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"S\n" +
-	"\x18CreateDeleteStateRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"U\n" +
-	"\x19CreateDeleteStateResponse\x128\n" +
-	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowDeleteStateR\x06result\"O\n" +
-	"\x14GetDeleteDataRequest\x127\n" +
-	"\n" +
-	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Q\n" +
-	"\x15GetDeleteDataResponse\x128\n" +
-	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowDeleteStateR\x06result2\xbb\r\n" +
+func (x *ChoseMovieTorrentOptionsRequest) Reset() {
+	*x = ChoseMovieTorrentOptionsRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoseMovieTorrentOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoseMovieTorrentOptionsRequest) ProtoMessage() {}
+
+func (x *ChoseMovieTorrentOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoseMovieTorrentOptionsRequest.ProtoReflect.Descriptor instead.
+func (*ChoseMovieTorrentOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChoseMovieTorrentOptionsRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+func (x *ChoseMovieTorrentOptionsRequest) GetHref() string {
+	if x != nil && x.Href != nil {
+		return *x.Href
+	}
+	return ""
+}
+
+func (x *ChoseMovieTorrentOptionsRequest) GetNewSearchQuery() string {
+	if x != nil && x.NewSearchQuery != nil {
+		return *x.NewSearchQuery
+	}
+	return ""
+}
+
+type ChoseMovieTorrentOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *MovieDeliveryState    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoseMovieTorrentOptionsResponse) Reset() {
+	*x = ChoseMovieTorrentOptionsResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoseMovieTorrentOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoseMovieTorrentOptionsResponse) ProtoMessage() {}
+
+func (x *ChoseMovieTorrentOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoseMovieTorrentOptionsResponse.ProtoReflect.Descriptor instead.
+func (*ChoseMovieTorrentOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChoseMovieTorrentOptionsResponse) GetResult() *MovieDeliveryState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RetryMovieDeliveryStepRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Новая раздача, если нужно заменить (до добавления раздачи в торрент клиент)
+	Href          *string `protobuf:"bytes,2,opt,name=href,proto3,oneof" json:"href,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryMovieDeliveryStepRequest) Reset() {
+	*x = RetryMovieDeliveryStepRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryMovieDeliveryStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryMovieDeliveryStepRequest) ProtoMessage() {}
+
+func (x *RetryMovieDeliveryStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryMovieDeliveryStepRequest.ProtoReflect.Descriptor instead.
+func (*RetryMovieDeliveryStepRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RetryMovieDeliveryStepRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+func (x *RetryMovieDeliveryStepRequest) GetHref() string {
+	if x != nil && x.Href != nil {
+		return *x.Href
+	}
+	return ""
+}
+
+type RetryMovieDeliveryStepResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *MovieDeliveryState    `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryMovieDeliveryStepResponse) Reset() {
+	*x = RetryMovieDeliveryStepResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryMovieDeliveryStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryMovieDeliveryStepResponse) ProtoMessage() {}
+
+func (x *RetryMovieDeliveryStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryMovieDeliveryStepResponse.ProtoReflect.Descriptor instead.
+func (*RetryMovieDeliveryStepResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RetryMovieDeliveryStepResponse) GetResult() *MovieDeliveryState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RetryDeliveryStepRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Новая раздача, если нужно заменить (до добавления раздачи в торрент клиент)
+	Href *string `protobuf:"bytes,2,opt,name=href,proto3,oneof" json:"href,omitempty"`
+	// Новый метч файлов, если нужно заменить (до размещения файлов на медиасервере)
+	ContentMatches *ContentMatches `protobuf:"bytes,3,opt,name=content_matches,json=contentMatches,proto3,oneof" json:"content_matches,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RetryDeliveryStepRequest) Reset() {
+	*x = RetryDeliveryStepRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDeliveryStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeliveryStepRequest) ProtoMessage() {}
+
+func (x *RetryDeliveryStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeliveryStepRequest.ProtoReflect.Descriptor instead.
+func (*RetryDeliveryStepRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RetryDeliveryStepRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+func (x *RetryDeliveryStepRequest) GetHref() string {
+	if x != nil && x.Href != nil {
+		return *x.Href
+	}
+	return ""
+}
+
+func (x *RetryDeliveryStepRequest) GetContentMatches() *ContentMatches {
+	if x != nil {
+		return x.ContentMatches
+	}
+	return nil
+}
+
+type RetryDeliveryStepResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowDeliveryState   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryDeliveryStepResponse) Reset() {
+	*x = RetryDeliveryStepResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryDeliveryStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeliveryStepResponse) ProtoMessage() {}
+
+func (x *RetryDeliveryStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeliveryStepResponse.ProtoReflect.Descriptor instead.
+func (*RetryDeliveryStepResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RetryDeliveryStepResponse) GetResult() *TVShowDeliveryState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CancelDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDeliveryRequest) Reset() {
+	*x = CancelDeliveryRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeliveryRequest) ProtoMessage() {}
+
+func (x *CancelDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*CancelDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelDeliveryRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type CancelDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowCancelState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDeliveryResponse) Reset() {
+	*x = CancelDeliveryResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeliveryResponse) ProtoMessage() {}
+
+func (x *CancelDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*CancelDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelDeliveryResponse) GetResult() *TVShowCancelState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetCancelDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancelDataRequest) Reset() {
+	*x = GetCancelDataRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancelDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancelDataRequest) ProtoMessage() {}
+
+func (x *GetCancelDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancelDataRequest.ProtoReflect.Descriptor instead.
+func (*GetCancelDataRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCancelDataRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type GetCancelDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowCancelState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancelDataResponse) Reset() {
+	*x = GetCancelDataResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancelDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancelDataResponse) ProtoMessage() {}
+
+func (x *GetCancelDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancelDataResponse.ProtoReflect.Descriptor instead.
+func (*GetCancelDataResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCancelDataResponse) GetResult() *TVShowCancelState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateUpdateStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUpdateStateRequest) Reset() {
+	*x = CreateUpdateStateRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUpdateStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUpdateStateRequest) ProtoMessage() {}
+
+func (x *CreateUpdateStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUpdateStateRequest.ProtoReflect.Descriptor instead.
+func (*CreateUpdateStateRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateUpdateStateRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type CreateUpdateStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowUpdateState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUpdateStateResponse) Reset() {
+	*x = CreateUpdateStateResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUpdateStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUpdateStateResponse) ProtoMessage() {}
+
+func (x *CreateUpdateStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUpdateStateResponse.ProtoReflect.Descriptor instead.
+func (*CreateUpdateStateResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateUpdateStateResponse) GetResult() *TVShowUpdateState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetUpdateDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateDataRequest) Reset() {
+	*x = GetUpdateDataRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateDataRequest) ProtoMessage() {}
+
+func (x *GetUpdateDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateDataRequest.ProtoReflect.Descriptor instead.
+func (*GetUpdateDataRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUpdateDataRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type GetUpdateDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowUpdateState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateDataResponse) Reset() {
+	*x = GetUpdateDataResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateDataResponse) ProtoMessage() {}
+
+func (x *GetUpdateDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateDataResponse.ProtoReflect.Descriptor instead.
+func (*GetUpdateDataResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUpdateDataResponse) GetResult() *TVShowUpdateState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateDeleteStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeleteStateRequest) Reset() {
+	*x = CreateDeleteStateRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeleteStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeleteStateRequest) ProtoMessage() {}
+
+func (x *CreateDeleteStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeleteStateRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeleteStateRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateDeleteStateRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type CreateDeleteStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowDeleteState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeleteStateResponse) Reset() {
+	*x = CreateDeleteStateResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeleteStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeleteStateResponse) ProtoMessage() {}
+
+func (x *CreateDeleteStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeleteStateResponse.ProtoReflect.Descriptor instead.
+func (*CreateDeleteStateResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateDeleteStateResponse) GetResult() *TVShowDeleteState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetDeleteDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeleteDataRequest) Reset() {
+	*x = GetDeleteDataRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeleteDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeleteDataRequest) ProtoMessage() {}
+
+func (x *GetDeleteDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeleteDataRequest.ProtoReflect.Descriptor instead.
+func (*GetDeleteDataRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetDeleteDataRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type GetDeleteDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *TVShowDeleteState     `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeleteDataResponse) Reset() {
+	*x = GetDeleteDataResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeleteDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeleteDataResponse) ProtoMessage() {}
+
+func (x *GetDeleteDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeleteDataResponse.ProtoReflect.Descriptor instead.
+func (*GetDeleteDataResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDeleteDataResponse) GetResult() *TVShowDeleteState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateMovieDeleteStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieDeleteStateRequest) Reset() {
+	*x = CreateMovieDeleteStateRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieDeleteStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieDeleteStateRequest) ProtoMessage() {}
+
+func (x *CreateMovieDeleteStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieDeleteStateRequest.ProtoReflect.Descriptor instead.
+func (*CreateMovieDeleteStateRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateMovieDeleteStateRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type CreateMovieDeleteStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *MovieDeleteState      `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMovieDeleteStateResponse) Reset() {
+	*x = CreateMovieDeleteStateResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMovieDeleteStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMovieDeleteStateResponse) ProtoMessage() {}
+
+func (x *CreateMovieDeleteStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMovieDeleteStateResponse.ProtoReflect.Descriptor instead.
+func (*CreateMovieDeleteStateResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateMovieDeleteStateResponse) GetResult() *MovieDeleteState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetMovieDeleteDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     *ContentID             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieDeleteDataRequest) Reset() {
+	*x = GetMovieDeleteDataRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieDeleteDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieDeleteDataRequest) ProtoMessage() {}
+
+func (x *GetMovieDeleteDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieDeleteDataRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieDeleteDataRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetMovieDeleteDataRequest) GetContentId() *ContentID {
+	if x != nil {
+		return x.ContentId
+	}
+	return nil
+}
+
+type GetMovieDeleteDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *MovieDeleteState      `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieDeleteDataResponse) Reset() {
+	*x = GetMovieDeleteDataResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieDeleteDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieDeleteDataResponse) ProtoMessage() {}
+
+func (x *GetMovieDeleteDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieDeleteDataResponse.ProtoReflect.Descriptor instead.
+func (*GetMovieDeleteDataResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMovieDeleteDataResponse) GetResult() *MovieDeleteState {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type QualityProfileParams struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MinResolution     int32                  `protobuf:"varint,2,opt,name=min_resolution,json=minResolution,proto3" json:"min_resolution,omitempty"`
+	MaxResolution     int32                  `protobuf:"varint,3,opt,name=max_resolution,json=maxResolution,proto3" json:"max_resolution,omitempty"`
+	PreferredCodecs   []string               `protobuf:"bytes,4,rep,name=preferred_codecs,json=preferredCodecs,proto3" json:"preferred_codecs,omitempty"`
+	ForbiddenCodecs   []string               `protobuf:"bytes,5,rep,name=forbidden_codecs,json=forbiddenCodecs,proto3" json:"forbidden_codecs,omitempty"`
+	RequiredAudio     []string               `protobuf:"bytes,6,rep,name=required_audio,json=requiredAudio,proto3" json:"required_audio,omitempty"`
+	MaxSizePerEpisode uint64                 `protobuf:"varint,7,opt,name=max_size_per_episode,json=maxSizePerEpisode,proto3" json:"max_size_per_episode,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QualityProfileParams) Reset() {
+	*x = QualityProfileParams{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityProfileParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityProfileParams) ProtoMessage() {}
+
+func (x *QualityProfileParams) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityProfileParams.ProtoReflect.Descriptor instead.
+func (*QualityProfileParams) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QualityProfileParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QualityProfileParams) GetMinResolution() int32 {
+	if x != nil {
+		return x.MinResolution
+	}
+	return 0
+}
+
+func (x *QualityProfileParams) GetMaxResolution() int32 {
+	if x != nil {
+		return x.MaxResolution
+	}
+	return 0
+}
+
+func (x *QualityProfileParams) GetPreferredCodecs() []string {
+	if x != nil {
+		return x.PreferredCodecs
+	}
+	return nil
+}
+
+func (x *QualityProfileParams) GetForbiddenCodecs() []string {
+	if x != nil {
+		return x.ForbiddenCodecs
+	}
+	return nil
+}
+
+func (x *QualityProfileParams) GetRequiredAudio() []string {
+	if x != nil {
+		return x.RequiredAudio
+	}
+	return nil
+}
+
+func (x *QualityProfileParams) GetMaxSizePerEpisode() uint64 {
+	if x != nil {
+		return x.MaxSizePerEpisode
+	}
+	return 0
+}
+
+type CreateQualityProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *QualityProfileParams  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQualityProfileRequest) Reset() {
+	*x = CreateQualityProfileRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQualityProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQualityProfileRequest) ProtoMessage() {}
+
+func (x *CreateQualityProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQualityProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateQualityProfileRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateQualityProfileRequest) GetParams() *QualityProfileParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type CreateQualityProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *QualityProfile        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQualityProfileResponse) Reset() {
+	*x = CreateQualityProfileResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQualityProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQualityProfileResponse) ProtoMessage() {}
+
+func (x *CreateQualityProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQualityProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateQualityProfileResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateQualityProfileResponse) GetResult() *QualityProfile {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetQualityProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityProfilesRequest) Reset() {
+	*x = GetQualityProfilesRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityProfilesRequest) ProtoMessage() {}
+
+func (x *GetQualityProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityProfilesRequest.ProtoReflect.Descriptor instead.
+func (*GetQualityProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{42}
+}
+
+type GetQualityProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*QualityProfile      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityProfilesResponse) Reset() {
+	*x = GetQualityProfilesResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityProfilesResponse) ProtoMessage() {}
+
+func (x *GetQualityProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityProfilesResponse.ProtoReflect.Descriptor instead.
+func (*GetQualityProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetQualityProfilesResponse) GetItems() []*QualityProfile {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetQualityProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityProfileRequest) Reset() {
+	*x = GetQualityProfileRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityProfileRequest) ProtoMessage() {}
+
+func (x *GetQualityProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetQualityProfileRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetQualityProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetQualityProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *QualityProfile        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityProfileResponse) Reset() {
+	*x = GetQualityProfileResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityProfileResponse) ProtoMessage() {}
+
+func (x *GetQualityProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetQualityProfileResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetQualityProfileResponse) GetResult() *QualityProfile {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UpdateQualityProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Params        *QualityProfileParams  `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQualityProfileRequest) Reset() {
+	*x = UpdateQualityProfileRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQualityProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQualityProfileRequest) ProtoMessage() {}
+
+func (x *UpdateQualityProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQualityProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQualityProfileRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateQualityProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateQualityProfileRequest) GetParams() *QualityProfileParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type UpdateQualityProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *QualityProfile        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQualityProfileResponse) Reset() {
+	*x = UpdateQualityProfileResponse{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQualityProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQualityProfileResponse) ProtoMessage() {}
+
+func (x *UpdateQualityProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQualityProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQualityProfileResponse) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateQualityProfileResponse) GetResult() *QualityProfile {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteQualityProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQualityProfileRequest) Reset() {
+	*x = DeleteQualityProfileRequest{}
+	mi := &file_media_delivery_videocontent_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQualityProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQualityProfileRequest) ProtoMessage() {}
+
+func (x *DeleteQualityProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_delivery_videocontent_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQualityProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQualityProfileRequest) Descriptor() ([]byte, []int) {
+	return file_media_delivery_videocontent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteQualityProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_media_delivery_videocontent_proto protoreflect.FileDescriptor
+
+const file_media_delivery_videocontent_proto_rawDesc = "" +
+	"\n" +
+	"!media-delivery/videocontent.proto\x12\rmediadelivery\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a!media-delivery/common-model.proto\x1a(media-delivery/video-content-model.proto\x1a+media-delivery/tv-show-delivery-state.proto\x1a)media-delivery/tv-show-delete-state.proto\x1a)media-delivery/tv-show-cancel-state.proto\x1a)media-delivery/tv-show-update-state.proto\x1a)media-delivery/movie-delivery-state.proto\x1a'media-delivery/movie-delete-state.proto\"n\n" +
+	"\x19CreateVideoContentRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"Q\n" +
+	"\x1aCreateVideoContentResponse\x123\n" +
+	"\x06result\x18\x01 \x01(\v2\x1b.mediadelivery.VideoContentR\x06result\"Q\n" +
+	"\x16GetVideoContentRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"L\n" +
+	"\x17GetVideoContentResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.mediadelivery.VideoContentR\x05items\"P\n" +
+	"\x15GetSeasonCheckRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"L\n" +
+	"\x16GetSeasonCheckResponse\x122\n" +
+	"\x06result\x18\x01 \x01(\v2\x1a.mediadelivery.SeasonCheckR\x06result\"\x89\x02\n" +
+	"\x1aCreateDeliveryStateRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x121\n" +
+	"\x12quality_profile_id\x18\x02 \x01(\tH\x00R\x10qualityProfileId\x88\x01\x01\x12\x18\n" +
+	"\arestore\x18\x03 \x01(\bR\arestore\x12>\n" +
+	"\vpack_source\x18\x04 \x01(\v2\x18.mediadelivery.ContentIDH\x01R\n" +
+	"packSource\x88\x01\x01B\x15\n" +
+	"\x13_quality_profile_idB\x0e\n" +
+	"\f_pack_source\"Y\n" +
+	"\x1bCreateDeliveryStateResponse\x12:\n" +
+	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"Q\n" +
+	"\x16GetDeliveryDataRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"U\n" +
+	"\x17GetDeliveryDataResponse\x12:\n" +
+	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"\xbb\x01\n" +
+	"\x1aChoseTorrentOptionsRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x12\x17\n" +
+	"\x04href\x18\x02 \x01(\tH\x00R\x04href\x88\x01\x01\x12-\n" +
+	"\x10new_search_query\x18\x03 \x01(\tH\x01R\x0enewSearchQuery\x88\x01\x01B\a\n" +
+	"\x05_hrefB\x13\n" +
+	"\x11_new_search_query\"Y\n" +
+	"\x1bChoseTorrentOptionsResponse\x12:\n" +
+	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"]\n" +
+	"\x1fChoseFileMatchesOptionsResponse\x12:\n" +
+	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"Z\n" +
+	"\x1fCreateMovieDeliveryStateRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"]\n" +
+	" CreateMovieDeliveryStateResponse\x129\n" +
+	"\x06result\x18\x01 \x01(\v2!.mediadelivery.MovieDeliveryStateR\x06result\"V\n" +
+	"\x1bGetMovieDeliveryDataRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Y\n" +
+	"\x1cGetMovieDeliveryDataResponse\x129\n" +
+	"\x06result\x18\x01 \x01(\v2!.mediadelivery.MovieDeliveryStateR\x06result\"\xc0\x01\n" +
+	"\x1fChoseMovieTorrentOptionsRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x12\x17\n" +
+	"\x04href\x18\x02 \x01(\tH\x00R\x04href\x88\x01\x01\x12-\n" +
+	"\x10new_search_query\x18\x03 \x01(\tH\x01R\x0enewSearchQuery\x88\x01\x01B\a\n" +
+	"\x05_hrefB\x13\n" +
+	"\x11_new_search_query\"]\n" +
+	" ChoseMovieTorrentOptionsResponse\x129\n" +
+	"\x06result\x18\x01 \x01(\v2!.mediadelivery.MovieDeliveryStateR\x06result\"z\n" +
+	"\x1dRetryMovieDeliveryStepRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x12\x17\n" +
+	"\x04href\x18\x02 \x01(\tH\x00R\x04href\x88\x01\x01B\a\n" +
+	"\x05_href\"[\n" +
+	"\x1eRetryMovieDeliveryStepResponse\x129\n" +
+	"\x06result\x18\x01 \x01(\v2!.mediadelivery.MovieDeliveryStateR\x06result\"\xd6\x01\n" +
+	"\x18RetryDeliveryStepRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\x12\x17\n" +
+	"\x04href\x18\x02 \x01(\tH\x00R\x04href\x88\x01\x01\x12K\n" +
+	"\x0fcontent_matches\x18\x03 \x01(\v2\x1d.mediadelivery.ContentMatchesH\x01R\x0econtentMatches\x88\x01\x01B\a\n" +
+	"\x05_hrefB\x12\n" +
+	"\x10_content_matches\"W\n" +
+	"\x19RetryDeliveryStepResponse\x12:\n" +
+	"\x06result\x18\x01 \x01(\v2\".mediadelivery.TVShowDeliveryStateR\x06result\"P\n" +
+	"\x15CancelDeliveryRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"R\n" +
+	"\x16CancelDeliveryResponse\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowCancelStateR\x06result\"O\n" +
+	"\x14GetCancelDataRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Q\n" +
+	"\x15GetCancelDataResponse\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowCancelStateR\x06result\"S\n" +
+	"\x18CreateUpdateStateRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"U\n" +
+	"\x19CreateUpdateStateResponse\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowUpdateStateR\x06result\"O\n" +
+	"\x14GetUpdateDataRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Q\n" +
+	"\x15GetUpdateDataResponse\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowUpdateStateR\x06result\"S\n" +
+	"\x18CreateDeleteStateRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"U\n" +
+	"\x19CreateDeleteStateResponse\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowDeleteStateR\x06result\"O\n" +
+	"\x14GetDeleteDataRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Q\n" +
+	"\x15GetDeleteDataResponse\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .mediadelivery.TVShowDeleteStateR\x06result\"X\n" +
+	"\x1dCreateMovieDeleteStateRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"Y\n" +
+	"\x1eCreateMovieDeleteStateResponse\x127\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.mediadelivery.MovieDeleteStateR\x06result\"T\n" +
+	"\x19GetMovieDeleteDataRequest\x127\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\v2\x18.mediadelivery.ContentIDR\tcontentId\"U\n" +
+	"\x1aGetMovieDeleteDataResponse\x127\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.mediadelivery.MovieDeleteStateR\x06result\"\xa6\x02\n" +
+	"\x14QualityProfileParams\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0emin_resolution\x18\x02 \x01(\x05R\rminResolution\x12%\n" +
+	"\x0emax_resolution\x18\x03 \x01(\x05R\rmaxResolution\x12)\n" +
+	"\x10preferred_codecs\x18\x04 \x03(\tR\x0fpreferredCodecs\x12)\n" +
+	"\x10forbidden_codecs\x18\x05 \x03(\tR\x0fforbiddenCodecs\x12%\n" +
+	"\x0erequired_audio\x18\x06 \x03(\tR\rrequiredAudio\x12/\n" +
+	"\x14max_size_per_episode\x18\a \x01(\x04R\x11maxSizePerEpisode\"Z\n" +
+	"\x1bCreateQualityProfileRequest\x12;\n" +
+	"\x06params\x18\x01 \x01(\v2#.mediadelivery.QualityProfileParamsR\x06params\"U\n" +
+	"\x1cCreateQualityProfileResponse\x125\n" +
+	"\x06result\x18\x01 \x01(\v2\x1d.mediadelivery.QualityProfileR\x06result\"\x1b\n" +
+	"\x19GetQualityProfilesRequest\"Q\n" +
+	"\x1aGetQualityProfilesResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.mediadelivery.QualityProfileR\x05items\"*\n" +
+	"\x18GetQualityProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x19GetQualityProfileResponse\x125\n" +
+	"\x06result\x18\x01 \x01(\v2\x1d.mediadelivery.QualityProfileR\x06result\"j\n" +
+	"\x1bUpdateQualityProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\x06params\x18\x02 \x01(\v2#.mediadelivery.QualityProfileParamsR\x06params\"U\n" +
+	"\x1cUpdateQualityProfileResponse\x125\n" +
+	"\x06result\x18\x01 \x01(\v2\x1d.mediadelivery.QualityProfileR\x06result\"-\n" +
+	"\x1bDeleteQualityProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xb6*\n" +
 	"\x13VideoContentService\x12\xb2\x01\n" +
 	"\x12CreateVideoContent\x12(.mediadelivery.CreateVideoContentRequest\x1a).mediadelivery.CreateVideoContentResponse\"G\x92A.\x12,Создание видео контента\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/content\x12\xcc\x01\n" +
-	"\x0fGetVideoContent\x12%.mediadelivery.GetVideoContentRequest\x1a&.mediadelivery.GetVideoContentResponse\"j\x92AT\x12RПолучение видео контента для кино/тв сериала\x82\xd3\xe4\x93\x02\r\x12\v/v1/content\x12\xd5\x01\n" +
+	"\x0fGetVideoContent\x12%.mediadelivery.GetVideoContentRequest\x1a&.mediadelivery.GetVideoContentResponse\"j\x92AT\x12RПолучение видео контента для кино/тв сериала\x82\xd3\xe4\x93\x02\r\x12\v/v1/content\x12\xf1\x01\n" +
+	"\x0eGetSeasonCheck\x12$.mediadelivery.GetSeasonCheckRequest\x1a%.mediadelivery.GetSeasonCheckResponse\"\x91\x01\x92An\x12lРезультат последней проверки выхода новых эпизодов сезона\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/content/season-check\x12\xd5\x01\n" +
 	"\x13CreateDeliveryState\x12).mediadelivery.CreateDeliveryStateRequest\x1a*.mediadelivery.CreateDeliveryStateResponse\"g\x92A?\x12=Создание доставки видео контента\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/content/state/delivery\x12\xe1\x01\n" +
 	"\x0fGetDeliveryData\x12%.mediadelivery.GetDeliveryDataRequest\x1a&.mediadelivery.GetDeliveryDataResponse\"\x7f\x92AZ\x12XПолучение данных стейта доставки видеоконтента\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/content/state/delivery\x12\xd3\x01\n" +
 	"\x13ChoseTorrentOptions\x12).mediadelivery.ChoseTorrentOptionsRequest\x1a*.mediadelivery.ChoseTorrentOptionsResponse\"e\x92A/\x12-Выбор раздачи с торрента\x82\xd3\xe4\x93\x02-:\x01*2(/v1/content/state/delivery/chose-torrent\x12\xe9\x01\n" +
-	"\x17ChoseFileMatchesOptions\x12-.mediadelivery.ChoseFileMatchesOptionsRequest\x1a..mediadelivery.ChoseFileMatchesOptionsResponse\"o\x92A4\x122Подтверждение метча файлов\x82\xd3\xe4\x93\x022:\x01*2-/v1/content/state/delivery/chose-file-matches\x12\xc5\x01\n" +
+	"\x17ChoseFileMatchesOptions\x12-.mediadelivery.ChoseFileMatchesOptionsRequest\x1a..mediadelivery.ChoseFileMatchesOptionsResponse\"o\x92A4\x122Подтверждение метча файлов\x82\xd3\xe4\x93\x022:\x01*2-/v1/content/state/delivery/chose-file-matches\x12\xec\x01\n" +
+	"\x11RetryDeliveryStep\x12'.mediadelivery.RetryDeliveryStepRequest\x1a(.mediadelivery.RetryDeliveryStepResponse\"\x83\x01\x92AU\x12SПовтор шага доставки, завершившегося ошибкой\x82\xd3\xe4\x93\x02%:\x01*\" /v1/content/state/delivery/retry\x12\xc8\x01\n" +
+	"\x0eCancelDelivery\x12$.mediadelivery.CancelDeliveryRequest\x1a%.mediadelivery.CancelDeliveryResponse\"i\x92A:\x128Отмена доставки видеоконтента\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/content/state/delivery/cancel\x12\xd4\x01\n" +
+	"\rGetCancelData\x12#.mediadelivery.GetCancelDataRequest\x1a$.mediadelivery.GetCancelDataResponse\"x\x92AL\x12JПолучение данных стейта отмены доставки\x82\xd3\xe4\x93\x02#\x12!/v1/content/state/delivery/cancel\x12\xcf\x01\n" +
+	"\x11CreateUpdateState\x12'.mediadelivery.CreateUpdateStateRequest\x1a(.mediadelivery.CreateUpdateStateResponse\"g\x92AA\x12?Обновление раздачи сезона сериала\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/content/state/update\x12\xd1\x01\n" +
+	"\rGetUpdateData\x12#.mediadelivery.GetUpdateDataRequest\x1a$.mediadelivery.GetUpdateDataResponse\"u\x92AR\x12PПолучение данных стейта обновления раздачи\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/content/state/update\x12\xdb\x01\n" +
+	"\x18CreateMovieDeliveryState\x12..mediadelivery.CreateMovieDeliveryStateRequest\x1a/.mediadelivery.CreateMovieDeliveryStateResponse\"^\x92A0\x12.Создание доставки фильма\x82\xd3\xe4\x93\x02%:\x01*\" /v1/content/state/movie-delivery\x12\xe8\x01\n" +
+	"\x14GetMovieDeliveryData\x12*.mediadelivery.GetMovieDeliveryDataRequest\x1a+.mediadelivery.GetMovieDeliveryDataResponse\"w\x92AL\x12JПолучение данных стейта доставки фильма\x82\xd3\xe4\x93\x02\"\x12 /v1/content/state/movie-delivery\x12\xf5\x01\n" +
+	"\x18ChoseMovieTorrentOptions\x12..mediadelivery.ChoseMovieTorrentOptionsRequest\x1a/.mediadelivery.ChoseMovieTorrentOptionsResponse\"x\x92A<\x12:Выбор раздачи фильма с торрента\x82\xd3\xe4\x93\x023:\x01*2./v1/content/state/movie-delivery/chose-torrent\x12\x8e\x02\n" +
+	"\x16RetryMovieDeliveryStep\x12,.mediadelivery.RetryMovieDeliveryStepRequest\x1a-.mediadelivery.RetryMovieDeliveryStepResponse\"\x96\x01\x92Ab\x12`Повтор шага доставки фильма, завершившегося ошибкой\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/content/state/movie-delivery/retry\x12\xc5\x01\n" +
 	"\x11CreateDeleteState\x12'.mediadelivery.CreateDeleteStateRequest\x1a(.mediadelivery.CreateDeleteStateResponse\"]\x92A:\x128Удаление файлов видеоконтента\x82\xd3\xe4\x93\x02\x1a*\x18/v1/content/state/delete\x12\xd9\x01\n" +
-	"\rGetDeleteData\x12#.mediadelivery.GetDeleteDataRequest\x1a$.mediadelivery.GetDeleteDataResponse\"}\x92AZ\x12XПолучение данных стейта удаления видеоконтента\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/content/state/deleteB'Z%github.com/kkiling/media-delivery/apib\x06proto3"
+	"\rGetDeleteData\x12#.mediadelivery.GetDeleteDataRequest\x1a$.mediadelivery.GetDeleteDataResponse\"}\x92AZ\x12XПолучение данных стейта удаления видеоконтента\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/content/state/delete\x12\xcc\x01\n" +
+	"\x16CreateMovieDeleteState\x12,.mediadelivery.CreateMovieDeleteStateRequest\x1a-.mediadelivery.CreateMovieDeleteStateResponse\"U\x92A,\x12*Удаление файлов фильма\x82\xd3\xe4\x93\x02 *\x1e/v1/content/state/movie-delete\x12\xe0\x01\n" +
+	"\x12GetMovieDeleteData\x12(.mediadelivery.GetMovieDeleteDataRequest\x1a).mediadelivery.GetMovieDeleteDataResponse\"u\x92AL\x12JПолучение данных стейта удаления фильма\x82\xd3\xe4\x93\x02 \x12\x1e/v1/content/state/movie-delete\x12\xc5\x01\n" +
+	"\x14CreateQualityProfile\x12*.mediadelivery.CreateQualityProfileRequest\x1a+.mediadelivery.CreateQualityProfileResponse\"T\x92A2\x120Создание профиля качества\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/quality-profiles\x12\xba\x01\n" +
+	"\x12GetQualityProfiles\x12(.mediadelivery.GetQualityProfilesRequest\x1a).mediadelivery.GetQualityProfilesResponse\"O\x92A0\x12.Список профилей качества\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/quality-profiles\x12\xc0\x01\n" +
+	"\x11GetQualityProfile\x12'.mediadelivery.GetQualityProfileRequest\x1a(.mediadelivery.GetQualityProfileResponse\"X\x92A4\x122Получение профиля качества\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/quality-profiles/{id}\x12\xcc\x01\n" +
+	"\x14UpdateQualityProfile\x12*.mediadelivery.UpdateQualityProfileRequest\x1a+.mediadelivery.UpdateQualityProfileResponse\"[\x92A4\x122Изменение профиля качества\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/quality-profiles/{id}\x12\xb2\x01\n" +
+	"\x14DeleteQualityProfile\x12*.mediadelivery.DeleteQualityProfileRequest\x1a\x16.google.protobuf.Empty\"V\x92A2\x120Удаление профиля качества\x82\xd3\xe4\x93\x02\x1b*\x19/v1/quality-profiles/{id}B'Z%github.com/kkiling/media-delivery/apib\x06proto3"
 
 var (
 	file_media_delivery_videocontent_proto_rawDescOnce sync.Once
@@ -772,66 +2518,174 @@ func file_media_delivery_videocontent_proto_rawDescGZIP() []byte {
 	return file_media_delivery_videocontent_proto_rawDescData
 }
 
-var file_media_delivery_videocontent_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_media_delivery_videocontent_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_media_delivery_videocontent_proto_goTypes = []any{
-	(*CreateVideoContentRequest)(nil),       // 0: mediadelivery.CreateVideoContentRequest
-	(*CreateVideoContentResponse)(nil),      // 1: mediadelivery.CreateVideoContentResponse
-	(*GetVideoContentRequest)(nil),          // 2: mediadelivery.GetVideoContentRequest
-	(*GetVideoContentResponse)(nil),         // 3: mediadelivery.GetVideoContentResponse
-	(*CreateDeliveryStateRequest)(nil),      // 4: mediadelivery.CreateDeliveryStateRequest
-	(*CreateDeliveryStateResponse)(nil),     // 5: mediadelivery.CreateDeliveryStateResponse
-	(*GetDeliveryDataRequest)(nil),          // 6: mediadelivery.GetDeliveryDataRequest
-	(*GetDeliveryDataResponse)(nil),         // 7: mediadelivery.GetDeliveryDataResponse
-	(*ChoseTorrentOptionsRequest)(nil),      // 8: mediadelivery.ChoseTorrentOptionsRequest
-	(*ChoseTorrentOptionsResponse)(nil),     // 9: mediadelivery.ChoseTorrentOptionsResponse
-	(*ChoseFileMatchesOptionsResponse)(nil), // 10: mediadelivery.ChoseFileMatchesOptionsResponse
-	(*CreateDeleteStateRequest)(nil),        // 11: mediadelivery.CreateDeleteStateRequest
-	(*CreateDeleteStateResponse)(nil),       // 12: mediadelivery.CreateDeleteStateResponse
-	(*GetDeleteDataRequest)(nil),            // 13: mediadelivery.GetDeleteDataRequest
-	(*GetDeleteDataResponse)(nil),           // 14: mediadelivery.GetDeleteDataResponse
-	(*ContentID)(nil),                       // 15: mediadelivery.ContentID
-	(*VideoContent)(nil),                    // 16: mediadelivery.VideoContent
-	(*TVShowDeliveryState)(nil),             // 17: mediadelivery.TVShowDeliveryState
-	(*TVShowDeleteState)(nil),               // 18: mediadelivery.TVShowDeleteState
-	(*ChoseFileMatchesOptionsRequest)(nil),  // 19: mediadelivery.ChoseFileMatchesOptionsRequest
+	(*CreateVideoContentRequest)(nil),        // 0: mediadelivery.CreateVideoContentRequest
+	(*CreateVideoContentResponse)(nil),       // 1: mediadelivery.CreateVideoContentResponse
+	(*GetVideoContentRequest)(nil),           // 2: mediadelivery.GetVideoContentRequest
+	(*GetVideoContentResponse)(nil),          // 3: mediadelivery.GetVideoContentResponse
+	(*GetSeasonCheckRequest)(nil),            // 4: mediadelivery.GetSeasonCheckRequest
+	(*GetSeasonCheckResponse)(nil),           // 5: mediadelivery.GetSeasonCheckResponse
+	(*CreateDeliveryStateRequest)(nil),       // 6: mediadelivery.CreateDeliveryStateRequest
+	(*CreateDeliveryStateResponse)(nil),      // 7: mediadelivery.CreateDeliveryStateResponse
+	(*GetDeliveryDataRequest)(nil),           // 8: mediadelivery.GetDeliveryDataRequest
+	(*GetDeliveryDataResponse)(nil),          // 9: mediadelivery.GetDeliveryDataResponse
+	(*ChoseTorrentOptionsRequest)(nil),       // 10: mediadelivery.ChoseTorrentOptionsRequest
+	(*ChoseTorrentOptionsResponse)(nil),      // 11: mediadelivery.ChoseTorrentOptionsResponse
+	(*ChoseFileMatchesOptionsResponse)(nil),  // 12: mediadelivery.ChoseFileMatchesOptionsResponse
+	(*CreateMovieDeliveryStateRequest)(nil),  // 13: mediadelivery.CreateMovieDeliveryStateRequest
+	(*CreateMovieDeliveryStateResponse)(nil), // 14: mediadelivery.CreateMovieDeliveryStateResponse
+	(*GetMovieDeliveryDataRequest)(nil),      // 15: mediadelivery.GetMovieDeliveryDataRequest
+	(*GetMovieDeliveryDataResponse)(nil),     // 16: mediadelivery.GetMovieDeliveryDataResponse
+	(*ChoseMovieTorrentOptionsRequest)(nil),  // 17: mediadelivery.ChoseMovieTorrentOptionsRequest
+	(*ChoseMovieTorrentOptionsResponse)(nil), // 18: mediadelivery.ChoseMovieTorrentOptionsResponse
+	(*RetryMovieDeliveryStepRequest)(nil),    // 19: mediadelivery.RetryMovieDeliveryStepRequest
+	(*RetryMovieDeliveryStepResponse)(nil),   // 20: mediadelivery.RetryMovieDeliveryStepResponse
+	(*RetryDeliveryStepRequest)(nil),         // 21: mediadelivery.RetryDeliveryStepRequest
+	(*RetryDeliveryStepResponse)(nil),        // 22: mediadelivery.RetryDeliveryStepResponse
+	(*CancelDeliveryRequest)(nil),            // 23: mediadelivery.CancelDeliveryRequest
+	(*CancelDeliveryResponse)(nil),           // 24: mediadelivery.CancelDeliveryResponse
+	(*GetCancelDataRequest)(nil),             // 25: mediadelivery.GetCancelDataRequest
+	(*GetCancelDataResponse)(nil),            // 26: mediadelivery.GetCancelDataResponse
+	(*CreateUpdateStateRequest)(nil),         // 27: mediadelivery.CreateUpdateStateRequest
+	(*CreateUpdateStateResponse)(nil),        // 28: mediadelivery.CreateUpdateStateResponse
+	(*GetUpdateDataRequest)(nil),             // 29: mediadelivery.GetUpdateDataRequest
+	(*GetUpdateDataResponse)(nil),            // 30: mediadelivery.GetUpdateDataResponse
+	(*CreateDeleteStateRequest)(nil),         // 31: mediadelivery.CreateDeleteStateRequest
+	(*CreateDeleteStateResponse)(nil),        // 32: mediadelivery.CreateDeleteStateResponse
+	(*GetDeleteDataRequest)(nil),             // 33: mediadelivery.GetDeleteDataRequest
+	(*GetDeleteDataResponse)(nil),            // 34: mediadelivery.GetDeleteDataResponse
+	(*CreateMovieDeleteStateRequest)(nil),    // 35: mediadelivery.CreateMovieDeleteStateRequest
+	(*CreateMovieDeleteStateResponse)(nil),   // 36: mediadelivery.CreateMovieDeleteStateResponse
+	(*GetMovieDeleteDataRequest)(nil),        // 37: mediadelivery.GetMovieDeleteDataRequest
+	(*GetMovieDeleteDataResponse)(nil),       // 38: mediadelivery.GetMovieDeleteDataResponse
+	(*QualityProfileParams)(nil),             // 39: mediadelivery.QualityProfileParams
+	(*CreateQualityProfileRequest)(nil),      // 40: mediadelivery.CreateQualityProfileRequest
+	(*CreateQualityProfileResponse)(nil),     // 41: mediadelivery.CreateQualityProfileResponse
+	(*GetQualityProfilesRequest)(nil),        // 42: mediadelivery.GetQualityProfilesRequest
+	(*GetQualityProfilesResponse)(nil),       // 43: mediadelivery.GetQualityProfilesResponse
+	(*GetQualityProfileRequest)(nil),         // 44: mediadelivery.GetQualityProfileRequest
+	(*GetQualityProfileResponse)(nil),        // 45: mediadelivery.GetQualityProfileResponse
+	(*UpdateQualityProfileRequest)(nil),      // 46: mediadelivery.UpdateQualityProfileRequest
+	(*UpdateQualityProfileResponse)(nil),     // 47: mediadelivery.UpdateQualityProfileResponse
+	(*DeleteQualityProfileRequest)(nil),      // 48: mediadelivery.DeleteQualityProfileRequest
+	(*ContentID)(nil),                        // 49: mediadelivery.ContentID
+	(*VideoContent)(nil),                     // 50: mediadelivery.VideoContent
+	(*SeasonCheck)(nil),                      // 51: mediadelivery.SeasonCheck
+	(*TVShowDeliveryState)(nil),              // 52: mediadelivery.TVShowDeliveryState
+	(*MovieDeliveryState)(nil),               // 53: mediadelivery.MovieDeliveryState
+	(*ContentMatches)(nil),                   // 54: mediadelivery.ContentMatches
+	(*TVShowCancelState)(nil),                // 55: mediadelivery.TVShowCancelState
+	(*TVShowUpdateState)(nil),                // 56: mediadelivery.TVShowUpdateState
+	(*TVShowDeleteState)(nil),                // 57: mediadelivery.TVShowDeleteState
+	(*MovieDeleteState)(nil),                 // 58: mediadelivery.MovieDeleteState
+	(*QualityProfile)(nil),                   // 59: mediadelivery.QualityProfile
+	(*ChoseFileMatchesOptionsRequest)(nil),   // 60: mediadelivery.ChoseFileMatchesOptionsRequest
+	(*emptypb.Empty)(nil),                    // 61: google.protobuf.Empty
 }
 var file_media_delivery_videocontent_proto_depIdxs = []int32{
-	15, // 0: mediadelivery.CreateVideoContentRequest.content_id:type_name -> mediadelivery.ContentID
-	16, // 1: mediadelivery.CreateVideoContentResponse.result:type_name -> mediadelivery.VideoContent
-	15, // 2: mediadelivery.GetVideoContentRequest.content_id:type_name -> mediadelivery.ContentID
-	16, // 3: mediadelivery.GetVideoContentResponse.items:type_name -> mediadelivery.VideoContent
-	15, // 4: mediadelivery.CreateDeliveryStateRequest.content_id:type_name -> mediadelivery.ContentID
-	17, // 5: mediadelivery.CreateDeliveryStateResponse.result:type_name -> mediadelivery.TVShowDeliveryState
-	15, // 6: mediadelivery.GetDeliveryDataRequest.content_id:type_name -> mediadelivery.ContentID
-	17, // 7: mediadelivery.GetDeliveryDataResponse.result:type_name -> mediadelivery.TVShowDeliveryState
-	15, // 8: mediadelivery.ChoseTorrentOptionsRequest.content_id:type_name -> mediadelivery.ContentID
-	17, // 9: mediadelivery.ChoseTorrentOptionsResponse.result:type_name -> mediadelivery.TVShowDeliveryState
-	17, // 10: mediadelivery.ChoseFileMatchesOptionsResponse.result:type_name -> mediadelivery.TVShowDeliveryState
-	15, // 11: mediadelivery.CreateDeleteStateRequest.content_id:type_name -> mediadelivery.ContentID
-	18, // 12: mediadelivery.CreateDeleteStateResponse.result:type_name -> mediadelivery.TVShowDeleteState
-	15, // 13: mediadelivery.GetDeleteDataRequest.content_id:type_name -> mediadelivery.ContentID
-	18, // 14: mediadelivery.GetDeleteDataResponse.result:type_name -> mediadelivery.TVShowDeleteState
-	0,  // 15: mediadelivery.VideoContentService.CreateVideoContent:input_type -> mediadelivery.CreateVideoContentRequest
-	2,  // 16: mediadelivery.VideoContentService.GetVideoContent:input_type -> mediadelivery.GetVideoContentRequest
-	4,  // 17: mediadelivery.VideoContentService.CreateDeliveryState:input_type -> mediadelivery.CreateDeliveryStateRequest
-	6,  // 18: mediadelivery.VideoContentService.GetDeliveryData:input_type -> mediadelivery.GetDeliveryDataRequest
-	8,  // 19: mediadelivery.VideoContentService.ChoseTorrentOptions:input_type -> mediadelivery.ChoseTorrentOptionsRequest
-	19, // 20: mediadelivery.VideoContentService.ChoseFileMatchesOptions:input_type -> mediadelivery.ChoseFileMatchesOptionsRequest
-	11, // 21: mediadelivery.VideoContentService.CreateDeleteState:input_type -> mediadelivery.CreateDeleteStateRequest
-	13, // 22: mediadelivery.VideoContentService.GetDeleteData:input_type -> mediadelivery.GetDeleteDataRequest
-	1,  // 23: mediadelivery.VideoContentService.CreateVideoContent:output_type -> mediadelivery.CreateVideoContentResponse
-	3,  // 24: mediadelivery.VideoContentService.GetVideoContent:output_type -> mediadelivery.GetVideoContentResponse
-	5,  // 25: mediadelivery.VideoContentService.CreateDeliveryState:output_type -> mediadelivery.CreateDeliveryStateResponse
-	7,  // 26: mediadelivery.VideoContentService.GetDeliveryData:output_type -> mediadelivery.GetDeliveryDataResponse
-	9,  // 27: mediadelivery.VideoContentService.ChoseTorrentOptions:output_type -> mediadelivery.ChoseTorrentOptionsResponse
-	10, // 28: mediadelivery.VideoContentService.ChoseFileMatchesOptions:output_type -> mediadelivery.ChoseFileMatchesOptionsResponse
-	12, // 29: mediadelivery.VideoContentService.CreateDeleteState:output_type -> mediadelivery.CreateDeleteStateResponse
-	14, // 30: mediadelivery.VideoContentService.GetDeleteData:output_type -> mediadelivery.GetDeleteDataResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	49, // 0: mediadelivery.CreateVideoContentRequest.content_id:type_name -> mediadelivery.ContentID
+	50, // 1: mediadelivery.CreateVideoContentResponse.result:type_name -> mediadelivery.VideoContent
+	49, // 2: mediadelivery.GetVideoContentRequest.content_id:type_name -> mediadelivery.ContentID
+	50, // 3: mediadelivery.GetVideoContentResponse.items:type_name -> mediadelivery.VideoContent
+	49, // 4: mediadelivery.GetSeasonCheckRequest.content_id:type_name -> mediadelivery.ContentID
+	51, // 5: mediadelivery.GetSeasonCheckResponse.result:type_name -> mediadelivery.SeasonCheck
+	49, // 6: mediadelivery.CreateDeliveryStateRequest.content_id:type_name -> mediadelivery.ContentID
+	49, // 7: mediadelivery.CreateDeliveryStateRequest.pack_source:type_name -> mediadelivery.ContentID
+	52, // 8: mediadelivery.CreateDeliveryStateResponse.result:type_name -> mediadelivery.TVShowDeliveryState
+	49, // 9: mediadelivery.GetDeliveryDataRequest.content_id:type_name -> mediadelivery.ContentID
+	52, // 10: mediadelivery.GetDeliveryDataResponse.result:type_name -> mediadelivery.TVShowDeliveryState
+	49, // 11: mediadelivery.ChoseTorrentOptionsRequest.content_id:type_name -> mediadelivery.ContentID
+	52, // 12: mediadelivery.ChoseTorrentOptionsResponse.result:type_name -> mediadelivery.TVShowDeliveryState
+	52, // 13: mediadelivery.ChoseFileMatchesOptionsResponse.result:type_name -> mediadelivery.TVShowDeliveryState
+	49, // 14: mediadelivery.CreateMovieDeliveryStateRequest.content_id:type_name -> mediadelivery.ContentID
+	53, // 15: mediadelivery.CreateMovieDeliveryStateResponse.result:type_name -> mediadelivery.MovieDeliveryState
+	49, // 16: mediadelivery.GetMovieDeliveryDataRequest.content_id:type_name -> mediadelivery.ContentID
+	53, // 17: mediadelivery.GetMovieDeliveryDataResponse.result:type_name -> mediadelivery.MovieDeliveryState
+	49, // 18: mediadelivery.ChoseMovieTorrentOptionsRequest.content_id:type_name -> mediadelivery.ContentID
+	53, // 19: mediadelivery.ChoseMovieTorrentOptionsResponse.result:type_name -> mediadelivery.MovieDeliveryState
+	49, // 20: mediadelivery.RetryMovieDeliveryStepRequest.content_id:type_name -> mediadelivery.ContentID
+	53, // 21: mediadelivery.RetryMovieDeliveryStepResponse.result:type_name -> mediadelivery.MovieDeliveryState
+	49, // 22: mediadelivery.RetryDeliveryStepRequest.content_id:type_name -> mediadelivery.ContentID
+	54, // 23: mediadelivery.RetryDeliveryStepRequest.content_matches:type_name -> mediadelivery.ContentMatches
+	52, // 24: mediadelivery.RetryDeliveryStepResponse.result:type_name -> mediadelivery.TVShowDeliveryState
+	49, // 25: mediadelivery.CancelDeliveryRequest.content_id:type_name -> mediadelivery.ContentID
+	55, // 26: mediadelivery.CancelDeliveryResponse.result:type_name -> mediadelivery.TVShowCancelState
+	49, // 27: mediadelivery.GetCancelDataRequest.content_id:type_name -> mediadelivery.ContentID
+	55, // 28: mediadelivery.GetCancelDataResponse.result:type_name -> mediadelivery.TVShowCancelState
+	49, // 29: mediadelivery.CreateUpdateStateRequest.content_id:type_name -> mediadelivery.ContentID
+	56, // 30: mediadelivery.CreateUpdateStateResponse.result:type_name -> mediadelivery.TVShowUpdateState
+	49, // 31: mediadelivery.GetUpdateDataRequest.content_id:type_name -> mediadelivery.ContentID
+	56, // 32: mediadelivery.GetUpdateDataResponse.result:type_name -> mediadelivery.TVShowUpdateState
+	49, // 33: mediadelivery.CreateDeleteStateRequest.content_id:type_name -> mediadelivery.ContentID
+	57, // 34: mediadelivery.CreateDeleteStateResponse.result:type_name -> mediadelivery.TVShowDeleteState
+	49, // 35: mediadelivery.GetDeleteDataRequest.content_id:type_name -> mediadelivery.ContentID
+	57, // 36: mediadelivery.GetDeleteDataResponse.result:type_name -> mediadelivery.TVShowDeleteState
+	49, // 37: mediadelivery.CreateMovieDeleteStateRequest.content_id:type_name -> mediadelivery.ContentID
+	58, // 38: mediadelivery.CreateMovieDeleteStateResponse.result:type_name -> mediadelivery.MovieDeleteState
+	49, // 39: mediadelivery.GetMovieDeleteDataRequest.content_id:type_name -> mediadelivery.ContentID
+	58, // 40: mediadelivery.GetMovieDeleteDataResponse.result:type_name -> mediadelivery.MovieDeleteState
+	39, // 41: mediadelivery.CreateQualityProfileRequest.params:type_name -> mediadelivery.QualityProfileParams
+	59, // 42: mediadelivery.CreateQualityProfileResponse.result:type_name -> mediadelivery.QualityProfile
+	59, // 43: mediadelivery.GetQualityProfilesResponse.items:type_name -> mediadelivery.QualityProfile
+	59, // 44: mediadelivery.GetQualityProfileResponse.result:type_name -> mediadelivery.QualityProfile
+	39, // 45: mediadelivery.UpdateQualityProfileRequest.params:type_name -> mediadelivery.QualityProfileParams
+	59, // 46: mediadelivery.UpdateQualityProfileResponse.result:type_name -> mediadelivery.QualityProfile
+	0,  // 47: mediadelivery.VideoContentService.CreateVideoContent:input_type -> mediadelivery.CreateVideoContentRequest
+	2,  // 48: mediadelivery.VideoContentService.GetVideoContent:input_type -> mediadelivery.GetVideoContentRequest
+	4,  // 49: mediadelivery.VideoContentService.GetSeasonCheck:input_type -> mediadelivery.GetSeasonCheckRequest
+	6,  // 50: mediadelivery.VideoContentService.CreateDeliveryState:input_type -> mediadelivery.CreateDeliveryStateRequest
+	8,  // 51: mediadelivery.VideoContentService.GetDeliveryData:input_type -> mediadelivery.GetDeliveryDataRequest
+	10, // 52: mediadelivery.VideoContentService.ChoseTorrentOptions:input_type -> mediadelivery.ChoseTorrentOptionsRequest
+	60, // 53: mediadelivery.VideoContentService.ChoseFileMatchesOptions:input_type -> mediadelivery.ChoseFileMatchesOptionsRequest
+	21, // 54: mediadelivery.VideoContentService.RetryDeliveryStep:input_type -> mediadelivery.RetryDeliveryStepRequest
+	23, // 55: mediadelivery.VideoContentService.CancelDelivery:input_type -> mediadelivery.CancelDeliveryRequest
+	25, // 56: mediadelivery.VideoContentService.GetCancelData:input_type -> mediadelivery.GetCancelDataRequest
+	27, // 57: mediadelivery.VideoContentService.CreateUpdateState:input_type -> mediadelivery.CreateUpdateStateRequest
+	29, // 58: mediadelivery.VideoContentService.GetUpdateData:input_type -> mediadelivery.GetUpdateDataRequest
+	13, // 59: mediadelivery.VideoContentService.CreateMovieDeliveryState:input_type -> mediadelivery.CreateMovieDeliveryStateRequest
+	15, // 60: mediadelivery.VideoContentService.GetMovieDeliveryData:input_type -> mediadelivery.GetMovieDeliveryDataRequest
+	17, // 61: mediadelivery.VideoContentService.ChoseMovieTorrentOptions:input_type -> mediadelivery.ChoseMovieTorrentOptionsRequest
+	19, // 62: mediadelivery.VideoContentService.RetryMovieDeliveryStep:input_type -> mediadelivery.RetryMovieDeliveryStepRequest
+	31, // 63: mediadelivery.VideoContentService.CreateDeleteState:input_type -> mediadelivery.CreateDeleteStateRequest
+	33, // 64: mediadelivery.VideoContentService.GetDeleteData:input_type -> mediadelivery.GetDeleteDataRequest
+	35, // 65: mediadelivery.VideoContentService.CreateMovieDeleteState:input_type -> mediadelivery.CreateMovieDeleteStateRequest
+	37, // 66: mediadelivery.VideoContentService.GetMovieDeleteData:input_type -> mediadelivery.GetMovieDeleteDataRequest
+	40, // 67: mediadelivery.VideoContentService.CreateQualityProfile:input_type -> mediadelivery.CreateQualityProfileRequest
+	42, // 68: mediadelivery.VideoContentService.GetQualityProfiles:input_type -> mediadelivery.GetQualityProfilesRequest
+	44, // 69: mediadelivery.VideoContentService.GetQualityProfile:input_type -> mediadelivery.GetQualityProfileRequest
+	46, // 70: mediadelivery.VideoContentService.UpdateQualityProfile:input_type -> mediadelivery.UpdateQualityProfileRequest
+	48, // 71: mediadelivery.VideoContentService.DeleteQualityProfile:input_type -> mediadelivery.DeleteQualityProfileRequest
+	1,  // 72: mediadelivery.VideoContentService.CreateVideoContent:output_type -> mediadelivery.CreateVideoContentResponse
+	3,  // 73: mediadelivery.VideoContentService.GetVideoContent:output_type -> mediadelivery.GetVideoContentResponse
+	5,  // 74: mediadelivery.VideoContentService.GetSeasonCheck:output_type -> mediadelivery.GetSeasonCheckResponse
+	7,  // 75: mediadelivery.VideoContentService.CreateDeliveryState:output_type -> mediadelivery.CreateDeliveryStateResponse
+	9,  // 76: mediadelivery.VideoContentService.GetDeliveryData:output_type -> mediadelivery.GetDeliveryDataResponse
+	11, // 77: mediadelivery.VideoContentService.ChoseTorrentOptions:output_type -> mediadelivery.ChoseTorrentOptionsResponse
+	12, // 78: mediadelivery.VideoContentService.ChoseFileMatchesOptions:output_type -> mediadelivery.ChoseFileMatchesOptionsResponse
+	22, // 79: mediadelivery.VideoContentService.RetryDeliveryStep:output_type -> mediadelivery.RetryDeliveryStepResponse
+	24, // 80: mediadelivery.VideoContentService.CancelDelivery:output_type -> mediadelivery.CancelDeliveryResponse
+	26, // 81: mediadelivery.VideoContentService.GetCancelData:output_type -> mediadelivery.GetCancelDataResponse
+	28, // 82: mediadelivery.VideoContentService.CreateUpdateState:output_type -> mediadelivery.CreateUpdateStateResponse
+	30, // 83: mediadelivery.VideoContentService.GetUpdateData:output_type -> mediadelivery.GetUpdateDataResponse
+	14, // 84: mediadelivery.VideoContentService.CreateMovieDeliveryState:output_type -> mediadelivery.CreateMovieDeliveryStateResponse
+	16, // 85: mediadelivery.VideoContentService.GetMovieDeliveryData:output_type -> mediadelivery.GetMovieDeliveryDataResponse
+	18, // 86: mediadelivery.VideoContentService.ChoseMovieTorrentOptions:output_type -> mediadelivery.ChoseMovieTorrentOptionsResponse
+	20, // 87: mediadelivery.VideoContentService.RetryMovieDeliveryStep:output_type -> mediadelivery.RetryMovieDeliveryStepResponse
+	32, // 88: mediadelivery.VideoContentService.CreateDeleteState:output_type -> mediadelivery.CreateDeleteStateResponse
+	34, // 89: mediadelivery.VideoContentService.GetDeleteData:output_type -> mediadelivery.GetDeleteDataResponse
+	36, // 90: mediadelivery.VideoContentService.CreateMovieDeleteState:output_type -> mediadelivery.CreateMovieDeleteStateResponse
+	38, // 91: mediadelivery.VideoContentService.GetMovieDeleteData:output_type -> mediadelivery.GetMovieDeleteDataResponse
+	41, // 92: mediadelivery.VideoContentService.CreateQualityProfile:output_type -> mediadelivery.CreateQualityProfileResponse
+	43, // 93: mediadelivery.VideoContentService.GetQualityProfiles:output_type -> mediadelivery.GetQualityProfilesResponse
+	45, // 94: mediadelivery.VideoContentService.GetQualityProfile:output_type -> mediadelivery.GetQualityProfileResponse
+	47, // 95: mediadelivery.VideoContentService.UpdateQualityProfile:output_type -> mediadelivery.UpdateQualityProfileResponse
+	61, // 96: mediadelivery.VideoContentService.DeleteQualityProfile:output_type -> google.protobuf.Empty
+	72, // [72:97] is the sub-list for method output_type
+	47, // [47:72] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_media_delivery_videocontent_proto_init() }
@@ -843,14 +2697,22 @@ func file_media_delivery_videocontent_proto_init() {
 	file_media_delivery_video_content_model_proto_init()
 	file_media_delivery_tv_show_delivery_state_proto_init()
 	file_media_delivery_tv_show_delete_state_proto_init()
-	file_media_delivery_videocontent_proto_msgTypes[8].OneofWrappers = []any{}
+	file_media_delivery_tv_show_cancel_state_proto_init()
+	file_media_delivery_tv_show_update_state_proto_init()
+	file_media_delivery_movie_delivery_state_proto_init()
+	file_media_delivery_movie_delete_state_proto_init()
+	file_media_delivery_videocontent_proto_msgTypes[6].OneofWrappers = []any{}
+	file_media_delivery_videocontent_proto_msgTypes[10].OneofWrappers = []any{}
+	file_media_delivery_videocontent_proto_msgTypes[17].OneofWrappers = []any{}
+	file_media_delivery_videocontent_proto_msgTypes[19].OneofWrappers = []any{}
+	file_media_delivery_videocontent_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_delivery_videocontent_proto_rawDesc), len(file_media_delivery_videocontent_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
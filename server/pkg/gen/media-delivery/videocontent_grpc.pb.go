@@ -12,6 +12,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,14 +21,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VideoContentService_CreateVideoContent_FullMethodName      = "/mediadelivery.VideoContentService/CreateVideoContent"
-	VideoContentService_GetVideoContent_FullMethodName         = "/mediadelivery.VideoContentService/GetVideoContent"
-	VideoContentService_CreateDeliveryState_FullMethodName     = "/mediadelivery.VideoContentService/CreateDeliveryState"
-	VideoContentService_GetDeliveryData_FullMethodName         = "/mediadelivery.VideoContentService/GetDeliveryData"
-	VideoContentService_ChoseTorrentOptions_FullMethodName     = "/mediadelivery.VideoContentService/ChoseTorrentOptions"
-	VideoContentService_ChoseFileMatchesOptions_FullMethodName = "/mediadelivery.VideoContentService/ChoseFileMatchesOptions"
-	VideoContentService_CreateDeleteState_FullMethodName       = "/mediadelivery.VideoContentService/CreateDeleteState"
-	VideoContentService_GetDeleteData_FullMethodName           = "/mediadelivery.VideoContentService/GetDeleteData"
+	VideoContentService_CreateVideoContent_FullMethodName       = "/mediadelivery.VideoContentService/CreateVideoContent"
+	VideoContentService_GetVideoContent_FullMethodName          = "/mediadelivery.VideoContentService/GetVideoContent"
+	VideoContentService_GetSeasonCheck_FullMethodName           = "/mediadelivery.VideoContentService/GetSeasonCheck"
+	VideoContentService_CreateDeliveryState_FullMethodName      = "/mediadelivery.VideoContentService/CreateDeliveryState"
+	VideoContentService_GetDeliveryData_FullMethodName          = "/mediadelivery.VideoContentService/GetDeliveryData"
+	VideoContentService_ChoseTorrentOptions_FullMethodName      = "/mediadelivery.VideoContentService/ChoseTorrentOptions"
+	VideoContentService_ChoseFileMatchesOptions_FullMethodName  = "/mediadelivery.VideoContentService/ChoseFileMatchesOptions"
+	VideoContentService_RetryDeliveryStep_FullMethodName        = "/mediadelivery.VideoContentService/RetryDeliveryStep"
+	VideoContentService_CancelDelivery_FullMethodName           = "/mediadelivery.VideoContentService/CancelDelivery"
+	VideoContentService_GetCancelData_FullMethodName            = "/mediadelivery.VideoContentService/GetCancelData"
+	VideoContentService_CreateUpdateState_FullMethodName        = "/mediadelivery.VideoContentService/CreateUpdateState"
+	VideoContentService_GetUpdateData_FullMethodName            = "/mediadelivery.VideoContentService/GetUpdateData"
+	VideoContentService_CreateMovieDeliveryState_FullMethodName = "/mediadelivery.VideoContentService/CreateMovieDeliveryState"
+	VideoContentService_GetMovieDeliveryData_FullMethodName     = "/mediadelivery.VideoContentService/GetMovieDeliveryData"
+	VideoContentService_ChoseMovieTorrentOptions_FullMethodName = "/mediadelivery.VideoContentService/ChoseMovieTorrentOptions"
+	VideoContentService_RetryMovieDeliveryStep_FullMethodName   = "/mediadelivery.VideoContentService/RetryMovieDeliveryStep"
+	VideoContentService_CreateDeleteState_FullMethodName        = "/mediadelivery.VideoContentService/CreateDeleteState"
+	VideoContentService_GetDeleteData_FullMethodName            = "/mediadelivery.VideoContentService/GetDeleteData"
+	VideoContentService_CreateMovieDeleteState_FullMethodName   = "/mediadelivery.VideoContentService/CreateMovieDeleteState"
+	VideoContentService_GetMovieDeleteData_FullMethodName       = "/mediadelivery.VideoContentService/GetMovieDeleteData"
+	VideoContentService_CreateQualityProfile_FullMethodName     = "/mediadelivery.VideoContentService/CreateQualityProfile"
+	VideoContentService_GetQualityProfiles_FullMethodName       = "/mediadelivery.VideoContentService/GetQualityProfiles"
+	VideoContentService_GetQualityProfile_FullMethodName        = "/mediadelivery.VideoContentService/GetQualityProfile"
+	VideoContentService_UpdateQualityProfile_FullMethodName     = "/mediadelivery.VideoContentService/UpdateQualityProfile"
+	VideoContentService_DeleteQualityProfile_FullMethodName     = "/mediadelivery.VideoContentService/DeleteQualityProfile"
 )
 
 // VideoContentServiceClient is the client API for VideoContentService service.
@@ -36,14 +54,38 @@ const (
 type VideoContentServiceClient interface {
 	CreateVideoContent(ctx context.Context, in *CreateVideoContentRequest, opts ...grpc.CallOption) (*CreateVideoContentResponse, error)
 	GetVideoContent(ctx context.Context, in *GetVideoContentRequest, opts ...grpc.CallOption) (*GetVideoContentResponse, error)
+	GetSeasonCheck(ctx context.Context, in *GetSeasonCheckRequest, opts ...grpc.CallOption) (*GetSeasonCheckResponse, error)
 	// Информация о доставки файлов videoContent
 	CreateDeliveryState(ctx context.Context, in *CreateDeliveryStateRequest, opts ...grpc.CallOption) (*CreateDeliveryStateResponse, error)
 	GetDeliveryData(ctx context.Context, in *GetDeliveryDataRequest, opts ...grpc.CallOption) (*GetDeliveryDataResponse, error)
 	ChoseTorrentOptions(ctx context.Context, in *ChoseTorrentOptionsRequest, opts ...grpc.CallOption) (*ChoseTorrentOptionsResponse, error)
 	ChoseFileMatchesOptions(ctx context.Context, in *ChoseFileMatchesOptionsRequest, opts ...grpc.CallOption) (*ChoseFileMatchesOptionsResponse, error)
+	// Повтор шага доставки сезона сериала, завершившегося ошибкой
+	RetryDeliveryStep(ctx context.Context, in *RetryDeliveryStepRequest, opts ...grpc.CallOption) (*RetryDeliveryStepResponse, error)
+	// Отмена доставки сезона сериала с откатом всего, что она успела сделать
+	CancelDelivery(ctx context.Context, in *CancelDeliveryRequest, opts ...grpc.CallOption) (*CancelDeliveryResponse, error)
+	GetCancelData(ctx context.Context, in *GetCancelDataRequest, opts ...grpc.CallOption) (*GetCancelDataResponse, error)
+	// Обновление раздачи доставленного сезона, если она перезалита с новыми эпизодами
+	CreateUpdateState(ctx context.Context, in *CreateUpdateStateRequest, opts ...grpc.CallOption) (*CreateUpdateStateResponse, error)
+	GetUpdateData(ctx context.Context, in *GetUpdateDataRequest, opts ...grpc.CallOption) (*GetUpdateDataResponse, error)
+	// Информация о доставки файлов фильма
+	CreateMovieDeliveryState(ctx context.Context, in *CreateMovieDeliveryStateRequest, opts ...grpc.CallOption) (*CreateMovieDeliveryStateResponse, error)
+	GetMovieDeliveryData(ctx context.Context, in *GetMovieDeliveryDataRequest, opts ...grpc.CallOption) (*GetMovieDeliveryDataResponse, error)
+	ChoseMovieTorrentOptions(ctx context.Context, in *ChoseMovieTorrentOptionsRequest, opts ...grpc.CallOption) (*ChoseMovieTorrentOptionsResponse, error)
+	// Повтор шага доставки фильма, завершившегося ошибкой
+	RetryMovieDeliveryStep(ctx context.Context, in *RetryMovieDeliveryStepRequest, opts ...grpc.CallOption) (*RetryMovieDeliveryStepResponse, error)
 	// Удаление файлов videoContent
 	CreateDeleteState(ctx context.Context, in *CreateDeleteStateRequest, opts ...grpc.CallOption) (*CreateDeleteStateResponse, error)
 	GetDeleteData(ctx context.Context, in *GetDeleteDataRequest, opts ...grpc.CallOption) (*GetDeleteDataResponse, error)
+	// Удаление файлов фильма
+	CreateMovieDeleteState(ctx context.Context, in *CreateMovieDeleteStateRequest, opts ...grpc.CallOption) (*CreateMovieDeleteStateResponse, error)
+	GetMovieDeleteData(ctx context.Context, in *GetMovieDeleteDataRequest, opts ...grpc.CallOption) (*GetMovieDeleteDataResponse, error)
+	// Профили качества
+	CreateQualityProfile(ctx context.Context, in *CreateQualityProfileRequest, opts ...grpc.CallOption) (*CreateQualityProfileResponse, error)
+	GetQualityProfiles(ctx context.Context, in *GetQualityProfilesRequest, opts ...grpc.CallOption) (*GetQualityProfilesResponse, error)
+	GetQualityProfile(ctx context.Context, in *GetQualityProfileRequest, opts ...grpc.CallOption) (*GetQualityProfileResponse, error)
+	UpdateQualityProfile(ctx context.Context, in *UpdateQualityProfileRequest, opts ...grpc.CallOption) (*UpdateQualityProfileResponse, error)
+	DeleteQualityProfile(ctx context.Context, in *DeleteQualityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type videoContentServiceClient struct {
@@ -74,6 +116,16 @@ func (c *videoContentServiceClient) GetVideoContent(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *videoContentServiceClient) GetSeasonCheck(ctx context.Context, in *GetSeasonCheckRequest, opts ...grpc.CallOption) (*GetSeasonCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeasonCheckResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetSeasonCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoContentServiceClient) CreateDeliveryState(ctx context.Context, in *CreateDeliveryStateRequest, opts ...grpc.CallOption) (*CreateDeliveryStateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDeliveryStateResponse)
@@ -114,6 +166,96 @@ func (c *videoContentServiceClient) ChoseFileMatchesOptions(ctx context.Context,
 	return out, nil
 }
 
+func (c *videoContentServiceClient) RetryDeliveryStep(ctx context.Context, in *RetryDeliveryStepRequest, opts ...grpc.CallOption) (*RetryDeliveryStepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryDeliveryStepResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_RetryDeliveryStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) CancelDelivery(ctx context.Context, in *CancelDeliveryRequest, opts ...grpc.CallOption) (*CancelDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelDeliveryResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_CancelDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) GetCancelData(ctx context.Context, in *GetCancelDataRequest, opts ...grpc.CallOption) (*GetCancelDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCancelDataResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetCancelData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) CreateUpdateState(ctx context.Context, in *CreateUpdateStateRequest, opts ...grpc.CallOption) (*CreateUpdateStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUpdateStateResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_CreateUpdateState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) GetUpdateData(ctx context.Context, in *GetUpdateDataRequest, opts ...grpc.CallOption) (*GetUpdateDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUpdateDataResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetUpdateData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) CreateMovieDeliveryState(ctx context.Context, in *CreateMovieDeliveryStateRequest, opts ...grpc.CallOption) (*CreateMovieDeliveryStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMovieDeliveryStateResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_CreateMovieDeliveryState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) GetMovieDeliveryData(ctx context.Context, in *GetMovieDeliveryDataRequest, opts ...grpc.CallOption) (*GetMovieDeliveryDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMovieDeliveryDataResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetMovieDeliveryData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) ChoseMovieTorrentOptions(ctx context.Context, in *ChoseMovieTorrentOptionsRequest, opts ...grpc.CallOption) (*ChoseMovieTorrentOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChoseMovieTorrentOptionsResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_ChoseMovieTorrentOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) RetryMovieDeliveryStep(ctx context.Context, in *RetryMovieDeliveryStepRequest, opts ...grpc.CallOption) (*RetryMovieDeliveryStepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryMovieDeliveryStepResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_RetryMovieDeliveryStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoContentServiceClient) CreateDeleteState(ctx context.Context, in *CreateDeleteStateRequest, opts ...grpc.CallOption) (*CreateDeleteStateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDeleteStateResponse)
@@ -134,20 +276,114 @@ func (c *videoContentServiceClient) GetDeleteData(ctx context.Context, in *GetDe
 	return out, nil
 }
 
+func (c *videoContentServiceClient) CreateMovieDeleteState(ctx context.Context, in *CreateMovieDeleteStateRequest, opts ...grpc.CallOption) (*CreateMovieDeleteStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMovieDeleteStateResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_CreateMovieDeleteState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) GetMovieDeleteData(ctx context.Context, in *GetMovieDeleteDataRequest, opts ...grpc.CallOption) (*GetMovieDeleteDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMovieDeleteDataResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetMovieDeleteData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) CreateQualityProfile(ctx context.Context, in *CreateQualityProfileRequest, opts ...grpc.CallOption) (*CreateQualityProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQualityProfileResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_CreateQualityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) GetQualityProfiles(ctx context.Context, in *GetQualityProfilesRequest, opts ...grpc.CallOption) (*GetQualityProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQualityProfilesResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetQualityProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) GetQualityProfile(ctx context.Context, in *GetQualityProfileRequest, opts ...grpc.CallOption) (*GetQualityProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQualityProfileResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_GetQualityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) UpdateQualityProfile(ctx context.Context, in *UpdateQualityProfileRequest, opts ...grpc.CallOption) (*UpdateQualityProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateQualityProfileResponse)
+	err := c.cc.Invoke(ctx, VideoContentService_UpdateQualityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoContentServiceClient) DeleteQualityProfile(ctx context.Context, in *DeleteQualityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VideoContentService_DeleteQualityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoContentServiceServer is the server API for VideoContentService service.
 // All implementations must embed UnimplementedVideoContentServiceServer
 // for forward compatibility.
 type VideoContentServiceServer interface {
 	CreateVideoContent(context.Context, *CreateVideoContentRequest) (*CreateVideoContentResponse, error)
 	GetVideoContent(context.Context, *GetVideoContentRequest) (*GetVideoContentResponse, error)
+	GetSeasonCheck(context.Context, *GetSeasonCheckRequest) (*GetSeasonCheckResponse, error)
 	// Информация о доставки файлов videoContent
 	CreateDeliveryState(context.Context, *CreateDeliveryStateRequest) (*CreateDeliveryStateResponse, error)
 	GetDeliveryData(context.Context, *GetDeliveryDataRequest) (*GetDeliveryDataResponse, error)
 	ChoseTorrentOptions(context.Context, *ChoseTorrentOptionsRequest) (*ChoseTorrentOptionsResponse, error)
 	ChoseFileMatchesOptions(context.Context, *ChoseFileMatchesOptionsRequest) (*ChoseFileMatchesOptionsResponse, error)
+	// Повтор шага доставки сезона сериала, завершившегося ошибкой
+	RetryDeliveryStep(context.Context, *RetryDeliveryStepRequest) (*RetryDeliveryStepResponse, error)
+	// Отмена доставки сезона сериала с откатом всего, что она успела сделать
+	CancelDelivery(context.Context, *CancelDeliveryRequest) (*CancelDeliveryResponse, error)
+	GetCancelData(context.Context, *GetCancelDataRequest) (*GetCancelDataResponse, error)
+	// Обновление раздачи доставленного сезона, если она перезалита с новыми эпизодами
+	CreateUpdateState(context.Context, *CreateUpdateStateRequest) (*CreateUpdateStateResponse, error)
+	GetUpdateData(context.Context, *GetUpdateDataRequest) (*GetUpdateDataResponse, error)
+	// Информация о доставки файлов фильма
+	CreateMovieDeliveryState(context.Context, *CreateMovieDeliveryStateRequest) (*CreateMovieDeliveryStateResponse, error)
+	GetMovieDeliveryData(context.Context, *GetMovieDeliveryDataRequest) (*GetMovieDeliveryDataResponse, error)
+	ChoseMovieTorrentOptions(context.Context, *ChoseMovieTorrentOptionsRequest) (*ChoseMovieTorrentOptionsResponse, error)
+	// Повтор шага доставки фильма, завершившегося ошибкой
+	RetryMovieDeliveryStep(context.Context, *RetryMovieDeliveryStepRequest) (*RetryMovieDeliveryStepResponse, error)
 	// Удаление файлов videoContent
 	CreateDeleteState(context.Context, *CreateDeleteStateRequest) (*CreateDeleteStateResponse, error)
 	GetDeleteData(context.Context, *GetDeleteDataRequest) (*GetDeleteDataResponse, error)
+	// Удаление файлов фильма
+	CreateMovieDeleteState(context.Context, *CreateMovieDeleteStateRequest) (*CreateMovieDeleteStateResponse, error)
+	GetMovieDeleteData(context.Context, *GetMovieDeleteDataRequest) (*GetMovieDeleteDataResponse, error)
+	// Профили качества
+	CreateQualityProfile(context.Context, *CreateQualityProfileRequest) (*CreateQualityProfileResponse, error)
+	GetQualityProfiles(context.Context, *GetQualityProfilesRequest) (*GetQualityProfilesResponse, error)
+	GetQualityProfile(context.Context, *GetQualityProfileRequest) (*GetQualityProfileResponse, error)
+	UpdateQualityProfile(context.Context, *UpdateQualityProfileRequest) (*UpdateQualityProfileResponse, error)
+	DeleteQualityProfile(context.Context, *DeleteQualityProfileRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedVideoContentServiceServer()
 }
 
@@ -164,6 +400,9 @@ func (UnimplementedVideoContentServiceServer) CreateVideoContent(context.Context
 func (UnimplementedVideoContentServiceServer) GetVideoContent(context.Context, *GetVideoContentRequest) (*GetVideoContentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoContent not implemented")
 }
+func (UnimplementedVideoContentServiceServer) GetSeasonCheck(context.Context, *GetSeasonCheckRequest) (*GetSeasonCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonCheck not implemented")
+}
 func (UnimplementedVideoContentServiceServer) CreateDeliveryState(context.Context, *CreateDeliveryStateRequest) (*CreateDeliveryStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeliveryState not implemented")
 }
@@ -176,12 +415,60 @@ func (UnimplementedVideoContentServiceServer) ChoseTorrentOptions(context.Contex
 func (UnimplementedVideoContentServiceServer) ChoseFileMatchesOptions(context.Context, *ChoseFileMatchesOptionsRequest) (*ChoseFileMatchesOptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChoseFileMatchesOptions not implemented")
 }
+func (UnimplementedVideoContentServiceServer) RetryDeliveryStep(context.Context, *RetryDeliveryStepRequest) (*RetryDeliveryStepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeliveryStep not implemented")
+}
+func (UnimplementedVideoContentServiceServer) CancelDelivery(context.Context, *CancelDeliveryRequest) (*CancelDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelivery not implemented")
+}
+func (UnimplementedVideoContentServiceServer) GetCancelData(context.Context, *GetCancelDataRequest) (*GetCancelDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancelData not implemented")
+}
+func (UnimplementedVideoContentServiceServer) CreateUpdateState(context.Context, *CreateUpdateStateRequest) (*CreateUpdateStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUpdateState not implemented")
+}
+func (UnimplementedVideoContentServiceServer) GetUpdateData(context.Context, *GetUpdateDataRequest) (*GetUpdateDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateData not implemented")
+}
+func (UnimplementedVideoContentServiceServer) CreateMovieDeliveryState(context.Context, *CreateMovieDeliveryStateRequest) (*CreateMovieDeliveryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMovieDeliveryState not implemented")
+}
+func (UnimplementedVideoContentServiceServer) GetMovieDeliveryData(context.Context, *GetMovieDeliveryDataRequest) (*GetMovieDeliveryDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovieDeliveryData not implemented")
+}
+func (UnimplementedVideoContentServiceServer) ChoseMovieTorrentOptions(context.Context, *ChoseMovieTorrentOptionsRequest) (*ChoseMovieTorrentOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChoseMovieTorrentOptions not implemented")
+}
+func (UnimplementedVideoContentServiceServer) RetryMovieDeliveryStep(context.Context, *RetryMovieDeliveryStepRequest) (*RetryMovieDeliveryStepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryMovieDeliveryStep not implemented")
+}
 func (UnimplementedVideoContentServiceServer) CreateDeleteState(context.Context, *CreateDeleteStateRequest) (*CreateDeleteStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeleteState not implemented")
 }
 func (UnimplementedVideoContentServiceServer) GetDeleteData(context.Context, *GetDeleteDataRequest) (*GetDeleteDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteData not implemented")
 }
+func (UnimplementedVideoContentServiceServer) CreateMovieDeleteState(context.Context, *CreateMovieDeleteStateRequest) (*CreateMovieDeleteStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMovieDeleteState not implemented")
+}
+func (UnimplementedVideoContentServiceServer) GetMovieDeleteData(context.Context, *GetMovieDeleteDataRequest) (*GetMovieDeleteDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovieDeleteData not implemented")
+}
+func (UnimplementedVideoContentServiceServer) CreateQualityProfile(context.Context, *CreateQualityProfileRequest) (*CreateQualityProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQualityProfile not implemented")
+}
+func (UnimplementedVideoContentServiceServer) GetQualityProfiles(context.Context, *GetQualityProfilesRequest) (*GetQualityProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQualityProfiles not implemented")
+}
+func (UnimplementedVideoContentServiceServer) GetQualityProfile(context.Context, *GetQualityProfileRequest) (*GetQualityProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQualityProfile not implemented")
+}
+func (UnimplementedVideoContentServiceServer) UpdateQualityProfile(context.Context, *UpdateQualityProfileRequest) (*UpdateQualityProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQualityProfile not implemented")
+}
+func (UnimplementedVideoContentServiceServer) DeleteQualityProfile(context.Context, *DeleteQualityProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQualityProfile not implemented")
+}
 func (UnimplementedVideoContentServiceServer) mustEmbedUnimplementedVideoContentServiceServer() {}
 func (UnimplementedVideoContentServiceServer) testEmbeddedByValue()                             {}
 
@@ -239,6 +526,24 @@ func _VideoContentService_GetVideoContent_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoContentService_GetSeasonCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetSeasonCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetSeasonCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetSeasonCheck(ctx, req.(*GetSeasonCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoContentService_CreateDeliveryState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDeliveryStateRequest)
 	if err := dec(in); err != nil {
@@ -311,6 +616,168 @@ func _VideoContentService_ChoseFileMatchesOptions_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoContentService_RetryDeliveryStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeliveryStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).RetryDeliveryStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_RetryDeliveryStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).RetryDeliveryStep(ctx, req.(*RetryDeliveryStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_CancelDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).CancelDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_CancelDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).CancelDelivery(ctx, req.(*CancelDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_GetCancelData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancelDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetCancelData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetCancelData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetCancelData(ctx, req.(*GetCancelDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_CreateUpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUpdateStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).CreateUpdateState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_CreateUpdateState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).CreateUpdateState(ctx, req.(*CreateUpdateStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_GetUpdateData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpdateDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetUpdateData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetUpdateData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetUpdateData(ctx, req.(*GetUpdateDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_CreateMovieDeliveryState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMovieDeliveryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).CreateMovieDeliveryState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_CreateMovieDeliveryState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).CreateMovieDeliveryState(ctx, req.(*CreateMovieDeliveryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_GetMovieDeliveryData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieDeliveryDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetMovieDeliveryData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetMovieDeliveryData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetMovieDeliveryData(ctx, req.(*GetMovieDeliveryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_ChoseMovieTorrentOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChoseMovieTorrentOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).ChoseMovieTorrentOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_ChoseMovieTorrentOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).ChoseMovieTorrentOptions(ctx, req.(*ChoseMovieTorrentOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_RetryMovieDeliveryStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryMovieDeliveryStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).RetryMovieDeliveryStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_RetryMovieDeliveryStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).RetryMovieDeliveryStep(ctx, req.(*RetryMovieDeliveryStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoContentService_CreateDeleteState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDeleteStateRequest)
 	if err := dec(in); err != nil {
@@ -347,6 +814,132 @@ func _VideoContentService_GetDeleteData_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoContentService_CreateMovieDeleteState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMovieDeleteStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).CreateMovieDeleteState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_CreateMovieDeleteState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).CreateMovieDeleteState(ctx, req.(*CreateMovieDeleteStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_GetMovieDeleteData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieDeleteDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetMovieDeleteData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetMovieDeleteData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetMovieDeleteData(ctx, req.(*GetMovieDeleteDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_CreateQualityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQualityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).CreateQualityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_CreateQualityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).CreateQualityProfile(ctx, req.(*CreateQualityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_GetQualityProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQualityProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetQualityProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetQualityProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetQualityProfiles(ctx, req.(*GetQualityProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_GetQualityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQualityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).GetQualityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_GetQualityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).GetQualityProfile(ctx, req.(*GetQualityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_UpdateQualityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQualityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).UpdateQualityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_UpdateQualityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).UpdateQualityProfile(ctx, req.(*UpdateQualityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoContentService_DeleteQualityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQualityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoContentServiceServer).DeleteQualityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoContentService_DeleteQualityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoContentServiceServer).DeleteQualityProfile(ctx, req.(*DeleteQualityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoContentService_ServiceDesc is the grpc.ServiceDesc for VideoContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +955,10 @@ var VideoContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVideoContent",
 			Handler:    _VideoContentService_GetVideoContent_Handler,
 		},
+		{
+			MethodName: "GetSeasonCheck",
+			Handler:    _VideoContentService_GetSeasonCheck_Handler,
+		},
 		{
 			MethodName: "CreateDeliveryState",
 			Handler:    _VideoContentService_CreateDeliveryState_Handler,
@@ -378,6 +975,42 @@ var VideoContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChoseFileMatchesOptions",
 			Handler:    _VideoContentService_ChoseFileMatchesOptions_Handler,
 		},
+		{
+			MethodName: "RetryDeliveryStep",
+			Handler:    _VideoContentService_RetryDeliveryStep_Handler,
+		},
+		{
+			MethodName: "CancelDelivery",
+			Handler:    _VideoContentService_CancelDelivery_Handler,
+		},
+		{
+			MethodName: "GetCancelData",
+			Handler:    _VideoContentService_GetCancelData_Handler,
+		},
+		{
+			MethodName: "CreateUpdateState",
+			Handler:    _VideoContentService_CreateUpdateState_Handler,
+		},
+		{
+			MethodName: "GetUpdateData",
+			Handler:    _VideoContentService_GetUpdateData_Handler,
+		},
+		{
+			MethodName: "CreateMovieDeliveryState",
+			Handler:    _VideoContentService_CreateMovieDeliveryState_Handler,
+		},
+		{
+			MethodName: "GetMovieDeliveryData",
+			Handler:    _VideoContentService_GetMovieDeliveryData_Handler,
+		},
+		{
+			MethodName: "ChoseMovieTorrentOptions",
+			Handler:    _VideoContentService_ChoseMovieTorrentOptions_Handler,
+		},
+		{
+			MethodName: "RetryMovieDeliveryStep",
+			Handler:    _VideoContentService_RetryMovieDeliveryStep_Handler,
+		},
 		{
 			MethodName: "CreateDeleteState",
 			Handler:    _VideoContentService_CreateDeleteState_Handler,
@@ -386,6 +1019,34 @@ var VideoContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeleteData",
 			Handler:    _VideoContentService_GetDeleteData_Handler,
 		},
+		{
+			MethodName: "CreateMovieDeleteState",
+			Handler:    _VideoContentService_CreateMovieDeleteState_Handler,
+		},
+		{
+			MethodName: "GetMovieDeleteData",
+			Handler:    _VideoContentService_GetMovieDeleteData_Handler,
+		},
+		{
+			MethodName: "CreateQualityProfile",
+			Handler:    _VideoContentService_CreateQualityProfile_Handler,
+		},
+		{
+			MethodName: "GetQualityProfiles",
+			Handler:    _VideoContentService_GetQualityProfiles_Handler,
+		},
+		{
+			MethodName: "GetQualityProfile",
+			Handler:    _VideoContentService_GetQualityProfile_Handler,
+		},
+		{
+			MethodName: "UpdateQualityProfile",
+			Handler:    _VideoContentService_UpdateQualityProfile_Handler,
+		},
+		{
+			MethodName: "DeleteQualityProfile",
+			Handler:    _VideoContentService_DeleteQualityProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "media-delivery/videocontent.proto",
@@ -0,0 +1,33 @@
+------------------------------------------------------------------------------------------------------------------------
+-- name: SaveImage :exec
+INSERT INTO images (id, w92, w185, w342, original)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetImage :one
+SELECT id, w92, w185, w342, original FROM images WHERE id = $1 LIMIT 1;
+
+-- name: SaveMovie :exec
+INSERT INTO movies (
+    id, title, original_title, overview, poster_id,
+    release_date, vote_average, vote_count, popularity,
+    backdrop_id, genres, imdb_id, origin_country,
+    runtime, status, tagline
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+          $9, $10, $11, $12, $13, $14,
+          $15, $16);
+
+-- name: GetMovie :one
+SELECT
+    id, title, original_title, overview, poster_id,
+    release_date, vote_average, vote_count, popularity,
+    backdrop_id, genres, imdb_id, origin_country,
+    runtime, status, tagline
+FROM movies
+WHERE id = $1;
+
+-- name: GetMovies :many
+SELECT
+    id, title, original_title, overview, poster_id,
+    release_date, vote_average, vote_count, popularity
+FROM movies
+ORDER BY popularity DESC;
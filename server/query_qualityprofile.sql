@@ -0,0 +1,30 @@
+-- name: SaveQualityProfile :exec
+INSERT INTO quality_profiles (id, name, created_at, updated_at, min_resolution, max_resolution,
+                              preferred_codecs, forbidden_codecs, required_audio, max_size_per_episode)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: UpdateQualityProfile :one
+UPDATE quality_profiles
+SET name = $2,
+    updated_at = $3,
+    min_resolution = $4,
+    max_resolution = $5,
+    preferred_codecs = $6,
+    forbidden_codecs = $7,
+    required_audio = $8,
+    max_size_per_episode = $9
+WHERE id = $1
+RETURNING id;
+
+-- name: GetQualityProfile :one
+SELECT * FROM quality_profiles
+WHERE id = $1;
+
+-- name: GetQualityProfiles :many
+SELECT * FROM quality_profiles
+ORDER BY name;
+
+-- name: DeleteQualityProfile :one
+DELETE FROM quality_profiles
+WHERE id = $1
+RETURNING id;
@@ -64,3 +64,24 @@ INSERT INTO episodes (
           $5, $6, $7, $8, $9,
           $10, $11);
 
+-- name: UpsertImage :exec
+INSERT INTO images (id, w92, w185, w342, original)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: UpsertEpisode :exec
+INSERT INTO episodes (
+    tv_show_id, season_number, air_date, episode_number,
+    episode_type, name, overview, runtime,
+    still_id, vote_average, vote_count
+) VALUES ($1, $2, $3, $4,
+          $5, $6, $7, $8, $9,
+          $10, $11)
+ON CONFLICT (tv_show_id, season_number, episode_number) DO UPDATE SET air_date=EXCLUDED.air_date,
+                                                                      episode_type=EXCLUDED.episode_type,
+                                                                      name=EXCLUDED.name,
+                                                                      overview=EXCLUDED.overview,
+                                                                      runtime=EXCLUDED.runtime,
+                                                                      still_id=EXCLUDED.still_id,
+                                                                      vote_average=EXCLUDED.vote_average,
+                                                                      vote_count=EXCLUDED.vote_count;
@@ -1,21 +1,50 @@
 -- name: SaveVideoContent :exec
-INSERT INTO video_content (id, created_at, movie_id, tvshow_id, season_number, delivery_status, states)
-VALUES ($1, $2, $3, $4, $5, $6,$7);
+INSERT INTO video_content (id, created_at, movie_id, tvshow_id, season_number, delivery_status, states, version)
+VALUES ($1, $2, $3, $4, $5, $6,$7, $8);
 
 -- name: GetVideoContentsMovieID :many
-SELECT id, created_at,delivery_status, states FROM video_content
+SELECT id, created_at,delivery_status, states, version FROM video_content
 WHERE movie_id=$1;
 
 -- name: GetVideoContentTVShow :many
-SELECT id, created_at, delivery_status, states FROM video_content
+SELECT id, created_at, delivery_status, states, version FROM video_content
 WHERE tvshow_id=$1 AND season_number=$2;
 
+-- name: GetVideoContentsTVShowID :many
+SELECT id, created_at, season_number, delivery_status, states, version FROM video_content
+WHERE tvshow_id=$1;
+
 -- name: GetVideoContentsByDeliveryStatus :many
-SELECT id, created_at, movie_id, tvshow_id, season_number, delivery_status, states FROM video_content
+SELECT id, created_at, movie_id, tvshow_id, season_number, delivery_status, states, version FROM video_content
 WHERE delivery_status=ANY($1::int[]) ORDER BY created_at DESC limit $2;
 
 -- name: UpdateVideoContent :one
 UPDATE video_content
 SET delivery_status=$1, states=$3
 WHERE id=$2
-RETURNING id;
\ No newline at end of file
+RETURNING id;
+
+-- name: GetStateReminder :one
+SELECT * FROM state_reminders
+WHERE state_id=$1 AND step=$2;
+
+-- name: SaveStateReminder :exec
+INSERT INTO state_reminders (state_id, step, reminded_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (state_id, step) DO UPDATE SET reminded_at=EXCLUDED.reminded_at;
+
+-- name: GetSeasonCheck :one
+SELECT * FROM season_checks
+WHERE video_content_id=$1;
+
+-- name: SaveSeasonCheck :exec
+INSERT INTO season_checks (video_content_id, checked_at, result, episodes_count,
+                           aired_episodes_count, delivered_episodes_count, next_episode_air_date, error)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (video_content_id) DO UPDATE SET checked_at=EXCLUDED.checked_at,
+                                             result=EXCLUDED.result,
+                                             episodes_count=EXCLUDED.episodes_count,
+                                             aired_episodes_count=EXCLUDED.aired_episodes_count,
+                                             delivered_episodes_count=EXCLUDED.delivered_episodes_count,
+                                             next_episode_air_date=EXCLUDED.next_episode_air_date,
+                                             error=EXCLUDED.error;
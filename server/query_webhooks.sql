@@ -0,0 +1,21 @@
+-- name: SaveWebhookEvent :exec
+INSERT INTO webhook_outbox (id, webhook_name, event_type, payload, created_at, attempts, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetPendingWebhookEvents :many
+SELECT * FROM webhook_outbox
+WHERE delivered_at IS NULL
+  AND failed_at IS NULL
+  AND next_attempt_at <= $1
+ORDER BY next_attempt_at
+LIMIT $2;
+
+-- name: UpdateWebhookEventAttempt :one
+UPDATE webhook_outbox
+SET attempts = $2,
+    next_attempt_at = $3,
+    delivered_at = $4,
+    failed_at = $5,
+    last_error = $6
+WHERE id = $1
+RETURNING id;
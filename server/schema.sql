@@ -141,6 +141,53 @@ CREATE SEQUENCE public.mkv_merge_logs_id_seq
 ALTER SEQUENCE public.mkv_merge_logs_id_seq OWNED BY public.mkv_merge_logs.id;
 
 
+--
+-- Name: movies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.movies (
+    id bigint NOT NULL,
+    title text NOT NULL,
+    original_title text NOT NULL,
+    overview text NOT NULL,
+    poster_id text,
+    release_date timestamp with time zone NOT NULL,
+    vote_average double precision NOT NULL,
+    vote_count integer NOT NULL,
+    popularity double precision NOT NULL,
+    backdrop_id text,
+    genres text[],
+    imdb_id text NOT NULL,
+    origin_country text[],
+    runtime integer NOT NULL,
+    status text NOT NULL,
+    tagline text NOT NULL,
+    CONSTRAINT movies_id_check CHECK ((id >= 0)),
+    CONSTRAINT movies_runtime_check CHECK ((runtime >= 0))
+);
+
+
+--
+-- Name: quality_profiles; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.quality_profiles (
+    id uuid NOT NULL,
+    name text NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    min_resolution integer NOT NULL,
+    max_resolution integer NOT NULL,
+    preferred_codecs text[] NOT NULL,
+    forbidden_codecs text[] NOT NULL,
+    required_audio text[] NOT NULL,
+    max_size_per_episode bigint NOT NULL,
+    CONSTRAINT quality_profiles_max_resolution_check CHECK ((max_resolution >= 0)),
+    CONSTRAINT quality_profiles_max_size_per_episode_check CHECK ((max_size_per_episode >= 0)),
+    CONSTRAINT quality_profiles_min_resolution_check CHECK ((min_resolution >= 0))
+);
+
+
 --
 -- Name: seasons; Type: TABLE; Schema: public; Owner: -
 --
@@ -158,6 +205,22 @@ CREATE TABLE public.seasons (
 );
 
 
+--
+-- Name: season_checks; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.season_checks (
+    video_content_id uuid NOT NULL,
+    checked_at timestamp with time zone NOT NULL,
+    result text NOT NULL,
+    episodes_count integer NOT NULL,
+    aired_episodes_count integer NOT NULL,
+    delivered_episodes_count integer NOT NULL,
+    next_episode_air_date timestamp with time zone,
+    error text
+);
+
+
 --
 -- Name: state; Type: TABLE; Schema: public; Owner: -
 --
@@ -177,6 +240,17 @@ CREATE TABLE public.state (
 );
 
 
+--
+-- Name: state_reminders; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.state_reminders (
+    state_id uuid NOT NULL,
+    step text NOT NULL,
+    reminded_at timestamp with time zone NOT NULL
+);
+
+
 --
 -- Name: step_execute_info; Type: TABLE; Schema: public; Owner: -
 --
@@ -253,10 +327,30 @@ CREATE TABLE public.video_content (
     season_number integer,
     delivery_status integer NOT NULL,
     states jsonb,
+    version text DEFAULT ''::text NOT NULL,
     CONSTRAINT content_choice_chk CHECK ((((movie_id IS NOT NULL) AND (tvshow_id IS NULL) AND (season_number IS NULL)) OR ((movie_id IS NULL) AND (tvshow_id IS NOT NULL) AND (season_number IS NOT NULL))))
 );
 
 
+--
+-- Name: webhook_outbox; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webhook_outbox (
+    id uuid NOT NULL,
+    webhook_name text NOT NULL,
+    event_type text NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    attempts integer NOT NULL,
+    next_attempt_at timestamp with time zone NOT NULL,
+    delivered_at timestamp with time zone,
+    failed_at timestamp with time zone,
+    last_error text,
+    CONSTRAINT webhook_outbox_attempts_check CHECK ((attempts >= 0))
+);
+
+
 --
 -- Name: mkv_merge_logs id; Type: DEFAULT; Schema: public; Owner: -
 --
@@ -303,6 +397,38 @@ ALTER TABLE ONLY public.mkv_merge
     ADD CONSTRAINT mkv_merge_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: movies movies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.movies
+    ADD CONSTRAINT movies_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: quality_profiles quality_profiles_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.quality_profiles
+    ADD CONSTRAINT quality_profiles_name_key UNIQUE (name);
+
+
+--
+-- Name: quality_profiles quality_profiles_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.quality_profiles
+    ADD CONSTRAINT quality_profiles_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: season_checks season_checks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.season_checks
+    ADD CONSTRAINT season_checks_pkey PRIMARY KEY (video_content_id);
+
+
 --
 -- Name: state state_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -311,6 +437,14 @@ ALTER TABLE ONLY public.state
     ADD CONSTRAINT state_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: state_reminders state_reminders_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.state_reminders
+    ADD CONSTRAINT state_reminders_pkey PRIMARY KEY (state_id, step);
+
+
 --
 -- Name: step_execute_info step_execute_info_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -351,6 +485,14 @@ ALTER TABLE ONLY public.video_content
     ADD CONSTRAINT video_content_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: webhook_outbox webhook_outbox_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webhook_outbox
+    ADD CONSTRAINT webhook_outbox_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: idx_episodes_tv_show_id_season_number_episode_number; Type: INDEX; Schema: public; Owner: -
 --
@@ -386,6 +528,27 @@ CREATE UNIQUE INDEX idx_state_idempotency_key ON public.state USING btree (idemp
 CREATE INDEX idx_step_execute_state_id ON public.step_execute_info USING btree (state_id);
 
 
+--
+-- Name: idx_video_content_movie_id_version; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_video_content_movie_id_version ON public.video_content USING btree (movie_id, version) WHERE (movie_id IS NOT NULL);
+
+
+--
+-- Name: idx_video_content_tvshow_id_season_number_version; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_video_content_tvshow_id_season_number_version ON public.video_content USING btree (tvshow_id, season_number, version) WHERE (tvshow_id IS NOT NULL);
+
+
+--
+-- Name: idx_webhook_outbox_next_attempt_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webhook_outbox_next_attempt_at ON public.webhook_outbox USING btree (next_attempt_at) WHERE ((delivered_at IS NULL) AND (failed_at IS NULL));
+
+
 --
 -- Name: mkv_merge_logs mkv_merge_logs_merge_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --